@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"log"
+	"merchshop/internal/oidc"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	mockOIDCPort     string
+	mockOIDCIssuer   string
+	mockOIDCClient   string
+	mockOIDCSecret   string
+	mockOIDCIdentity oidc.MockIdentity
+
+	mockOIDCCmd = &cobra.Command{
+		Use:   "mock-oidc",
+		Short: "Run a local OpenID provider that signs everyone in as one user",
+		Args:  cobra.NoArgs,
+		Run:   MockOIDC,
+	}
+)
+
+func init() {
+	mockOIDCCmd.Flags().StringVar(&mockOIDCPort, "port", "9000", "HTTP port of the provider")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCIssuer, "issuer", "http://localhost:9000", "Issuer URL the provider is reachable at")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCClient, "client_id", "merch", "Accepted client id")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCSecret, "client_secret", "secret", "Accepted client secret")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCIdentity.Subject, "sub", "mock-user", "Subject of the signed in user")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCIdentity.Email, "email", "mock.user@example.com", "Email of the signed in user")
+	mockOIDCCmd.Flags().BoolVar(&mockOIDCIdentity.EmailVerified, "email_verified", true, "Whether the email is verified")
+	mockOIDCCmd.Flags().StringVar(&mockOIDCIdentity.PreferredUsername, "preferred_username", "mock.user", "Preferred username of the signed in user")
+	rootCmd.AddCommand(mockOIDCCmd)
+}
+
+func MockOIDC(cmd *cobra.Command, args []string) {
+	provider, err := oidc.NewMockProvider(mockOIDCIssuer, mockOIDCClient, mockOIDCSecret, mockOIDCIdentity)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("mock oidc provider for %s listening on :%s", mockOIDCIssuer, mockOIDCPort)
+	log.Fatal(http.ListenAndServe(":"+mockOIDCPort, provider))
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+	"merchshop/internal/service"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportFrom   string
+	reportTo     string
+	reportFormat string
+	reportOut    string
+
+	reportCmd = &cobra.Command{
+		Use:   "report <transfers|purchases|balances>",
+		Short: "Export a transactions report for a date range",
+		Args:  cobra.ExactArgs(1),
+		Run:   Report,
+	}
+)
+
+func init() {
+	reportCmd.Flags().StringVar(&reportFrom, "from", "", "Period start, inclusive (YYYY-MM-DD or RFC 3339)")
+	reportCmd.Flags().StringVar(&reportTo, "to", "", "Period end, exclusive (YYYY-MM-DD or RFC 3339)")
+	reportCmd.Flags().StringVar(&reportFormat, "format", "csv", "Output format: csv, jsonl or xlsx")
+	reportCmd.Flags().StringVarP(&reportOut, "out", "o", "", "Output file (default stdout)")
+	rootCmd.AddCommand(reportCmd)
+}
+
+func parseReportTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: expected YYYY-MM-DD or RFC 3339", value)
+	}
+	return &t, nil
+}
+
+func Report(cmd *cobra.Command, args []string) {
+	var period model.Period
+	var err error
+	if period.From, err = parseReportTime(reportFrom); err != nil {
+		log.Fatal(err)
+	}
+	if period.To, err = parseReportTime(reportTo); err != nil {
+		log.Fatal(err)
+	}
+	kind := model.ReportKind(args[0])
+	format := model.ReportFormat(reportFormat)
+
+	r, err := repository.NewPgMerchRepository(context.TODO(), dbSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+	s := service.NewMerchService(r, r, service.DefaultPasswordHashing, nil, nil)
+	if err := s.ValidateReport(kind, format, period); err != nil {
+		log.Fatal(err)
+	}
+	if err := s.AuditReportExport(context.TODO(), model.AuditActorSystem, kind, format, period); err != nil {
+		log.Fatal(err)
+	}
+
+	var out io.Writer = os.Stdout
+	if reportOut != "" {
+		f, err := os.Create(reportOut)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	if err := s.ExportReport(context.TODO(), w, kind, format, period); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+	"merchshop/internal/service"
+
+	"github.com/spf13/cobra"
+)
+
+var setRoleCmd = &cobra.Command{
+	Use:   "set-role <username> <user|admin|auditor>",
+	Short: "Grant or revoke the admin and auditor roles",
+	Args:  cobra.ExactArgs(2),
+	Run:   SetRole,
+}
+
+func init() {
+	rootCmd.AddCommand(setRoleCmd)
+}
+
+func SetRole(cmd *cobra.Command, args []string) {
+	r, err := repository.NewPgMerchRepository(context.TODO(), dbSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+	if err := service.NewMerchService(r, r, service.DefaultPasswordHashing, nil, nil).SetUserRole(context.TODO(), model.AuditActorSystem, args[0], args[1]); err != nil {
+		log.Fatal(err)
+	}
+}
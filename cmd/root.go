@@ -4,9 +4,15 @@ import (
 	"context"
 	"errors"
 	"log"
+	"merchshop/internal/ldap"
+	"merchshop/internal/nats"
+	"merchshop/internal/oidc"
+	"merchshop/internal/ratelimit"
 	"merchshop/internal/repository"
 	"merchshop/internal/server"
+	"merchshop/internal/service"
 	"os"
+	"time"
 
 	"github.com/golang-migrate/migrate"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -18,6 +24,19 @@ var (
 	port          string
 	dbSource      string
 	migrationsDir string
+	eventRelay    string
+	eventRelayURL string
+	natsConfig    nats.Config
+	eventSubject  string
+	loginAttempts string
+	rateLimit     string
+	rateLimitFile string
+	hashing       = service.DefaultPasswordHashing
+	oidcConfig    oidc.Config
+	authenticator string
+	ldapConfig    ldap.Config
+	auditKey      string
+	proxies       []string
 
 	rootCmd = &cobra.Command{
 		Use:   "merch",
@@ -34,6 +53,53 @@ func init() {
 		"migrations",
 		"Path to the db migrations folder")
 	rootCmd.PersistentFlags().StringVar(&port, "port", "8080", "HTTP Server port")
+	rootCmd.Flags().StringSliceVar(&proxies, "trusted_proxies", nil,
+		"Addresses or CIDRs of reverse proxies whose X-Forwarded-For is trusted; by default the peer address is the client")
+	rootCmd.Flags().StringVar(&auditKey, "audit_key", "",
+		"Secret key of the audit log hash chain; keep it out of the database")
+	rootCmd.Flags().StringVar(&eventRelay, "event_relay", "none",
+		"Where to relay domain events from the outbox: http, nats, log (for debugging) or none, which prunes them after an hour")
+	rootCmd.Flags().StringVar(&eventRelayURL, "event_relay_url", "",
+		"Collector endpoint for the http event relay")
+	rootCmd.Flags().StringVar(&natsConfig.URL, "nats_url", "",
+		"NATS server for the nats event relay, nats://[user:password@]host:4222 or tls://host:4222")
+	rootCmd.Flags().DurationVar(&natsConfig.Timeout, "nats_timeout", 10*time.Second, "Timeout of a NATS publish")
+	rootCmd.Flags().StringVar(&eventSubject, "event_subject_prefix", "merch.events.",
+		"Prefix of the subjects the nats event relay publishes to, followed by the event type")
+	rootCmd.Flags().StringVar(&loginAttempts, "login_attempts", "postgres",
+		"Where to track failed logins: postgres, or memory for a single instance")
+	rootCmd.Flags().StringVar(&rateLimit, "rate_limit", "memory",
+		"Where to keep rate limit buckets: memory (per instance), postgres or none")
+	rootCmd.Flags().StringVar(&rateLimitFile, "rate_limits", "",
+		"Path to a JSON file with the per-client, default and per-route rate limits")
+	rootCmd.Flags().StringVar(&hashing.Algorithm, "password_hash", hashing.Algorithm,
+		"Algorithm for new password hashes: bcrypt or argon2id; older hashes are upgraded on login")
+	rootCmd.Flags().IntVar(&hashing.BcryptCost, "bcrypt_cost", hashing.BcryptCost,
+		"bcrypt cost factor")
+	rootCmd.Flags().Uint32Var(&hashing.Argon2Time, "argon2_time", hashing.Argon2Time,
+		"argon2id number of passes")
+	rootCmd.Flags().Uint32Var(&hashing.Argon2Memory, "argon2_memory", hashing.Argon2Memory,
+		"argon2id memory in KiB")
+	rootCmd.Flags().Uint8Var(&hashing.Argon2Threads, "argon2_threads", hashing.Argon2Threads,
+		"argon2id degree of parallelism")
+	rootCmd.Flags().StringVar(&authenticator, "authenticator", "local",
+		"How /api/auth checks passwords: local hashes or an ldap bind")
+	rootCmd.Flags().StringVar(&ldapConfig.URL, "ldap_url", "", "Directory URL, ldap://host:389 or ldaps://host:636")
+	rootCmd.Flags().StringVar(&ldapConfig.BindDN, "ldap_bind_dn", "uid=%s,ou=people,dc=example,dc=com",
+		"DN users bind as, %s standing for the username")
+	rootCmd.Flags().BoolVar(&ldapConfig.StartTLS, "ldap_start_tls", true,
+		"Upgrade ldap:// connections with StartTLS; disable only for directories reached over a trusted network")
+	rootCmd.Flags().DurationVar(&ldapConfig.Timeout, "ldap_timeout", 10*time.Second, "Timeout of a directory bind")
+	rootCmd.Flags().StringVar(&oidcConfig.Issuer, "oidc_issuer", "",
+		"OpenID Connect issuer URL; enables single sign-on at /api/auth/oidc/login")
+	rootCmd.Flags().StringVar(&oidcConfig.ClientID, "oidc_client_id", "", "OpenID Connect client id")
+	rootCmd.Flags().StringVar(&oidcConfig.ClientSecret, "oidc_client_secret", "", "OpenID Connect client secret")
+	rootCmd.Flags().StringVar(&oidcConfig.RedirectURL, "oidc_redirect_url", "http://localhost:8080/api/auth/oidc/callback",
+		"Public URL of /api/auth/oidc/callback registered at the provider")
+	rootCmd.Flags().StringSliceVar(&oidcConfig.Scopes, "oidc_scopes", []string{"email", "profile"},
+		"OpenID Connect scopes requested besides openid")
+	rootCmd.Flags().StringVar(&oidcConfig.UsernameClaim, "oidc_username_claim", "email",
+		"ID token claim used as the merch username: email, preferred_username or sub")
 }
 
 func Execute() {
@@ -43,6 +109,12 @@ func Execute() {
 }
 
 func Run(cmd *cobra.Command, args []string) {
+	if err := hashing.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	if auditKey == "" {
+		log.Fatal("--audit_key is required")
+	}
 	m, err := migrate.New("file://"+migrationsDir, dbSource)
 	if err != nil {
 		log.Fatal(err)
@@ -51,10 +123,74 @@ func Run(cmd *cobra.Command, args []string) {
 		log.Fatal(err)
 	}
 
+	var relay service.EventRelay
+	switch eventRelay {
+	case "log":
+		relay = service.LogRelay{}
+	case "http":
+		if eventRelayURL == "" {
+			log.Fatal("--event_relay_url is required for the http event relay")
+		}
+		relay = service.NewHTTPRelay(eventRelayURL)
+	case "nats":
+		if natsConfig.URL == "" {
+			log.Fatal("--nats_url is required for the nats event relay")
+		}
+		publisher, err := nats.NewPublisher(natsConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer publisher.Close()
+		relay = service.NewBrokerRelay(publisher, eventSubject)
+	case "none":
+	default:
+		log.Fatalf("unknown event relay %q", eventRelay)
+	}
+
 	r, err := repository.NewPgMerchRepository(context.TODO(), dbSource)
 	if err != nil {
 		log.Fatal(err)
 	}
-	s := server.NewServer("0.0.0.0:"+port, r)
+	var logins repository.LoginAttemptStore = r
+	switch loginAttempts {
+	case "postgres":
+	case "memory":
+		logins = repository.NewMemoryLoginAttemptStore()
+	default:
+		log.Fatalf("unknown login attempts store %q", loginAttempts)
+	}
+	limits := ratelimit.DefaultConfig
+	if rateLimitFile != "" {
+		if limits, err = ratelimit.LoadConfig(rateLimitFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	var rateLimits ratelimit.Store
+	switch rateLimit {
+	case "memory":
+		rateLimits = ratelimit.NewMemoryStore()
+	case "postgres":
+		rateLimits = ratelimit.NewPostgresStore(r)
+	case "none":
+	default:
+		log.Fatalf("unknown rate limit store %q", rateLimit)
+	}
+	var auth service.Authenticator
+	switch authenticator {
+	case "local":
+	case "ldap":
+		if auth, err = service.NewLDAPAuthenticator(ldapConfig); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("unknown authenticator %q", authenticator)
+	}
+	var provider *oidc.Provider
+	if oidcConfig.Issuer != "" {
+		if provider, err = oidc.NewProvider(context.TODO(), oidcConfig); err != nil {
+			log.Fatal(err)
+		}
+	}
+	s := server.NewServer("0.0.0.0:"+port, r, r, relay, logins, rateLimits, limits, hashing, auth, provider, []byte(auditKey), proxies)
 	log.Fatal(s.ListenAndServe())
 }
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+// actorFrom returns the user or the service account the request was
+// authenticated as.
+func actorFrom(ctx context.Context) (model.Actor, bool) {
+	if account, ok := ctx.Value("serviceAccount").(string); ok && account != "" {
+		return model.Actor{ServiceAccount: account}, true
+	}
+	username, ok := ctx.Value("username").(string)
+	return model.Actor{Username: username}, ok && username != ""
+}
+
+func (s *APIServer) PostApiCoinsGrant(ctx context.Context, req PostApiCoinsGrantRequestObject) (PostApiCoinsGrantResponseObject, error) {
+	actor, ok := actorFrom(ctx)
+	if !ok {
+		return PostApiCoinsGrant400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiCoinsGrant400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	reason := ""
+	if req.Body.Reason != nil {
+		reason = *req.Body.Reason
+	}
+	currency := model.DefaultCurrency
+	if req.Body.Currency != nil {
+		currency = *req.Body.Currency
+	}
+	if err := s.merchService.GrantCoins(ctx, actor, req.Body.ToUser, currency, int32(req.Body.Amount), reason); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiCoinsGrant403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiCoinsGrant404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidGrant), errors.Is(err, model.ErrUserDeactivated), errors.Is(err, model.ErrCurrencyNotFound):
+			return PostApiCoinsGrant400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiCoinsGrant500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiCoinsGrant200Response{}, nil
+}
+
+func (s *APIServer) GetApiUsersUsernameInfo(ctx context.Context, req GetApiUsersUsernameInfoRequestObject) (GetApiUsersUsernameInfoResponseObject, error) {
+	actor, ok := actorFrom(ctx)
+	if !ok {
+		return GetApiUsersUsernameInfo400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	info, err := s.merchService.GetUserInfo(ctx, actor, req.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return GetApiUsersUsernameInfo403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return GetApiUsersUsernameInfo404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiUsersUsernameInfo500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiUsersUsernameInfo200JSONResponse(toAPIInfo(info)), nil
+}
+
+func (s *APIServer) GetApiAdminServiceAccounts(ctx context.Context, req GetApiAdminServiceAccountsRequestObject) (GetApiAdminServiceAccountsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminServiceAccounts400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	accounts, err := s.merchService.ListServiceAccounts(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminServiceAccounts403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminServiceAccounts500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminServiceAccounts200JSONResponse{}
+	for _, a := range accounts {
+		resp = append(resp, toAPIServiceAccount(a))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminServiceAccounts(ctx context.Context, req PostApiAdminServiceAccountsRequestObject) (PostApiAdminServiceAccountsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminServiceAccounts400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminServiceAccounts400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	description := ""
+	if req.Body.Description != nil {
+		description = *req.Body.Description
+	}
+	account, err := s.merchService.CreateServiceAccount(ctx, username, req.Body.Name, description)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminServiceAccounts403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidServiceAccount), errors.Is(err, model.ErrServiceAccountExists):
+			return PostApiAdminServiceAccounts400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminServiceAccounts500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminServiceAccounts200JSONResponse(toAPIServiceAccount(*account)), nil
+}
+
+func (s *APIServer) GetApiAdminServiceAccountsNameKeys(ctx context.Context, req GetApiAdminServiceAccountsNameKeysRequestObject) (GetApiAdminServiceAccountsNameKeysResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminServiceAccountsNameKeys400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	keys, err := s.merchService.ListAPIKeys(ctx, username, req.Name)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminServiceAccountsNameKeys403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminServiceAccountsNameKeys500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminServiceAccountsNameKeys200JSONResponse{}
+	for _, k := range keys {
+		resp = append(resp, toAPIKey(k))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminServiceAccountsNameKeys(ctx context.Context, req PostApiAdminServiceAccountsNameKeysRequestObject) (PostApiAdminServiceAccountsNameKeysResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminServiceAccountsNameKeys400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminServiceAccountsNameKeys400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	key, err := s.merchService.CreateAPIKey(ctx, username, req.Name, req.Body.Scopes, req.Body.ExpiresAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminServiceAccountsNameKeys403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrServiceAccountNotFound):
+			return PostApiAdminServiceAccountsNameKeys404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAPIKeyScopes), errors.Is(err, model.ErrInvalidAPIKeyExpiry):
+			return PostApiAdminServiceAccountsNameKeys400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminServiceAccountsNameKeys500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminServiceAccountsNameKeys200JSONResponse(toAPIKey(*key)), nil
+}
+
+func (s *APIServer) DeleteApiAdminApiKeysId(ctx context.Context, req DeleteApiAdminApiKeysIdRequestObject) (DeleteApiAdminApiKeysIdResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminApiKeysId400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.RevokeAPIKey(ctx, username, req.Id); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminApiKeysId403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrAPIKeyNotFound):
+			return DeleteApiAdminApiKeysId404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminApiKeysId500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminApiKeysId200Response{}, nil
+}
+
+func toAPIServiceAccount(a model.ServiceAccount) ServiceAccount {
+	return ServiceAccount{
+		Name:        a.Name,
+		Description: a.Description,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+// toAPIKey includes the key itself only right after it was created.
+func toAPIKey(k model.APIKey) APIKey {
+	return APIKey{
+		Id:             k.ID,
+		ServiceAccount: k.ServiceAccount,
+		Prefix:         k.Prefix,
+		Key:            optionalString(k.Key),
+		Scopes:         k.Scopes,
+		CreatedBy:      k.CreatedBy,
+		CreatedAt:      k.CreatedAt,
+		ExpiresAt:      k.ExpiresAt,
+		LastUsedAt:     k.LastUsedAt,
+		RevokedAt:      k.RevokedAt,
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiAuctions(ctx context.Context, req GetApiAuctionsRequestObject) (GetApiAuctionsResponseObject, error) {
+	auctions, err := s.merchService.ListAuctions(ctx)
+	if err != nil {
+		return GetApiAuctions500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAuctions200JSONResponse{}
+	for _, a := range auctions {
+		resp = append(resp, toAPIAuction(a))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) GetApiAuctionsId(ctx context.Context, req GetApiAuctionsIdRequestObject) (GetApiAuctionsIdResponseObject, error) {
+	auction, err := s.merchService.GetAuction(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrAuctionNotFound) {
+			return GetApiAuctionsId404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAuctionsId500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := toAPIAuction(*auction)
+	bids := []AuctionBid{}
+	for _, b := range auction.Bids {
+		bids = append(bids, AuctionBid{
+			Username:  b.Username,
+			Amount:    int(b.Amount),
+			Released:  b.Released,
+			CreatedAt: b.CreatedAt,
+		})
+	}
+	resp.Bids = &bids
+	return GetApiAuctionsId200JSONResponse(resp), nil
+}
+
+func (s *APIServer) PostApiAuctionsIdBids(ctx context.Context, req PostApiAuctionsIdBidsRequestObject) (PostApiAuctionsIdBidsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAuctionsIdBids400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAuctionsIdBids400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	auction, err := s.merchService.PlaceBid(ctx, username, req.Id, int32(req.Body.Amount))
+	if err != nil {
+		var exceeded *model.LimitExceededError
+		if errors.As(err, &exceeded) {
+			return PostApiAuctionsIdBids403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		switch {
+		case errors.Is(err, model.ErrAuctionNotFound):
+			return PostApiAuctionsIdBids404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAmount), errors.Is(err, model.ErrAuctionClosed), errors.Is(err, model.ErrBidTooLow),
+			errors.Is(err, model.ErrInsufficientFunds), errors.Is(err, model.ErrUserDeactivated):
+			return PostApiAuctionsIdBids400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAuctionsIdBids500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAuctionsIdBids200JSONResponse(toAPIAuction(*auction)), nil
+}
+
+func (s *APIServer) PostApiAdminAuctions(ctx context.Context, req PostApiAdminAuctionsRequestObject) (PostApiAdminAuctionsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminAuctions400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminAuctions400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if req.Body.ReservePrice <= 0 {
+		return PostApiAdminAuctions400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidAuction.Error())}), nil
+	}
+	auction, err := s.merchService.CreateAuction(ctx, username, req.Body.Item, uint32(req.Body.ReservePrice), req.Body.EndsAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminAuctions403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrItemNotFound):
+			return PostApiAdminAuctions404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAuction):
+			return PostApiAdminAuctions400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminAuctions500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminAuctions200JSONResponse(toAPIAuction(*auction)), nil
+}
+
+func toAPIAuction(a model.Auction) Auction {
+	resp := Auction{
+		Id:            a.ID,
+		Item:          a.Item,
+		ReservePrice:  int(a.ReservePrice),
+		EndsAt:        a.EndsAt,
+		HighestBidder: optionalString(a.HighestBidder),
+		Closed:        a.ClosedAt != nil,
+		Winner:        optionalString(a.Winner),
+		CreatedBy:     a.CreatedBy,
+		CreatedAt:     a.CreatedAt,
+	}
+	if a.HighestBid > 0 {
+		bid := int(a.HighestBid)
+		resp.HighestBid = &bid
+	}
+	return resp
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+)
+
+func (s *APIServer) GetApiAudit(ctx context.Context, req GetApiAuditRequestObject) (GetApiAuditResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAudit400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	limit := defaultAuditLimit
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+		if limit <= 0 || limit > maxAuditLimit {
+			return GetApiAudit400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 500")}), nil
+		}
+	}
+	filter := model.AuditFilter{
+		Period:    model.Period{From: req.Params.From, To: req.Params.To},
+		BeforeSeq: req.Params.BeforeSeq,
+		Limit:     int32(limit),
+	}
+	if req.Params.Actor != nil {
+		filter.Actor = *req.Params.Actor
+	}
+	if req.Params.Action != nil {
+		filter.Action = *req.Params.Action
+	}
+	if req.Params.Target != nil {
+		filter.Target = *req.Params.Target
+	}
+	entries, err := s.merchService.GetAuditLog(ctx, username, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return GetApiAudit403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidPeriod):
+			return GetApiAudit400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAudit500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAudit200JSONResponse{}
+	for _, e := range entries {
+		resp = append(resp, toAPIAuditEntry(e))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) GetApiAuditVerify(ctx context.Context, req GetApiAuditVerifyRequestObject) (GetApiAuditVerifyResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAuditVerify400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	result, err := s.merchService.VerifyAuditLog(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAuditVerify403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAuditVerify500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiAuditVerify200JSONResponse(AuditVerification{
+		Valid:     result.Valid,
+		Checked:   result.Checked,
+		Redacted:  result.Redacted,
+		BrokenSeq: result.BrokenSeq,
+	}), nil
+}
+
+func toAPIAuditEntry(e model.AuditEntry) AuditEntry {
+	entry := AuditEntry{
+		Seq:       e.Seq,
+		CreatedAt: e.CreatedAt,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		Target:    e.Target,
+		Ip:        optionalString(e.Meta.IP),
+		UserAgent: optionalString(e.Meta.UserAgent),
+		RequestId: optionalString(e.Meta.RequestID),
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+	}
+	if e.Before != nil {
+		entry.Before = &e.Before
+	}
+	if e.After != nil {
+		entry.After = &e.After
+	}
+	return entry
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiCatalog(ctx context.Context, req GetApiCatalogRequestObject) (GetApiCatalogResponseObject, error) {
+	filter := model.CatalogFilter{}
+	if req.Params.Category != nil {
+		filter.Category = *req.Params.Category
+	}
+	if req.Params.MinPrice != nil {
+		if *req.Params.MinPrice < 0 {
+			return GetApiCatalog400JSONResponse(ErrorResponse{Errors: ptr("minPrice must not be negative")}), nil
+		}
+		minPrice := uint32(*req.Params.MinPrice)
+		filter.MinPrice = &minPrice
+	}
+	if req.Params.MaxPrice != nil {
+		if *req.Params.MaxPrice < 0 {
+			return GetApiCatalog400JSONResponse(ErrorResponse{Errors: ptr("maxPrice must not be negative")}), nil
+		}
+		maxPrice := uint32(*req.Params.MaxPrice)
+		filter.MaxPrice = &maxPrice
+	}
+	if req.Params.Sort != nil {
+		switch sort := model.CatalogSort(*req.Params.Sort); sort {
+		case model.CatalogSortPriceAsc, model.CatalogSortPriceDesc, model.CatalogSortName:
+			filter.Sort = sort
+		default:
+			return GetApiCatalog400JSONResponse(ErrorResponse{Errors: ptr("sort must be one of price, -price, name")}), nil
+		}
+	}
+	lang, ok := parseLang(req.Params.Lang)
+	if !ok {
+		return GetApiCatalog400JSONResponse(ErrorResponse{Errors: ptr("lang must be ru or en")}), nil
+	}
+	filter.Lang = lang
+
+	items, err := s.merchService.ListCatalog(ctx, filter)
+	if err != nil {
+		return GetApiCatalog500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+
+	resp := GetApiCatalog200JSONResponse{}
+	for _, item := range items {
+		name := localize(item.Name, lang)
+		if name == "" {
+			name = item.Item
+		}
+		catalogItem := CatalogItem{
+			Item:        item.Item,
+			Name:        name,
+			Description: optionalString(localize(item.Description, lang)),
+			Price:       int(item.Price),
+			Currency:    item.Currency,
+			Category:    optionalString(item.Category),
+		}
+		if len(item.Images) > 0 {
+			images := item.Images
+			catalogItem.Images = &images
+		}
+		if len(item.Variants) > 0 {
+			var variants []ProductVariant
+			for _, v := range item.Variants {
+				variants = append(variants, toAPIProductVariant(v))
+			}
+			catalogItem.Variants = &variants
+		}
+		resp = append(resp, catalogItem)
+	}
+	return resp, nil
+}
+
+func (s *APIServer) GetApiCategories(ctx context.Context, req GetApiCategoriesRequestObject) (GetApiCategoriesResponseObject, error) {
+	lang, ok := parseLang(req.Params.Lang)
+	if !ok {
+		return GetApiCategories400JSONResponse(ErrorResponse{Errors: ptr("lang must be ru or en")}), nil
+	}
+	categories, err := s.merchService.ListCategories(ctx)
+	if err != nil {
+		return GetApiCategories500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiCategories200JSONResponse{}
+	for _, c := range categories {
+		resp = append(resp, Category{
+			Slug: c.Slug,
+			Name: localize(c.Name, lang),
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PutApiAdminProductsItemCurrency(ctx context.Context, req PutApiAdminProductsItemCurrencyRequestObject) (PutApiAdminProductsItemCurrencyResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiAdminProductsItemCurrency400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil || req.Body.Currency == "" {
+		return PutApiAdminProductsItemCurrency400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if err := s.merchService.SetProductCurrency(ctx, username, req.Item, req.Body.Currency); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PutApiAdminProductsItemCurrency403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrItemNotFound):
+			return PutApiAdminProductsItemCurrency404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrCurrencyNotFound):
+			return PutApiAdminProductsItemCurrency400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiAdminProductsItemCurrency500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiAdminProductsItemCurrency200Response{}, nil
+}
+
+func (s *APIServer) PutApiAdminProductsItemVariantsSku(ctx context.Context, req PutApiAdminProductsItemVariantsSkuRequestObject) (PutApiAdminProductsItemVariantsSkuResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiAdminProductsItemVariantsSku400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PutApiAdminProductsItemVariantsSku400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if (req.Body.Stock != nil && *req.Body.Stock < 0) || (req.Body.Price != nil && *req.Body.Price <= 0) {
+		return PutApiAdminProductsItemVariantsSku400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidVariant.Error())}), nil
+	}
+	variant := model.ProductVariant{
+		Item: req.Item,
+		SKU:  req.Sku,
+	}
+	if req.Body.Stock != nil {
+		stock := uint32(*req.Body.Stock)
+		variant.Stock = &stock
+	}
+	if req.Body.Default != nil {
+		variant.Default = *req.Body.Default
+	}
+	if req.Body.Size != nil {
+		variant.Size = *req.Body.Size
+	}
+	if req.Body.Color != nil {
+		variant.Color = *req.Body.Color
+	}
+	if req.Body.Price != nil {
+		price := uint32(*req.Body.Price)
+		variant.Price = &price
+	}
+	updated, err := s.merchService.SetProductVariant(ctx, username, variant)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PutApiAdminProductsItemVariantsSku403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrItemNotFound):
+			return PutApiAdminProductsItemVariantsSku404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidVariant), errors.Is(err, model.ErrVariantSKUTaken):
+			return PutApiAdminProductsItemVariantsSku400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiAdminProductsItemVariantsSku500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiAdminProductsItemVariantsSku200JSONResponse(toAPIProductVariant(*updated)), nil
+}
+
+func toAPIProductVariant(v model.ProductVariant) ProductVariant {
+	variant := ProductVariant{
+		Sku:     v.SKU,
+		Size:    optionalString(v.Size),
+		Color:   optionalString(v.Color),
+		Default: v.Default,
+	}
+	if v.Stock != nil {
+		variant.Stock = ptrInt(int(*v.Stock))
+	}
+	if v.Price != nil {
+		variant.Price = ptrInt(int(*v.Price))
+	}
+	return variant
+}
+
+// parseLang validates the optional lang parameter, defaulting to Russian.
+func parseLang(lang *string) (string, bool) {
+	if lang == nil || *lang == "" {
+		return "ru", true
+	}
+	switch *lang {
+	case "ru", "en":
+		return *lang, true
+	}
+	return "", false
+}
+
+// localize picks the requested translation, falling back to the other one
+// when it is missing.
+func localize(t model.LocalizedText, lang string) string {
+	if lang == "en" && t.EN != "" {
+		return t.EN
+	}
+	if t.RU == "" {
+		return t.EN
+	}
+	return t.RU
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiCurrencies(ctx context.Context, req GetApiCurrenciesRequestObject) (GetApiCurrenciesResponseObject, error) {
+	currencies, err := s.merchService.ListCurrencies(ctx)
+	if err != nil {
+		return GetApiCurrencies500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiCurrencies200JSONResponse{}
+	for _, c := range currencies {
+		resp = append(resp, toAPICurrency(c))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminCurrencies(ctx context.Context, req PostApiAdminCurrenciesRequestObject) (PostApiAdminCurrenciesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminCurrencies400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminCurrencies400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	currency := model.Currency{
+		Code:         req.Body.Code,
+		Name:         req.Body.Name,
+		Transferable: req.Body.Transferable,
+	}
+	if req.Body.LifetimeDays != nil {
+		if *req.Body.LifetimeDays <= 0 {
+			return PostApiAdminCurrencies400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidCurrencyLifetime.Error())}), nil
+		}
+		days := uint32(*req.Body.LifetimeDays)
+		currency.LifetimeDays = &days
+	}
+	created, err := s.merchService.CreateCurrency(ctx, username, currency)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminCurrencies403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidCurrency), errors.Is(err, model.ErrInvalidCurrencyLifetime),
+			errors.Is(err, model.ErrCurrencyExists):
+			return PostApiAdminCurrencies400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminCurrencies500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminCurrencies200JSONResponse(toAPICurrency(*created)), nil
+}
+
+func toAPICurrency(c model.Currency) Currency {
+	resp := Currency{
+		Code:         c.Code,
+		Name:         c.Name,
+		Transferable: c.Transferable,
+		CreatedAt:    c.CreatedAt,
+	}
+	if c.LifetimeDays != nil {
+		days := int(*c.LifetimeDays)
+		resp.LifetimeDays = &days
+	}
+	return resp
+}
+
+func toAPIBalances(balances []model.Balance) []Balance {
+	resp := []Balance{}
+	for _, b := range balances {
+		resp = append(resp, Balance{
+			Currency:      b.Currency,
+			Amount:        int(b.Amount),
+			NextExpiresAt: b.NextExpiresAt,
+		})
+	}
+	return resp
+}
+
+func (s *APIServer) PostApiAdminUsersUsernameBalance(ctx context.Context, req PostApiAdminUsersUsernameBalanceRequestObject) (PostApiAdminUsersUsernameBalanceResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernameBalance400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminUsersUsernameBalance400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	currency := model.DefaultCurrency
+	if req.Body.Currency != nil {
+		currency = *req.Body.Currency
+	}
+	reason := ""
+	if req.Body.Reason != nil {
+		reason = *req.Body.Reason
+	}
+	err := s.merchService.AdjustBalance(ctx, username, req.Username, currency, int32(req.Body.Amount), reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernameBalance403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernameBalance404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAdjustment), errors.Is(err, model.ErrUserDeactivated),
+			errors.Is(err, model.ErrCurrencyNotFound), errors.Is(err, model.ErrInsufficientFunds):
+			return PostApiAdminUsersUsernameBalance400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernameBalance500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernameBalance200Response{}, nil
+}
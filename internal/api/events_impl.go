@@ -0,0 +1,55 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"merchshop/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const sseHeartbeatInterval = 25 * time.Second
+
+// StreamEvents streams the authenticated user's balance changes, incoming
+// transfers and purchases as Server-Sent Events. It is registered directly on
+// the gin router since the generated strict handlers cannot flush per event.
+//
+// (GET /api/events)
+func (s *APIServer) StreamEvents(c *gin.Context) {
+	username := c.GetString("username")
+	if username == "" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, ErrorResponse{Errors: ptr("missing or invalid user")})
+		return
+	}
+
+	events, unsubscribe := s.events.Subscribe(username)
+	defer unsubscribe()
+
+	coins, err := s.merchService.GetBalance(c, username)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{Errors: ptr(err.Error())})
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent(model.EventBalanceChanged, map[string]any{"coins": coins})
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case e := <-events:
+			c.SSEvent(e.Type, e.Data)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
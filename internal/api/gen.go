@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -15,410 +17,10713 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-// AuthRequest defines model for AuthRequest.
-type AuthRequest struct {
-	// Password Пароль для аутентификации.
-	Password string `json:"password"`
+// Defines values for SetTeamMemberRequestRole.
+const (
+	SetTeamMemberRequestRoleLead   SetTeamMemberRequestRole = "lead"
+	SetTeamMemberRequestRoleMember SetTeamMemberRequestRole = "member"
+)
 
-	// Username Имя пользователя для аутентификации.
-	Username string `json:"username"`
-}
+// Defines values for SpendingLimitRuleScope.
+const (
+	SpendingLimitRuleScopeRole SpendingLimitRuleScope = "role"
+	SpendingLimitRuleScopeUser SpendingLimitRuleScope = "user"
+)
 
-// AuthResponse defines model for AuthResponse.
-type AuthResponse struct {
-	// Token JWT-токен для доступа к защищенным ресурсам.
-	Token *string `json:"token,omitempty"`
-}
+// Defines values for TeamMemberRole.
+const (
+	TeamMemberRoleLead   TeamMemberRole = "lead"
+	TeamMemberRoleMember TeamMemberRole = "member"
+)
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	// Errors Сообщение об ошибке, описывающее проблему.
-	Errors *string `json:"errors,omitempty"`
-}
+// Defines values for TeamTransactionKind.
+const (
+	Deposit  TeamTransactionKind = "deposit"
+	Transfer TeamTransactionKind = "transfer"
+)
 
-// InfoResponse defines model for InfoResponse.
-type InfoResponse struct {
-	CoinHistory *struct {
-		Received *[]struct {
-			// Amount Количество полученных монет.
-			Amount *int `json:"amount,omitempty"`
+// Defines values for DeleteApiAdminLimitsScopeSubjectParamsScope.
+const (
+	DeleteApiAdminLimitsScopeSubjectParamsScopeRole DeleteApiAdminLimitsScopeSubjectParamsScope = "role"
+	DeleteApiAdminLimitsScopeSubjectParamsScopeUser DeleteApiAdminLimitsScopeSubjectParamsScope = "user"
+)
 
-			// FromUser Имя пользователя, который отправил монеты.
-			FromUser *string `json:"fromUser,omitempty"`
-		} `json:"received,omitempty"`
-		Sent *[]struct {
-			// Amount Количество отправленных монет.
-			Amount *int `json:"amount,omitempty"`
+// Defines values for PutApiAdminLimitsScopeSubjectParamsScope.
+const (
+	Role PutApiAdminLimitsScopeSubjectParamsScope = "role"
+	User PutApiAdminLimitsScopeSubjectParamsScope = "user"
+)
 
-			// ToUser Имя пользователя, которому отправлены монеты.
-			ToUser *string `json:"toUser,omitempty"`
-		} `json:"sent,omitempty"`
-	} `json:"coinHistory,omitempty"`
+// APIKey defines model for APIKey.
+type APIKey struct {
+	// CreatedAt Время выпуска ключа.
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
-	Inventory *[]struct {
-		// Quantity Количество предметов.
-		Quantity *int `json:"quantity,omitempty"`
+	// CreatedBy Администратор, выпустивший ключ.
+	CreatedBy string `json:"createdBy"`
 
-		// Type Тип предмета.
-		Type *string `json:"type,omitempty"`
-	} `json:"inventory,omitempty"`
-}
+	// ExpiresAt Время, до которого ключ действителен. Отсутствует у бессрочных ключей.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
-// SendCoinRequest defines model for SendCoinRequest.
-type SendCoinRequest struct {
-	// Amount Количество монет, которые необходимо отправить.
-	Amount int `json:"amount"`
+	// Id Идентификатор ключа.
+	Id int32 `json:"id"`
 
-	// ToUser Имя пользователя, которому нужно отправить монеты.
-	ToUser string `json:"toUser"`
-}
+	// Key Ключ целиком. Возвращается только при выпуске ключа.
+	Key *string `json:"key,omitempty"`
 
-// PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
-type PostApiAuthJSONRequestBody = AuthRequest
+	// LastUsedAt Время последнего использования ключа. Обновляется раз в минуту, поэтому может отставать от фактического.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
 
-// PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
-type PostApiSendCoinJSONRequestBody = SendCoinRequest
+	// Prefix Начало ключа, по которому его можно узнать.
+	Prefix string `json:"prefix"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostApiAuth(c *gin.Context)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetApiBuyItem(c *gin.Context, item string)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetApiInfo(c *gin.Context)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostApiSendCoin(c *gin.Context)
-}
+	// RevokedAt Время отзыва ключа.
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	// Scopes Области доступа ключа, например coins:grant и info:read.
+	Scopes []string `json:"scopes"`
+
+	// ServiceAccount Сервисный аккаунт, которому принадлежит ключ.
+	ServiceAccount string `json:"serviceAccount"`
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// AdjustBalanceRequest defines model for AdjustBalanceRequest.
+type AdjustBalanceRequest struct {
+	// Amount Сумма корректировки; отрицательная сумма списывает монеты.
+	Amount int `json:"amount"`
 
-// PostApiAuth operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAuth(c *gin.Context) {
+	// Currency Валюта корректировки, по умолчанию монеты.
+	Currency *string `json:"currency,omitempty"`
 
-	c.Set(BearerAuthScopes, []string{})
+	// Reason Причина корректировки.
+	Reason *string `json:"reason,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// Allowance defines model for Allowance.
+type Allowance struct {
+	// Limit Лимит за период.
+	Limit int `json:"limit"`
 
-	siw.Handler.PostApiAuth(c)
+	// Remaining Остаток лимита в текущем периоде.
+	Remaining int `json:"remaining"`
 }
 
-// GetApiBuyItem operation middleware
-func (siw *ServerInterfaceWrapper) GetApiBuyItem(c *gin.Context) {
+// Auction defines model for Auction.
+type Auction struct {
+	// Bids Ставки, старые первыми.
+	Bids *[]AuctionBid `json:"bids,omitempty"`
 
-	var err error
+	// Closed Закрыт ли аукцион.
+	Closed bool `json:"closed"`
 
-	// ------------- Path parameter "item" -------------
-	var item string
+	// CreatedAt Время создания.
+	CreatedAt time.Time `json:"createdAt"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
-		return
-	}
+	// CreatedBy Администратор, создавший аукцион.
+	CreatedBy string `json:"createdBy"`
 
-	c.Set(BearerAuthScopes, []string{})
+	// EndsAt Время завершения аукциона.
+	EndsAt time.Time `json:"endsAt"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// HighestBid Наибольшая ставка.
+	HighestBid *int `json:"highestBid,omitempty"`
 
-	siw.Handler.GetApiBuyItem(c, item)
-}
+	// HighestBidder Пользователь, сделавший наибольшую ставку.
+	HighestBidder *string `json:"highestBidder,omitempty"`
 
-// GetApiInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetApiInfo(c *gin.Context) {
+	// Id Идентификатор аукциона.
+	Id int32 `json:"id"`
 
-	c.Set(BearerAuthScopes, []string{})
+	// Item Тип предмета.
+	Item string `json:"item"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// ReservePrice Минимальная ставка.
+	ReservePrice int `json:"reservePrice"`
 
-	siw.Handler.GetApiInfo(c)
+	// Winner Победитель закрытого аукциона.
+	Winner *string `json:"winner,omitempty"`
 }
 
-// PostApiSendCoin operation middleware
-func (siw *ServerInterfaceWrapper) PostApiSendCoin(c *gin.Context) {
+// AuctionBid defines model for AuctionBid.
+type AuctionBid struct {
+	// Amount Размер ставки.
+	Amount int `json:"amount"`
 
-	c.Set(BearerAuthScopes, []string{})
+	// CreatedAt Время ставки.
+	CreatedAt time.Time `json:"createdAt"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Released Ставка перебита, и ее монеты возвращены.
+	Released bool `json:"released"`
 
-	siw.Handler.PostApiSendCoin(c)
+	// Username Пользователь, сделавший ставку.
+	Username string `json:"username"`
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action Действие.
+	Action string `json:"action"`
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	// Actor Пользователь, выполнивший действие, или system для действий из командной строки.
+	Actor string `json:"actor"`
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// After Значения после изменения.
+	After *map[string]interface{} `json:"after,omitempty"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
+	// Before Значения до изменения.
+	Before *map[string]interface{} `json:"before,omitempty"`
 
-	router.POST(options.BaseURL+"/api/auth", wrapper.PostApiAuth)
-	router.GET(options.BaseURL+"/api/buy/:item", wrapper.GetApiBuyItem)
-	router.GET(options.BaseURL+"/api/info", wrapper.GetApiInfo)
-	router.POST(options.BaseURL+"/api/sendCoin", wrapper.PostApiSendCoin)
+	// CreatedAt Время действия.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Hash HMAC-SHA256 записи вместе с хешем предыдущей, с ключом вне базы данных. Пустой, пока запись не добавлена в цепочку; записи добавляются в цепочку в фоне в течение нескольких секунд.
+	Hash string `json:"hash"`
+
+	// Ip IP-адрес клиента.
+	Ip *string `json:"ip,omitempty"`
+
+	// PrevHash Хеш предыдущей записи в цепочке. Пустой, пока запись не добавлена в цепочку.
+	PrevHash string `json:"prevHash"`
+
+	// RequestId Идентификатор запроса (заголовок X-Request-ID).
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Seq Номер записи. Номера возрастают, но могут идти с пропусками.
+	Seq int64 `json:"seq"`
+
+	// Target Объект действия.
+	Target string `json:"target"`
+
+	// UserAgent User-Agent клиента.
+	UserAgent *string `json:"userAgent,omitempty"`
 }
 
-type PostApiAuthRequestObject struct {
-	Body *PostApiAuthJSONRequestBody
+// AuditVerification defines model for AuditVerification.
+type AuditVerification struct {
+	// BrokenSeq Номер первой записи, не соответствующей цепочке.
+	BrokenSeq *int64 `json:"brokenSeq,omitempty"`
+
+	// Checked Количество проверенных записей. Записи, еще не добавленные в цепочку, не проверяются.
+	Checked int64 `json:"checked"`
+
+	// Redacted Количество проверенных записей, обезличенных при удалении пользователя. Их содержимое сверяется с хешем, вычисленным при обезличивании.
+	Redacted int64 `json:"redacted"`
+
+	// Valid Цепочка не нарушена.
+	Valid bool `json:"valid"`
 }
 
-type PostApiAuthResponseObject interface {
-	VisitPostApiAuthResponse(w http.ResponseWriter) error
+// AuthRequest defines model for AuthRequest.
+type AuthRequest struct {
+	// Password Пароль для аутентификации.
+	Password string `json:"password"`
+
+	// Username Имя пользователя для аутентификации.
+	Username string `json:"username"`
 }
 
-type PostApiAuth200JSONResponse AuthResponse
+// AuthResponse defines model for AuthResponse.
+type AuthResponse struct {
+	// ChallengeExpiresAt Время, до которого нужно завершить вход.
+	ChallengeExpiresAt *time.Time `json:"challengeExpiresAt,omitempty"`
 
-func (response PostApiAuth200JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ChallengeToken Токен для завершения входа вторым фактором.
+	ChallengeToken *string `json:"challengeToken,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// EnrollmentRequired Требуется ли сначала настроить TOTP через /api/auth/mfa/enroll. Устанавливается для администраторов без двухфакторной аутентификации.
+	EnrollmentRequired *bool `json:"enrollmentRequired,omitempty"`
 
-type PostApiAuth400JSONResponse ErrorResponse
+	// MfaRequired Требуется ли второй фактор. Вход завершается запросом /api/auth/mfa с challengeToken.
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
 
-func (response PostApiAuth400JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// RecoveryCodes Коды восстановления, если TOTP был настроен при этом входе. Показываются только один раз.
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Token JWT-токен для доступа к защищенным ресурсам. Отсутствует, если требуется второй фактор.
+	Token *string `json:"token,omitempty"`
 }
 
-type PostApiAuth401JSONResponse ErrorResponse
+// Balance defines model for Balance.
+type Balance struct {
+	// Amount Доступный остаток.
+	Amount int `json:"amount"`
 
-func (response PostApiAuth401JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Currency Код валюты.
+	Currency string `json:"currency"`
 
-	return json.NewEncoder(w).Encode(response)
+	// NextExpiresAt Когда сгорает ближайшая часть остатка.
+	NextExpiresAt *time.Time `json:"nextExpiresAt,omitempty"`
 }
 
-type PostApiAuth500JSONResponse ErrorResponse
+// CatalogItem defines model for CatalogItem.
+type CatalogItem struct {
+	// Category Категория предмета.
+	Category *string `json:"category,omitempty"`
 
-func (response PostApiAuth500JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Currency Валюта цены.
+	Currency string `json:"currency"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Description Описание предмета.
+	Description *string `json:"description,omitempty"`
 
-type GetApiBuyItemRequestObject struct {
+	// Images Ссылки на изображения.
+	Images *[]string `json:"images,omitempty"`
+
+	// Item Тип предмета.
 	Item string `json:"item"`
+
+	// Name Отображаемое название.
+	Name string `json:"name"`
+
+	// Price Цена предмета.
+	Price    int               `json:"price"`
+	Variants *[]ProductVariant `json:"variants,omitempty"`
 }
 
-type GetApiBuyItemResponseObject interface {
-	VisitGetApiBuyItemResponse(w http.ResponseWriter) error
+// Category defines model for Category.
+type Category struct {
+	// Name Отображаемое название категории.
+	Name string `json:"name"`
+
+	// Slug Идентификатор категории.
+	Slug string `json:"slug"`
 }
 
-type GetApiBuyItem200Response struct {
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	// CurrentPassword Текущий пароль.
+	CurrentPassword string `json:"currentPassword"`
+
+	// NewPassword Новый пароль, не короче 8 символов.
+	NewPassword string `json:"newPassword"`
 }
 
-func (response GetApiBuyItem200Response) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	// ExpiresAt Время, до которого ключ действителен. Без него ключ бессрочный.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Scopes Области доступа ключа, coins:grant и/или info:read.
+	Scopes []string `json:"scopes"`
 }
 
-type GetApiBuyItem400JSONResponse ErrorResponse
+// CreateAuctionRequest defines model for CreateAuctionRequest.
+type CreateAuctionRequest struct {
+	// EndsAt Время завершения аукциона.
+	EndsAt time.Time `json:"endsAt"`
 
-func (response GetApiBuyItem400JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Item Тип предмета.
+	Item string `json:"item"`
 
-	return json.NewEncoder(w).Encode(response)
+	// ReservePrice Минимальная ставка.
+	ReservePrice int `json:"reservePrice"`
 }
 
-type GetApiBuyItem401JSONResponse ErrorResponse
+// CreateCurrencyRequest defines model for CreateCurrencyRequest.
+type CreateCurrencyRequest struct {
+	// Code Код валюты.
+	Code string `json:"code"`
 
-func (response GetApiBuyItem401JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// LifetimeDays Через сколько дней сгорает каждое начисление. Не задается для несгораемых валют.
+	LifetimeDays *int `json:"lifetimeDays,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Name Отображаемое название.
+	Name string `json:"name"`
+
+	// Transferable Можно ли переводить валюту другим пользователям.
+	Transferable bool `json:"transferable"`
 }
 
-type GetApiBuyItem500JSONResponse ErrorResponse
+// CreateGroupPurchaseRequest defines model for CreateGroupPurchaseRequest.
+type CreateGroupPurchaseRequest struct {
+	// Deadline Срок, до которого нужно собрать цену.
+	Deadline time.Time `json:"deadline"`
 
-func (response GetApiBuyItem500JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Recipient Получатель подарка.
+	Recipient string `json:"recipient"`
+
+	// Variant Артикул (SKU) варианта, обязателен для предметов с вариантами.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreatePromoCodeRequest defines model for CreatePromoCodeRequest.
+type CreatePromoCodeRequest struct {
+	// AmountOff Скидка в монетах. Задается либо она, либо percentOff.
+	AmountOff *int `json:"amountOff,omitempty"`
+
+	// Category Категория, к предметам которой применим промокод.
+	Category *string `json:"category,omitempty"`
+
+	// Code Промокод.
+	Code string `json:"code"`
+
+	// EndsAt Окончание действия промокода.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// Item Предмет, к которому применим промокод. Не задан, если промокод действует на все предметы.
+	Item *string `json:"item,omitempty"`
+
+	// MaxUses Сколько раз всего можно применить промокод. Не задано для неограниченного числа применений.
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// PerUserLimit Сколько раз промокод может применить один пользователь.
+	PerUserLimit *int `json:"perUserLimit,omitempty"`
+
+	// PercentOff Скидка в процентах. Задается либо она, либо amountOff.
+	PercentOff *int `json:"percentOff,omitempty"`
+
+	// StartsAt Начало действия промокода.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+}
+
+// CreateSaleRequest defines model for CreateSaleRequest.
+type CreateSaleRequest struct {
+	// AmountOff Скидка в монетах. Задается либо она, либо percentOff.
+	AmountOff *int `json:"amountOff,omitempty"`
+
+	// Category Категория, на предметы которой действует распродажа.
+	Category *string `json:"category,omitempty"`
+
+	// EndsAt Окончание распродажи.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Item Предмет, на который действует распродажа. Задается либо он, либо категория.
+	Item *string `json:"item,omitempty"`
+
+	// Name Название распродажи.
+	Name string `json:"name"`
+
+	// PercentOff Скидка в процентах. Задается либо она, либо amountOff.
+	PercentOff *int `json:"percentOff,omitempty"`
+
+	// StartsAt Начало распродажи.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// CreateServiceAccountRequest defines model for CreateServiceAccountRequest.
+type CreateServiceAccountRequest struct {
+	// Description Описание сервисного аккаунта.
+	Description *string `json:"description,omitempty"`
+
+	// Name Имя сервисного аккаунта.
+	Name string `json:"name"`
+}
+
+// CreateTeamRequest defines model for CreateTeamRequest.
+type CreateTeamRequest struct {
+	// Description Описание команды.
+	Description *string `json:"description,omitempty"`
+
+	// Name Название команды.
+	Name string `json:"name"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// EventTypes Типы событий — coins.transferred, item.purchased, user.registered. Пустой список означает все события.
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// Url Адрес (http или https), на который отправляются события.
+	Url string `json:"url"`
+}
+
+// Currency defines model for Currency.
+type Currency struct {
+	// Code Код валюты.
+	Code string `json:"code"`
+
+	// CreatedAt Время создания.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LifetimeDays Через сколько дней сгорает каждое начисление. Не задано для несгораемых валют.
+	LifetimeDays *int `json:"lifetimeDays,omitempty"`
+
+	// Name Отображаемое название.
+	Name string `json:"name"`
+
+	// Transferable Можно ли переводить валюту другим пользователям.
+	Transferable bool `json:"transferable"`
+}
+
+// DataExport defines model for DataExport.
+type DataExport struct {
+	// AuditEvents События журнала аудита, в которых пользователь выступает исполнителем или объектом, старые первыми.
+	AuditEvents []AuditEntry `json:"auditEvents"`
+
+	// Balances Балансы во всех валютах, монеты первыми.
+	Balances []Balance `json:"balances"`
+
+	// Coins Баланс монет.
+	Coins int `json:"coins"`
+
+	// ExportedAt Время выгрузки.
+	ExportedAt time.Time `json:"exportedAt"`
+	Profile    Profile   `json:"profile"`
+
+	// Purchases Покупки, старые первыми.
+	Purchases []Purchase `json:"purchases"`
+
+	// Role Роль пользователя.
+	Role string `json:"role"`
+
+	// Transfers Отправленные и полученные переводы, старые первыми.
+	Transfers []TransferRecord `json:"transfers"`
+}
+
+// DirectoryEntry defines model for DirectoryEntry.
+type DirectoryEntry struct {
+	// AvatarUrl Адрес изображения аватара.
+	AvatarUrl string `json:"avatarUrl"`
+
+	// Department Отдел.
+	Department string `json:"department"`
+
+	// DisplayName Отображаемое имя.
+	DisplayName string `json:"displayName"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// ErasureResponse defines model for ErasureResponse.
+type ErasureResponse struct {
+	// Pseudonym Псевдоним, которым заменено имя пользователя в истории.
+	Pseudonym string `json:"pseudonym"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Errors Сообщение об ошибке, описывающее проблему.
+	Errors *string `json:"errors,omitempty"`
+}
+
+// GrantCoinsRequest defines model for GrantCoinsRequest.
+type GrantCoinsRequest struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// Currency Валюта начисления, по умолчанию монеты.
+	Currency *string `json:"currency,omitempty"`
+
+	// Reason Причина начисления.
+	Reason *string `json:"reason,omitempty"`
+
+	// ToUser Имя пользователя, которому начисляются монеты.
+	ToUser string `json:"toUser"`
+}
+
+// GroupPurchase defines model for GroupPurchase.
+type GroupPurchase struct {
+	// CreatedAt Время создания.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Пользователь, начавший сбор.
+	CreatedBy string `json:"createdBy"`
+
+	// Deadline Срок, до которого нужно собрать цену.
+	Deadline time.Time `json:"deadline"`
+
+	// Id Идентификатор групповой покупки.
+	Id int32 `json:"id"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Pledged Сумма внесенных монет.
+	Pledged int `json:"pledged"`
+
+	// Pledges Взносы, старые первыми.
+	Pledges *[]Pledge `json:"pledges,omitempty"`
+
+	// Price Цена предмета.
+	Price int `json:"price"`
+
+	// Recipient Получатель подарка.
+	Recipient string `json:"recipient"`
+
+	// Status open — сбор идет, funded — предмет куплен, refunded — взносы возвращены.
+	Status string `json:"status"`
+
+	// Variant Артикул (SKU) варианта предмета, если он есть.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// IdentityLinkRequired defines model for IdentityLinkRequired.
+type IdentityLinkRequired struct {
+	// Errors Сообщение об ошибке.
+	Errors string `json:"errors"`
+
+	// LinkExpiresAt Время, до которого действует токен связи.
+	LinkExpiresAt *time.Time `json:"linkExpiresAt,omitempty"`
+
+	// LinkToken Токен для /api/auth/oidc/link. Передается, только если у пользователя есть пароль.
+	LinkToken *string `json:"linkToken,omitempty"`
+}
+
+// InfoResponse defines model for InfoResponse.
+type InfoResponse struct {
+	// Balances Балансы во всех валютах пользователя, монеты первыми.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CoinHistory *struct {
+		Received *[]struct {
+			// Amount Количество полученных монет.
+			Amount *int `json:"amount,omitempty"`
+
+			// Currency Валюта перевода.
+			Currency *string `json:"currency,omitempty"`
+
+			// FromUser Имя пользователя, который отправил монеты. Для переводов из кошелька команды это руководитель команды.
+			FromUser *string `json:"fromUser,omitempty"`
+
+			// Team Команда, из кошелька которой переведены монеты.
+			Team *string `json:"team,omitempty"`
+		} `json:"received,omitempty"`
+		Sent *[]struct {
+			// Amount Количество отправленных монет.
+			Amount *int `json:"amount,omitempty"`
+
+			// Currency Валюта перевода.
+			Currency *string `json:"currency,omitempty"`
+
+			// Team Команда, из кошелька которой переведены монеты, если перевод сделан руководителем команды.
+			Team *string `json:"team,omitempty"`
+
+			// ToUser Имя пользователя, которому отправлены монеты.
+			ToUser *string `json:"toUser,omitempty"`
+		} `json:"sent,omitempty"`
+	} `json:"coinHistory,omitempty"`
+
+	// Coins Количество доступных монет.
+	Coins     *int `json:"coins,omitempty"`
+	Inventory *[]struct {
+		// Quantity Количество предметов.
+		Quantity *int `json:"quantity,omitempty"`
+
+		// Type Тип предмета.
+		Type *string `json:"type,omitempty"`
+
+		// Variant Артикул (SKU) варианта предмета, если он есть.
+		Variant *string `json:"variant,omitempty"`
+	} `json:"inventory,omitempty"`
+
+	// Limits Остаток лимитов пользователя. Отсутствующее поле означает отсутствие лимита.
+	Limits *SpendingAllowance `json:"limits,omitempty"`
+}
+
+// ItemRanking defines model for ItemRanking.
+type ItemRanking struct {
+	// Coins Потрачено монет на предмет за период.
+	Coins int64 `json:"coins"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Purchases Количество покупок за период.
+	Purchases int `json:"purchases"`
+}
+
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	// Coins Сумма монет за период.
+	Coins int64 `json:"coins"`
+
+	// Transfers Количество переводов за период.
+	Transfers int `json:"transfers"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// MFAChallengeRequest defines model for MFAChallengeRequest.
+type MFAChallengeRequest struct {
+	// ChallengeToken Токен, полученный от /api/auth.
+	ChallengeToken string `json:"challengeToken"`
+}
+
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code Шестизначный код TOTP или, где это допускается, код восстановления.
+	Code string `json:"code"`
+}
+
+// MFAEnrollment defines model for MFAEnrollment.
+type MFAEnrollment struct {
+	// OtpauthUri URI otpauth://, обычно показываемый в виде QR-кода.
+	OtpauthUri string `json:"otpauthUri"`
+
+	// Secret Секрет TOTP в base32 для ручного ввода в приложение.
+	Secret string `json:"secret"`
+}
+
+// MFALoginRequest defines model for MFALoginRequest.
+type MFALoginRequest struct {
+	// ChallengeToken Токен, полученный от /api/auth.
+	ChallengeToken string `json:"challengeToken"`
+
+	// Code Шестизначный код TOTP или код восстановления.
+	Code string `json:"code"`
+}
+
+// MFAStatus defines model for MFAStatus.
+type MFAStatus struct {
+	// Enabled Включена ли двухфакторная аутентификация.
+	Enabled bool `json:"enabled"`
+
+	// RecoveryCodesLeft Сколько кодов восстановления еще не использовано.
+	RecoveryCodesLeft int `json:"recoveryCodesLeft"`
+}
+
+// MarkNotificationsReadRequest defines model for MarkNotificationsReadRequest.
+type MarkNotificationsReadRequest struct {
+	// Ids Идентификаторы уведомлений. Если не указаны, прочитанными отмечаются все уведомления.
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// CreatedAt Время события.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data Подробности события.
+	Data map[string]interface{} `json:"data"`
+
+	// Id Идентификатор уведомления.
+	Id int64 `json:"id"`
+
+	// Kind Тип события — coins_received, purchase_completed, wishlist_price_drop или wishlist_restock.
+	Kind string `json:"kind"`
+
+	// ReadAt Время прочтения, если уведомление прочитано.
+	ReadAt *time.Time `json:"readAt,omitempty"`
+}
+
+// OIDCLinkRequest defines model for OIDCLinkRequest.
+type OIDCLinkRequest struct {
+	// LinkToken Токен из ответа /api/auth/oidc/callback.
+	LinkToken string `json:"linkToken"`
+
+	// Password Пароль пользователя.
+	Password string `json:"password"`
+}
+
+// PasswordResetToken defines model for PasswordResetToken.
+type PasswordResetToken struct {
+	// ExpiresAt Время, до которого токен действителен.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Token Одноразовый токен сброса пароля. Показывается только один раз.
+	Token string `json:"token"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// PlaceBidRequest defines model for PlaceBidRequest.
+type PlaceBidRequest struct {
+	// Amount Размер ставки. Должен быть не меньше минимальной ставки и больше наибольшей.
+	Amount int `json:"amount"`
+}
+
+// Pledge defines model for Pledge.
+type Pledge struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// CreatedAt Время взноса.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Username Пользователь, внесший монеты.
+	Username string `json:"username"`
+}
+
+// PledgeRequest defines model for PledgeRequest.
+type PledgeRequest struct {
+	// Amount Количество монет, не больше недостающей суммы.
+	Amount int `json:"amount"`
+}
+
+// ProductVariant defines model for ProductVariant.
+type ProductVariant struct {
+	// Color Цвет.
+	Color *string `json:"color,omitempty"`
+
+	// Default Вариант по умолчанию, который покупается, если артикул не указан.
+	Default bool `json:"default"`
+
+	// Price Цена варианта, если она отличается от цены предмета.
+	Price *int `json:"price,omitempty"`
+
+	// Size Размер.
+	Size *string `json:"size,omitempty"`
+
+	// Sku Артикул (SKU) варианта.
+	Sku string `json:"sku"`
+
+	// Stock Остаток на складе; отсутствует, если остаток не ограничен.
+	Stock *int `json:"stock,omitempty"`
+}
+
+// Profile defines model for Profile.
+type Profile struct {
+	// Active Активна ли учетная запись.
+	Active bool `json:"active"`
+
+	// AvatarUrl Адрес изображения аватара.
+	AvatarUrl string `json:"avatarUrl"`
+
+	// Department Отдел.
+	Department string `json:"department"`
+
+	// DisplayName Отображаемое имя.
+	DisplayName string `json:"displayName"`
+
+	// Email Адрес электронной почты.
+	Email string `json:"email"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// PromoCode defines model for PromoCode.
+type PromoCode struct {
+	// AmountOff Скидка в монетах. Задается либо она, либо percentOff.
+	AmountOff *int `json:"amountOff,omitempty"`
+
+	// Category Категория, к предметам которой применим промокод.
+	Category *string `json:"category,omitempty"`
+
+	// Code Промокод.
+	Code string `json:"code"`
+
+	// EndsAt Окончание действия промокода.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// Item Предмет, к которому применим промокод. Не задан, если промокод действует на все предметы.
+	Item *string `json:"item,omitempty"`
+
+	// MaxUses Сколько раз всего можно применить промокод. Не задано для неограниченного числа применений.
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// PerUserLimit Сколько раз промокод может применить один пользователь.
+	PerUserLimit int `json:"perUserLimit"`
+
+	// PercentOff Скидка в процентах. Задается либо она, либо amountOff.
+	PercentOff *int `json:"percentOff,omitempty"`
+
+	// StartsAt Начало действия промокода.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// Uses Сколько раз промокод уже применен.
+	Uses int `json:"uses"`
+}
+
+// Purchase defines model for Purchase.
+type Purchase struct {
+	// CreatedAt Время покупки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Валюта, в которой уплачена цена.
+	Currency string `json:"currency"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Price Цена, уплаченная за предмет, с учётом скидок.
+	Price int `json:"price"`
+
+	// PromoCode Применённый промокод.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Sale Название распродажи, по которой применена скидка.
+	Sale *string `json:"sale,omitempty"`
+
+	// Variant Артикул (SKU) варианта предмета, если он есть.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	// RecoveryCodes Одноразовые коды восстановления. Показываются только один раз.
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// NewPassword Новый пароль, не короче 8 символов.
+	NewPassword string `json:"newPassword"`
+
+	// Token Одноразовый токен сброса пароля.
+	Token string `json:"token"`
+}
+
+// Sale defines model for Sale.
+type Sale struct {
+	// AmountOff Скидка в монетах. Задается либо она, либо percentOff.
+	AmountOff *int `json:"amountOff,omitempty"`
+
+	// Category Категория, на предметы которой действует распродажа.
+	Category *string `json:"category,omitempty"`
+
+	// EndsAt Окончание распродажи.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Id Идентификатор распродажи.
+	Id int32 `json:"id"`
+
+	// Item Предмет, на который действует распродажа. Задается либо он, либо категория.
+	Item *string `json:"item,omitempty"`
+
+	// Name Название распродажи.
+	Name string `json:"name"`
+
+	// PercentOff Скидка в процентах. Задается либо она, либо amountOff.
+	PercentOff *int `json:"percentOff,omitempty"`
+
+	// StartsAt Начало распродажи.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// SendCoinRequest defines model for SendCoinRequest.
+type SendCoinRequest struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// Currency Валюта перевода, по умолчанию монеты. Переводить можно только переводимые валюты.
+	Currency *string `json:"currency,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
+}
+
+// ServiceAccount defines model for ServiceAccount.
+type ServiceAccount struct {
+	// CreatedAt Время создания аккаунта.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Администратор, создавший аккаунт.
+	CreatedBy string `json:"createdBy"`
+
+	// Description Описание сервисного аккаунта.
+	Description string `json:"description"`
+
+	// Name Имя сервисного аккаунта.
+	Name string `json:"name"`
+}
+
+// SetProductCurrencyRequest defines model for SetProductCurrencyRequest.
+type SetProductCurrencyRequest struct {
+	// Currency Код валюты; coins — монеты.
+	Currency string `json:"currency"`
+}
+
+// SetProductVariantRequest defines model for SetProductVariantRequest.
+type SetProductVariantRequest struct {
+	// Color Цвет.
+	Color *string `json:"color,omitempty"`
+
+	// Default Сделать вариант вариантом по умолчанию вместо текущего.
+	Default *bool `json:"default,omitempty"`
+
+	// Price Цена варианта, если она отличается от цены предмета.
+	Price *int `json:"price,omitempty"`
+
+	// Size Размер.
+	Size *string `json:"size,omitempty"`
+
+	// Stock Остаток на складе; если не указан, остаток не ограничен.
+	Stock *int `json:"stock,omitempty"`
+}
+
+// SetTeamMemberRequest defines model for SetTeamMemberRequest.
+type SetTeamMemberRequest struct {
+	// Role Роль в команде.
+	Role SetTeamMemberRequestRole `json:"role"`
+}
+
+// SetTeamMemberRequestRole Роль в команде.
+type SetTeamMemberRequestRole string
+
+// SpendingAllowance Остаток лимитов пользователя. Отсутствующее поле означает отсутствие лимита.
+type SpendingAllowance struct {
+	DailyTransfer *Allowance `json:"dailyTransfer,omitempty"`
+
+	// MaxTransfer Максимальная сумма одного перевода.
+	MaxTransfer     *int       `json:"maxTransfer,omitempty"`
+	MonthlyPurchase *Allowance `json:"monthlyPurchase,omitempty"`
+}
+
+// SpendingLimitRule defines model for SpendingLimitRule.
+type SpendingLimitRule struct {
+	// Limits Лимиты на переводы и покупки. Дни и месяцы считаются по календарю в UTC. Отсутствующее поле означает отсутствие лимита.
+	Limits SpendingLimits `json:"limits"`
+
+	// Scope Область действия лимитов.
+	Scope SpendingLimitRuleScope `json:"scope"`
+
+	// Subject Имя пользователя или роль.
+	Subject string `json:"subject"`
+
+	// UpdatedAt Время последнего изменения.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SpendingLimitRuleScope Область действия лимитов.
+type SpendingLimitRuleScope string
+
+// SpendingLimits Лимиты на переводы и покупки. Дни и месяцы считаются по календарю в UTC. Отсутствующее поле означает отсутствие лимита.
+type SpendingLimits struct {
+	// DailyTransfer Сколько монет можно отправить коллегам за день, включая взносы в групповые покупки.
+	DailyTransfer *int `json:"dailyTransfer,omitempty"`
+
+	// MaxTransfer Максимальная сумма одного перевода.
+	MaxTransfer *int `json:"maxTransfer,omitempty"`
+
+	// MonthlyPurchase Сколько монет можно потратить на мерч за месяц. Ставки на аукционах учитываются с момента, когда они сделаны, пока их не перебьют.
+	MonthlyPurchase *int `json:"monthlyPurchase,omitempty"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// Coins Баланс кошелька команды.
+	Coins int `json:"coins"`
+
+	// CreatedAt Время создания команды.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Администратор, создавший команду.
+	CreatedBy string `json:"createdBy"`
+
+	// Description Описание команды.
+	Description string `json:"description"`
+
+	// Members Участники команды. Возвращаются только при запросе одной команды.
+	Members *[]TeamMember `json:"members,omitempty"`
+
+	// Name Название команды.
+	Name string `json:"name"`
+}
+
+// TeamDepositRequest defines model for TeamDepositRequest.
+type TeamDepositRequest struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// Reason Причина пополнения.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	// JoinedAt Время добавления в команду.
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Role Роль в команде. Руководители (lead) распоряжаются кошельком команды.
+	Role TeamMemberRole `json:"role"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// TeamMemberRole Роль в команде. Руководители (lead) распоряжаются кошельком команды.
+type TeamMemberRole string
+
+// TeamTransaction defines model for TeamTransaction.
+type TeamTransaction struct {
+	// Actor Пользователь, выполнивший операцию.
+	Actor string `json:"actor"`
+
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// CreatedAt Время операции.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор операции.
+	Id int64 `json:"id"`
+
+	// Kind Пополнение кошелька (deposit) или перевод участнику (transfer).
+	Kind TeamTransactionKind `json:"kind"`
+
+	// Reason Причина операции.
+	Reason string `json:"reason"`
+
+	// ToUser Получатель перевода.
+	ToUser *string `json:"toUser,omitempty"`
+}
+
+// TeamTransactionKind Пополнение кошелька (deposit) или перевод участнику (transfer).
+type TeamTransactionKind string
+
+// TeamTransferRequest defines model for TeamTransferRequest.
+type TeamTransferRequest struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// Reason Причина перевода.
+	Reason *string `json:"reason,omitempty"`
+
+	// ToUser Участник команды, которому переводятся монеты.
+	ToUser string `json:"toUser"`
+}
+
+// TransferRecord defines model for TransferRecord.
+type TransferRecord struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// CreatedAt Время перевода.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Валюта перевода.
+	Currency string `json:"currency"`
+
+	// FromUser Отправитель. Для переводов из кошелька команды это руководитель команды.
+	FromUser string `json:"fromUser"`
+
+	// Team Команда, из кошелька которой переведены монеты.
+	Team *string `json:"team,omitempty"`
+
+	// ToUser Получатель.
+	ToUser string `json:"toUser"`
+}
+
+// UnreadCountResponse defines model for UnreadCountResponse.
+type UnreadCountResponse struct {
+	// Count Количество непрочитанных уведомлений.
+	Count int `json:"count"`
+}
+
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
+	// AvatarUrl Абсолютный http(s) адрес изображения аватара.
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Department Отдел.
+	Department *string `json:"department,omitempty"`
+
+	// DisplayName Отображаемое имя.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Email Адрес электронной почты.
+	Email *string `json:"email,omitempty"`
+}
+
+// UserStats defines model for UserStats.
+type UserStats struct {
+	// ColleaguesThanked Количество разных коллег, которым пользователь отправлял монеты.
+	ColleaguesThanked int `json:"colleaguesThanked"`
+
+	// TotalReceived Получено монет от коллег.
+	TotalReceived int64 `json:"totalReceived"`
+
+	// TotalSent Отправлено монет коллегам.
+	TotalSent int64 `json:"totalSent"`
+
+	// TotalSpent Потрачено монет на мерч.
+	TotalSpent int64 `json:"totalSpent"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Количество выполненных попыток.
+	Attempts int `json:"attempts"`
+
+	// CreatedAt Время создания доставки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt Время успешной доставки.
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Идентификатор события.
+	EventId int64 `json:"eventId"`
+
+	// EventType Тип события.
+	EventType string `json:"eventType"`
+
+	// Id Идентификатор доставки.
+	Id int64 `json:"id"`
+
+	// LastError Ошибка последней попытки.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP-код ответа последней попытки.
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Время следующей попытки для ожидающих доставок.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Status Статус доставки — pending, delivered или dead.
+	Status string `json:"status"`
+}
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// Active Отправляются ли события по подписке.
+	Active bool `json:"active"`
+
+	// CreatedAt Время создания подписки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Администратор, создавший подписку.
+	CreatedBy string `json:"createdBy"`
+
+	// EventTypes Типы событий подписки, пустой список означает все события.
+	EventTypes []string `json:"eventTypes"`
+
+	// Id Идентификатор подписки.
+	Id int32 `json:"id"`
+
+	// Secret Секрет для проверки HMAC-SHA256 подписи в заголовке X-Merch-Signature.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес получателя.
+	Url string `json:"url"`
+}
+
+// WishlistItem defines model for WishlistItem.
+type WishlistItem struct {
+	// AddedAt Время добавления в список.
+	AddedAt time.Time `json:"addedAt"`
+
+	// Currency Валюта, в которой указаны цена и недостающая сумма.
+	Currency string `json:"currency"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// MissingCoins Сколько валюты предмета не хватает для покупки при текущем балансе в этой валюте.
+	MissingCoins int `json:"missingCoins"`
+
+	// Price Текущая цена предмета с учетом самого дешевого варианта и действующих распродаж.
+	Price int `json:"price"`
+}
+
+// DeleteApiAdminLimitsScopeSubjectParamsScope defines parameters for DeleteApiAdminLimitsScopeSubject.
+type DeleteApiAdminLimitsScopeSubjectParamsScope string
+
+// PutApiAdminLimitsScopeSubjectParamsScope defines parameters for PutApiAdminLimitsScopeSubject.
+type PutApiAdminLimitsScopeSubjectParamsScope string
+
+// GetApiAdminReportsKindParams defines parameters for GetApiAdminReportsKind.
+type GetApiAdminReportsKindParams struct {
+	// From Начало периода включительно. По умолчанию — без ограничения.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его. По умолчанию — без ограничения.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Формат — csv (по умолчанию), jsonl или xlsx (CSV для импорта в электронные таблицы).
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiAdminWebhooksIdDeliveriesParams defines parameters for GetApiAdminWebhooksIdDeliveries.
+type GetApiAdminWebhooksIdDeliveriesParams struct {
+	// Status Фильтр по статусу доставки — pending, delivered или dead.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Максимальное количество записей (по умолчанию 50).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiAuditParams defines parameters for GetApiAudit.
+type GetApiAuditParams struct {
+	// Actor Фильтр по пользователю, выполнившему действие.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Фильтр по действию, например auth.login_failed или coins.transferred.
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Target Фильтр по объекту действия.
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
+
+	// From Начало периода включительно.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// BeforeSeq Вернуть записи старше указанной, для постраничного вывода.
+	BeforeSeq *int64 `form:"beforeSeq,omitempty" json:"beforeSeq,omitempty"`
+
+	// Limit Максимальное количество записей (по умолчанию 50, не больше 500).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiBuyItemParams defines parameters for GetApiBuyItem.
+type GetApiBuyItemParams struct {
+	// Variant Артикул (SKU) варианта предмета, например размера или цвета. Если не указан, покупается вариант по умолчанию; для предметов с вариантами, но без варианта по умолчанию, обязателен.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `form:"promoCode,omitempty" json:"promoCode,omitempty"`
+}
+
+// GetApiCatalogParams defines parameters for GetApiCatalog.
+type GetApiCatalogParams struct {
+	// Category Категория товаров.
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+
+	// MinPrice Минимальная цена.
+	MinPrice *int `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Максимальная цена.
+	MaxPrice *int `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Sort Сортировка — price (по возрастанию цены), -price (по убыванию цены) или name (по названию).
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Lang Язык названий и описаний — ru (по умолчанию) или en.
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// GetApiCategoriesParams defines parameters for GetApiCategories.
+type GetApiCategoriesParams struct {
+	// Lang Язык названий — ru (по умолчанию) или en.
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// GetApiLeaderboardItemsParams defines parameters for GetApiLeaderboardItems.
+type GetApiLeaderboardItemsParams struct {
+	// From Начало периода включительно. По умолчанию — без ограничения.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его. По умолчанию — без ограничения.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество мест в рейтинге (по умолчанию 10, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiLeaderboardReceiversParams defines parameters for GetApiLeaderboardReceivers.
+type GetApiLeaderboardReceiversParams struct {
+	// From Начало периода включительно. По умолчанию — без ограничения.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его. По умолчанию — без ограничения.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество мест в рейтинге (по умолчанию 10, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiLeaderboardSendersParams defines parameters for GetApiLeaderboardSenders.
+type GetApiLeaderboardSendersParams struct {
+	// From Начало периода включительно. По умолчанию — без ограничения.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его. По умолчанию — без ограничения.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество мест в рейтинге (по умолчанию 10, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiNotificationsParams defines parameters for GetApiNotifications.
+type GetApiNotificationsParams struct {
+	// Limit Максимальное количество уведомлений (по умолчанию 50).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// UnreadOnly Вернуть только непрочитанные уведомления.
+	UnreadOnly *bool `form:"unreadOnly,omitempty" json:"unreadOnly,omitempty"`
+
+	// BeforeId Вернуть уведомления старше указанного, для постраничного вывода.
+	BeforeId *int64 `form:"beforeId,omitempty" json:"beforeId,omitempty"`
+}
+
+// GetApiStatsUsernameParams defines parameters for GetApiStatsUsername.
+type GetApiStatsUsernameParams struct {
+	// From Начало периода включительно. По умолчанию — без ограничения.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включая его. По умолчанию — без ограничения.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetApiTeamsNameTransactionsParams defines parameters for GetApiTeamsNameTransactions.
+type GetApiTeamsNameTransactionsParams struct {
+	// Limit Максимальное количество операций в ответе.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiUsersParams defines parameters for GetApiUsers.
+type GetApiUsersParams struct {
+	// Q Подстрока для поиска без учета регистра. Без нее возвращаются все активные пользователи.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Limit Максимальное количество пользователей в ответе.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostApiAdminAuctionsJSONRequestBody defines body for PostApiAdminAuctions for application/json ContentType.
+type PostApiAdminAuctionsJSONRequestBody = CreateAuctionRequest
+
+// PostApiAdminCurrenciesJSONRequestBody defines body for PostApiAdminCurrencies for application/json ContentType.
+type PostApiAdminCurrenciesJSONRequestBody = CreateCurrencyRequest
+
+// PutApiAdminLimitsScopeSubjectJSONRequestBody defines body for PutApiAdminLimitsScopeSubject for application/json ContentType.
+type PutApiAdminLimitsScopeSubjectJSONRequestBody = SpendingLimits
+
+// PutApiAdminProductsItemCurrencyJSONRequestBody defines body for PutApiAdminProductsItemCurrency for application/json ContentType.
+type PutApiAdminProductsItemCurrencyJSONRequestBody = SetProductCurrencyRequest
+
+// PutApiAdminProductsItemVariantsSkuJSONRequestBody defines body for PutApiAdminProductsItemVariantsSku for application/json ContentType.
+type PutApiAdminProductsItemVariantsSkuJSONRequestBody = SetProductVariantRequest
+
+// PostApiAdminPromoCodesJSONRequestBody defines body for PostApiAdminPromoCodes for application/json ContentType.
+type PostApiAdminPromoCodesJSONRequestBody = CreatePromoCodeRequest
+
+// PostApiAdminSalesJSONRequestBody defines body for PostApiAdminSales for application/json ContentType.
+type PostApiAdminSalesJSONRequestBody = CreateSaleRequest
+
+// PostApiAdminServiceAccountsJSONRequestBody defines body for PostApiAdminServiceAccounts for application/json ContentType.
+type PostApiAdminServiceAccountsJSONRequestBody = CreateServiceAccountRequest
+
+// PostApiAdminServiceAccountsNameKeysJSONRequestBody defines body for PostApiAdminServiceAccountsNameKeys for application/json ContentType.
+type PostApiAdminServiceAccountsNameKeysJSONRequestBody = CreateAPIKeyRequest
+
+// PostApiAdminTeamsJSONRequestBody defines body for PostApiAdminTeams for application/json ContentType.
+type PostApiAdminTeamsJSONRequestBody = CreateTeamRequest
+
+// PostApiAdminTeamsNameDepositJSONRequestBody defines body for PostApiAdminTeamsNameDeposit for application/json ContentType.
+type PostApiAdminTeamsNameDepositJSONRequestBody = TeamDepositRequest
+
+// PutApiAdminTeamsNameMembersUsernameJSONRequestBody defines body for PutApiAdminTeamsNameMembersUsername for application/json ContentType.
+type PutApiAdminTeamsNameMembersUsernameJSONRequestBody = SetTeamMemberRequest
+
+// PostApiAdminUsersUsernameBalanceJSONRequestBody defines body for PostApiAdminUsersUsernameBalance for application/json ContentType.
+type PostApiAdminUsersUsernameBalanceJSONRequestBody = AdjustBalanceRequest
+
+// PostApiAdminWebhooksJSONRequestBody defines body for PostApiAdminWebhooks for application/json ContentType.
+type PostApiAdminWebhooksJSONRequestBody = CreateWebhookRequest
+
+// PostApiAuctionsIdBidsJSONRequestBody defines body for PostApiAuctionsIdBids for application/json ContentType.
+type PostApiAuctionsIdBidsJSONRequestBody = PlaceBidRequest
+
+// PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
+type PostApiAuthJSONRequestBody = AuthRequest
+
+// PostApiAuthMfaJSONRequestBody defines body for PostApiAuthMfa for application/json ContentType.
+type PostApiAuthMfaJSONRequestBody = MFALoginRequest
+
+// PostApiAuthMfaEnrollJSONRequestBody defines body for PostApiAuthMfaEnroll for application/json ContentType.
+type PostApiAuthMfaEnrollJSONRequestBody = MFAChallengeRequest
+
+// PostApiAuthOidcLinkJSONRequestBody defines body for PostApiAuthOidcLink for application/json ContentType.
+type PostApiAuthOidcLinkJSONRequestBody = OIDCLinkRequest
+
+// PostApiCoinsGrantJSONRequestBody defines body for PostApiCoinsGrant for application/json ContentType.
+type PostApiCoinsGrantJSONRequestBody = GrantCoinsRequest
+
+// PostApiGroupPurchasesJSONRequestBody defines body for PostApiGroupPurchases for application/json ContentType.
+type PostApiGroupPurchasesJSONRequestBody = CreateGroupPurchaseRequest
+
+// PostApiGroupPurchasesIdPledgesJSONRequestBody defines body for PostApiGroupPurchasesIdPledges for application/json ContentType.
+type PostApiGroupPurchasesIdPledgesJSONRequestBody = PledgeRequest
+
+// PostApiMfaConfirmJSONRequestBody defines body for PostApiMfaConfirm for application/json ContentType.
+type PostApiMfaConfirmJSONRequestBody = MFACodeRequest
+
+// PostApiMfaDisableJSONRequestBody defines body for PostApiMfaDisable for application/json ContentType.
+type PostApiMfaDisableJSONRequestBody = MFACodeRequest
+
+// PostApiMfaRecoveryCodesJSONRequestBody defines body for PostApiMfaRecoveryCodes for application/json ContentType.
+type PostApiMfaRecoveryCodesJSONRequestBody = MFACodeRequest
+
+// PostApiNotificationsReadJSONRequestBody defines body for PostApiNotificationsRead for application/json ContentType.
+type PostApiNotificationsReadJSONRequestBody = MarkNotificationsReadRequest
+
+// PostApiPasswordJSONRequestBody defines body for PostApiPassword for application/json ContentType.
+type PostApiPasswordJSONRequestBody = ChangePasswordRequest
+
+// PostApiPasswordResetJSONRequestBody defines body for PostApiPasswordReset for application/json ContentType.
+type PostApiPasswordResetJSONRequestBody = ResetPasswordRequest
+
+// PutApiProfileJSONRequestBody defines body for PutApiProfile for application/json ContentType.
+type PutApiProfileJSONRequestBody = UpdateProfileRequest
+
+// PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
+type PostApiSendCoinJSONRequestBody = SendCoinRequest
+
+// PostApiTeamsNameTransferJSONRequestBody defines body for PostApiTeamsNameTransfer for application/json ContentType.
+type PostApiTeamsNameTransferJSONRequestBody = TeamTransferRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Отозвать API-ключ (только для администраторов).
+	// (DELETE /api/admin/apiKeys/{id})
+	DeleteApiAdminApiKeysId(c *gin.Context, id int32)
+	// Создать аукцион предмета (только для администраторов). Аукцион закрывается автоматически по истечении срока.
+	// (POST /api/admin/auctions)
+	PostApiAdminAuctions(c *gin.Context)
+	// Создать валюту, например непереводимые бонусные баллы (только для администраторов).
+	// (POST /api/admin/currencies)
+	PostApiAdminCurrencies(c *gin.Context)
+	// Получить лимиты на переводы и покупки для пользователей и ролей (только для администраторов).
+	// (GET /api/admin/limits)
+	GetApiAdminLimits(c *gin.Context)
+	// Удалить лимиты пользователя или роли (только для администраторов).
+	// (DELETE /api/admin/limits/{scope}/{subject})
+	DeleteApiAdminLimitsScopeSubject(c *gin.Context, scope DeleteApiAdminLimitsScopeSubjectParamsScope, subject string)
+	// Задать лимиты пользователя или роли (только для администраторов). Собственные лимиты пользователя имеют приоритет над лимитами его роли.
+	// (PUT /api/admin/limits/{scope}/{subject})
+	PutApiAdminLimitsScopeSubject(c *gin.Context, scope PutApiAdminLimitsScopeSubjectParamsScope, subject string)
+	// Изменить валюту, в которой указана цена предмета (только для администраторов). Сама цена не меняется.
+	// (PUT /api/admin/products/{item}/currency)
+	PutApiAdminProductsItemCurrency(c *gin.Context, item string)
+	// Добавить вариант предмета или изменить его размер, цвет, остаток и цену (только для администраторов).
+	// (PUT /api/admin/products/{item}/variants/{sku})
+	PutApiAdminProductsItemVariantsSku(c *gin.Context, item string, sku string)
+	// Получить список промокодов (только для администраторов).
+	// (GET /api/admin/promoCodes)
+	GetApiAdminPromoCodes(c *gin.Context)
+	// Создать промокод (только для администраторов).
+	// (POST /api/admin/promoCodes)
+	PostApiAdminPromoCodes(c *gin.Context)
+	// Удалить промокод, который еще не использовали (только для администраторов).
+	// (DELETE /api/admin/promoCodes/{code})
+	DeleteApiAdminPromoCodesCode(c *gin.Context, code string)
+	// Выгрузить отчёт о переводах, покупках или балансах за период (только для администраторов).
+	// (GET /api/admin/reports/{kind})
+	GetApiAdminReportsKind(c *gin.Context, kind string, params GetApiAdminReportsKindParams)
+	// Получить список распродаж, включая прошедшие и будущие (только для администраторов).
+	// (GET /api/admin/sales)
+	GetApiAdminSales(c *gin.Context)
+	// Запланировать распродажу предмета или категории (только для администраторов).
+	// (POST /api/admin/sales)
+	PostApiAdminSales(c *gin.Context)
+	// Удалить распродажу, по которой еще не было покупок (только для администраторов).
+	// (DELETE /api/admin/sales/{id})
+	DeleteApiAdminSalesId(c *gin.Context, id int32)
+	// Получить список сервисных аккаунтов (только для администраторов).
+	// (GET /api/admin/serviceAccounts)
+	GetApiAdminServiceAccounts(c *gin.Context)
+	// Создать сервисный аккаунт (только для администраторов).
+	// (POST /api/admin/serviceAccounts)
+	PostApiAdminServiceAccounts(c *gin.Context)
+	// Получить список API-ключей сервисного аккаунта, включая отозванные (только для администраторов).
+	// (GET /api/admin/serviceAccounts/{name}/keys)
+	GetApiAdminServiceAccountsNameKeys(c *gin.Context, name string)
+	// Выпустить API-ключ сервисного аккаунта (только для администраторов). Ключ возвращается только в этом ответе.
+	// (POST /api/admin/serviceAccounts/{name}/keys)
+	PostApiAdminServiceAccountsNameKeys(c *gin.Context, name string)
+	// Создать команду с пустым кошельком (только для администраторов).
+	// (POST /api/admin/teams)
+	PostApiAdminTeams(c *gin.Context)
+	// Пополнить кошелек команды новыми монетами (только для администраторов).
+	// (POST /api/admin/teams/{name}/deposit)
+	PostApiAdminTeamsNameDeposit(c *gin.Context, name string)
+	// Исключить пользователя из команды (только для администраторов).
+	// (DELETE /api/admin/teams/{name}/members/{username})
+	DeleteApiAdminTeamsNameMembersUsername(c *gin.Context, name string, username string)
+	// Добавить пользователя в команду или изменить его роль в ней (только для администраторов).
+	// (PUT /api/admin/teams/{name}/members/{username})
+	PutApiAdminTeamsNameMembersUsername(c *gin.Context, name string, username string)
+	// Скорректировать баланс пользователя (только для администраторов). Отрицательная сумма списывает монеты; пользователь получает уведомление.
+	// (POST /api/admin/users/{username}/balance)
+	PostApiAdminUsersUsernameBalance(c *gin.Context, username string)
+	// Деактивировать пользователя (только для администраторов). Пользователь больше не может войти и получать монеты, история сохраняется.
+	// (POST /api/admin/users/{username}/deactivate)
+	PostApiAdminUsersUsernameDeactivate(c *gin.Context, username string)
+	// Удалить пользователя по его запросу (только для администраторов). Имя пользователя в истории переводов и покупок и в журнале аудита заменяется псевдонимом, IP-адреса и User-Agent его записей аудита стираются, личные данные удаляются. Зарегистрироваться под этим именем повторно нельзя.
+	// (POST /api/admin/users/{username}/erase)
+	PostApiAdminUsersUsernameErase(c *gin.Context, username string)
+	// Сбросить двухфакторную аутентификацию пользователя, потерявшего доступ к ней (только для администраторов). Администратор настраивает ее заново при следующем входе.
+	// (DELETE /api/admin/users/{username}/mfa)
+	DeleteApiAdminUsersUsernameMfa(c *gin.Context, username string)
+	// Выдать одноразовый токен сброса пароля пользователя (только для администраторов). Ранее выданные неиспользованные токены отзываются.
+	// (POST /api/admin/users/{username}/passwordReset)
+	PostApiAdminUsersUsernamePasswordReset(c *gin.Context, username string)
+	// Снова активировать деактивированного пользователя (только для администраторов).
+	// (POST /api/admin/users/{username}/reactivate)
+	PostApiAdminUsersUsernameReactivate(c *gin.Context, username string)
+	// Снять блокировку входа пользователя после неудачных попыток (только для администраторов).
+	// (POST /api/admin/users/{username}/unlock)
+	PostApiAdminUsersUsernameUnlock(c *gin.Context, username string)
+	// Получить список подписок на вебхуки (только для администраторов).
+	// (GET /api/admin/webhooks)
+	GetApiAdminWebhooks(c *gin.Context)
+	// Создать подписку на вебхуки (только для администраторов).
+	// (POST /api/admin/webhooks)
+	PostApiAdminWebhooks(c *gin.Context)
+	// Повторно отправить доставку, исчерпавшую попытки (только для администраторов).
+	// (POST /api/admin/webhooks/deliveries/{deliveryId}/retry)
+	PostApiAdminWebhooksDeliveriesDeliveryIdRetry(c *gin.Context, deliveryId int64)
+	// Удалить подписку на вебхуки вместе с журналом доставок (только для администраторов).
+	// (DELETE /api/admin/webhooks/{id})
+	DeleteApiAdminWebhooksId(c *gin.Context, id int32)
+	// Получить журнал доставок подписки, начиная с самых новых (только для администраторов).
+	// (GET /api/admin/webhooks/{id}/deliveries)
+	GetApiAdminWebhooksIdDeliveries(c *gin.Context, id int32, params GetApiAdminWebhooksIdDeliveriesParams)
+	// Получить открытые аукционы, начиная с ближайших к завершению.
+	// (GET /api/auctions)
+	GetApiAuctions(c *gin.Context)
+	// Получить аукцион вместе со ставками.
+	// (GET /api/auctions/{id})
+	GetApiAuctionsId(c *gin.Context, id int32)
+	// Сделать ставку. Монеты ставки блокируются до тех пор, пока ставку не перебьют, а по завершении аукциона списываются в оплату предмета.
+	// (POST /api/auctions/{id}/bids)
+	PostApiAuctionsIdBids(c *gin.Context, id int32)
+	// Получить записи журнала аудита, начиная с самых новых (только для аудиторов).
+	// (GET /api/audit)
+	GetApiAudit(c *gin.Context, params GetApiAuditParams)
+	// Проверить целостность цепочки хешей журнала аудита (только для аудиторов).
+	// (GET /api/audit/verify)
+	GetApiAuditVerify(c *gin.Context)
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// (POST /api/auth)
+	PostApiAuth(c *gin.Context)
+	// Завершить вход кодом TOTP или кодом восстановления. Не требует аутентификации. Администратор, настраивающий TOTP при входе, получает в ответе коды восстановления.
+	// (POST /api/auth/mfa)
+	PostApiAuthMfa(c *gin.Context)
+	// Начать настройку TOTP по токену входа. Нужно администраторам, у которых двухфакторная аутентификация еще не настроена. Не требует аутентификации.
+	// (POST /api/auth/mfa/enroll)
+	PostApiAuthMfaEnroll(c *gin.Context)
+	// Подтвердить паролем связь внешней учетной записи с существующим пользователем и войти. Не требует аутентификации.
+	// (POST /api/auth/oidc/link)
+	PostApiAuthOidcLink(c *gin.Context)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetApiBuyItem(c *gin.Context, item string, params GetApiBuyItemParams)
+	// Получить каталог товаров с фильтрацией и сортировкой.
+	// (GET /api/catalog)
+	GetApiCatalog(c *gin.Context, params GetApiCatalogParams)
+	// Получить список категорий товаров.
+	// (GET /api/categories)
+	GetApiCategories(c *gin.Context, params GetApiCategoriesParams)
+	// Начислить пользователю монеты. Доступно администраторам и сервисным аккаунтам с областью coins:grant.
+	// (POST /api/coins/grant)
+	PostApiCoinsGrant(c *gin.Context)
+	// Получить список валют магазина.
+	// (GET /api/currencies)
+	GetApiCurrencies(c *gin.Context)
+	// Получить открытые групповые покупки, начиная с ближайших к сроку.
+	// (GET /api/groupPurchases)
+	GetApiGroupPurchases(c *gin.Context)
+	// Начать групповую покупку предмета в подарок коллеге. Предмет покупается по обычной цене, как только взносы ее покроют; если этого не произошло к сроку, взносы возвращаются.
+	// (POST /api/groupPurchases)
+	PostApiGroupPurchases(c *gin.Context)
+	// Получить групповую покупку вместе со взносами.
+	// (GET /api/groupPurchases/{id})
+	GetApiGroupPurchasesId(c *gin.Context, id int32)
+	// Внести монеты в групповую покупку. Монеты блокируются до покупки предмета или возврата взносов.
+	// (POST /api/groupPurchases/{id}/pledges)
+	PostApiGroupPurchasesIdPledges(c *gin.Context, id int32)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetApiInfo(c *gin.Context)
+	// Получить самые покупаемые предметы за период.
+	// (GET /api/leaderboard/items)
+	GetApiLeaderboardItems(c *gin.Context, params GetApiLeaderboardItemsParams)
+	// Получить пользователей, получивших больше всего монет за период.
+	// (GET /api/leaderboard/receivers)
+	GetApiLeaderboardReceivers(c *gin.Context, params GetApiLeaderboardReceiversParams)
+	// Получить пользователей, отправивших больше всего монет за период.
+	// (GET /api/leaderboard/senders)
+	GetApiLeaderboardSenders(c *gin.Context, params GetApiLeaderboardSendersParams)
+	// Получить состояние двухфакторной аутентификации текущего пользователя.
+	// (GET /api/mfa)
+	GetApiMfa(c *gin.Context)
+	// Подтвердить настройку TOTP первым кодом и включить двухфакторную аутентификацию. Коды восстановления возвращаются только в этом ответе.
+	// (POST /api/mfa/confirm)
+	PostApiMfaConfirm(c *gin.Context)
+	// Отключить двухфакторную аутентификацию кодом TOTP или кодом восстановления. Администраторам недоступно.
+	// (POST /api/mfa/disable)
+	PostApiMfaDisable(c *gin.Context)
+	// Начать настройку TOTP. Заменяет неподтвержденную настройку, если она есть.
+	// (POST /api/mfa/enroll)
+	PostApiMfaEnroll(c *gin.Context)
+	// Заменить коды восстановления новыми. Требует код TOTP.
+	// (POST /api/mfa/recoveryCodes)
+	PostApiMfaRecoveryCodes(c *gin.Context)
+	// Получить уведомления пользователя, начиная с самых новых.
+	// (GET /api/notifications)
+	GetApiNotifications(c *gin.Context, params GetApiNotificationsParams)
+	// Отметить уведомления прочитанными.
+	// (POST /api/notifications/read)
+	PostApiNotificationsRead(c *gin.Context)
+	// Получить количество непрочитанных уведомлений.
+	// (GET /api/notifications/unreadCount)
+	GetApiNotificationsUnreadCount(c *gin.Context)
+	// Сменить пароль текущего пользователя.
+	// (POST /api/password)
+	PostApiPassword(c *gin.Context)
+	// Задать новый пароль по одноразовому токену сброса, выданному администратором. Не требует аутентификации.
+	// (POST /api/password/reset)
+	PostApiPasswordReset(c *gin.Context)
+	// Получить профиль текущего пользователя.
+	// (GET /api/profile)
+	GetApiProfile(c *gin.Context)
+	// Изменить профиль текущего пользователя. Изменяются только переданные поля, пустая строка очищает поле.
+	// (PUT /api/profile)
+	PutApiProfile(c *gin.Context)
+	// Выгрузить все данные текущего пользователя — профиль, баланс, переводы, покупки и события журнала аудита.
+	// (GET /api/profile/export)
+	GetApiProfileExport(c *gin.Context)
+	// Получить историю покупок.
+	// (GET /api/purchases)
+	GetApiPurchases(c *gin.Context)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostApiSendCoin(c *gin.Context)
+	// Получить статистику пользователя за период.
+	// (GET /api/stats/{username})
+	GetApiStatsUsername(c *gin.Context, username string, params GetApiStatsUsernameParams)
+	// Получить список команд с балансами их кошельков.
+	// (GET /api/teams)
+	GetApiTeams(c *gin.Context)
+	// Получить команду вместе с ее участниками.
+	// (GET /api/teams/{name})
+	GetApiTeamsName(c *gin.Context, name string)
+	// Получить последние операции кошелька команды, новые первыми. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{name}/transactions)
+	GetApiTeamsNameTransactions(c *gin.Context, name string, params GetApiTeamsNameTransactionsParams)
+	// Перевести монеты из кошелька команды ее участнику (только для руководителей команды).
+	// (POST /api/teams/{name}/transfer)
+	PostApiTeamsNameTransfer(c *gin.Context, name string)
+	// Найти активных пользователей по имени, отображаемому имени или отделу, например для выбора получателя перевода.
+	// (GET /api/users)
+	GetApiUsers(c *gin.Context, params GetApiUsersParams)
+	// Получить информацию о монетах, инвентаре и истории транзакций пользователя. Доступно самому пользователю, администраторам и сервисным аккаунтам с областью info:read.
+	// (GET /api/users/{username}/info)
+	GetApiUsersUsernameInfo(c *gin.Context, username string)
+	// Получить список желаемых предметов.
+	// (GET /api/wishlist)
+	GetApiWishlist(c *gin.Context)
+	// Удалить предмет из списка желаемого.
+	// (DELETE /api/wishlist/{item})
+	DeleteApiWishlistItem(c *gin.Context, item string)
+	// Добавить предмет в список желаемого.
+	// (PUT /api/wishlist/{item})
+	PutApiWishlistItem(c *gin.Context, item string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// DeleteApiAdminApiKeysId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminApiKeysId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminApiKeysId(c, id)
+}
+
+// PostApiAdminAuctions operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminAuctions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminAuctions(c)
+}
+
+// PostApiAdminCurrencies operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminCurrencies(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminCurrencies(c)
+}
+
+// GetApiAdminLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminLimits(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminLimits(c)
+}
+
+// DeleteApiAdminLimitsScopeSubject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminLimitsScopeSubject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope DeleteApiAdminLimitsScopeSubjectParamsScope
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", c.Param("scope"), &scope, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject", c.Param("subject"), &subject, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminLimitsScopeSubject(c, scope, subject)
+}
+
+// PutApiAdminLimitsScopeSubject operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminLimitsScopeSubject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope PutApiAdminLimitsScopeSubjectParamsScope
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", c.Param("scope"), &scope, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject", c.Param("subject"), &subject, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminLimitsScopeSubject(c, scope, subject)
+}
+
+// PutApiAdminProductsItemCurrency operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminProductsItemCurrency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminProductsItemCurrency(c, item)
+}
+
+// PutApiAdminProductsItemVariantsSku operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminProductsItemVariantsSku(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "sku" -------------
+	var sku string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sku", c.Param("sku"), &sku, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sku: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminProductsItemVariantsSku(c, item, sku)
+}
+
+// GetApiAdminPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminPromoCodes(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminPromoCodes(c)
+}
+
+// PostApiAdminPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminPromoCodes(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminPromoCodes(c)
+}
+
+// DeleteApiAdminPromoCodesCode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminPromoCodesCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminPromoCodesCode(c, code)
+}
+
+// GetApiAdminReportsKind operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminReportsKind(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "kind" -------------
+	var kind string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", c.Param("kind"), &kind, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAdminReportsKindParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminReportsKind(c, kind, params)
+}
+
+// GetApiAdminSales operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminSales(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminSales(c)
+}
+
+// PostApiAdminSales operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminSales(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminSales(c)
+}
+
+// DeleteApiAdminSalesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminSalesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminSalesId(c, id)
+}
+
+// GetApiAdminServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminServiceAccounts(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminServiceAccounts(c)
+}
+
+// PostApiAdminServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminServiceAccounts(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminServiceAccounts(c)
+}
+
+// GetApiAdminServiceAccountsNameKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminServiceAccountsNameKeys(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminServiceAccountsNameKeys(c, name)
+}
+
+// PostApiAdminServiceAccountsNameKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminServiceAccountsNameKeys(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminServiceAccountsNameKeys(c, name)
+}
+
+// PostApiAdminTeams operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminTeams(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminTeams(c)
+}
+
+// PostApiAdminTeamsNameDeposit operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminTeamsNameDeposit(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminTeamsNameDeposit(c, name)
+}
+
+// DeleteApiAdminTeamsNameMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminTeamsNameMembersUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminTeamsNameMembersUsername(c, name, username)
+}
+
+// PutApiAdminTeamsNameMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminTeamsNameMembersUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminTeamsNameMembersUsername(c, name, username)
+}
+
+// PostApiAdminUsersUsernameBalance operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernameBalance(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernameBalance(c, username)
+}
+
+// PostApiAdminUsersUsernameDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernameDeactivate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernameDeactivate(c, username)
+}
+
+// PostApiAdminUsersUsernameErase operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernameErase(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernameErase(c, username)
+}
+
+// DeleteApiAdminUsersUsernameMfa operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminUsersUsernameMfa(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminUsersUsernameMfa(c, username)
+}
+
+// PostApiAdminUsersUsernamePasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernamePasswordReset(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernamePasswordReset(c, username)
+}
+
+// PostApiAdminUsersUsernameReactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernameReactivate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernameReactivate(c, username)
+}
+
+// PostApiAdminUsersUsernameUnlock operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminUsersUsernameUnlock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminUsersUsernameUnlock(c, username)
+}
+
+// GetApiAdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminWebhooks(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminWebhooks(c)
+}
+
+// PostApiAdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminWebhooks(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminWebhooks(c)
+}
+
+// PostApiAdminWebhooksDeliveriesDeliveryIdRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminWebhooksDeliveriesDeliveryIdRetry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", c.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminWebhooksDeliveriesDeliveryIdRetry(c, deliveryId)
+}
+
+// DeleteApiAdminWebhooksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminWebhooksId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminWebhooksId(c, id)
+}
+
+// GetApiAdminWebhooksIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminWebhooksIdDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAdminWebhooksIdDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminWebhooksIdDeliveries(c, id, params)
+}
+
+// GetApiAuctions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuctions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAuctions(c)
+}
+
+// GetApiAuctionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuctionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAuctionsId(c, id)
+}
+
+// PostApiAuctionsIdBids operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuctionsIdBids(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuctionsIdBids(c, id)
+}
+
+// GetApiAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAudit(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAuditParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target", c.Request.URL.Query(), &params.Target)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter target: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "beforeSeq" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "beforeSeq", c.Request.URL.Query(), &params.BeforeSeq)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter beforeSeq: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAudit(c, params)
+}
+
+// GetApiAuditVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuditVerify(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAuditVerify(c)
+}
+
+// PostApiAuth operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuth(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuth(c)
+}
+
+// PostApiAuthMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthMfa(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuthMfa(c)
+}
+
+// PostApiAuthMfaEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthMfaEnroll(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuthMfaEnroll(c)
+}
+
+// PostApiAuthOidcLink operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthOidcLink(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuthOidcLink(c)
+}
+
+// GetApiBuyItem operation middleware
+func (siw *ServerInterfaceWrapper) GetApiBuyItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiBuyItemParams
+
+	// ------------- Optional query parameter "variant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variant", c.Request.URL.Query(), &params.Variant)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variant: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "promoCode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "promoCode", c.Request.URL.Query(), &params.PromoCode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter promoCode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiBuyItem(c, item, params)
+}
+
+// GetApiCatalog operation middleware
+func (siw *ServerInterfaceWrapper) GetApiCatalog(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiCatalogParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", c.Request.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minPrice: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", c.Request.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxPrice: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiCatalog(c, params)
+}
+
+// GetApiCategories operation middleware
+func (siw *ServerInterfaceWrapper) GetApiCategories(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiCategoriesParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiCategories(c, params)
+}
+
+// PostApiCoinsGrant operation middleware
+func (siw *ServerInterfaceWrapper) PostApiCoinsGrant(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{"coins:grant"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiCoinsGrant(c)
+}
+
+// GetApiCurrencies operation middleware
+func (siw *ServerInterfaceWrapper) GetApiCurrencies(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiCurrencies(c)
+}
+
+// GetApiGroupPurchases operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroupPurchases(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiGroupPurchases(c)
+}
+
+// PostApiGroupPurchases operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGroupPurchases(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiGroupPurchases(c)
+}
+
+// GetApiGroupPurchasesId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroupPurchasesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiGroupPurchasesId(c, id)
+}
+
+// PostApiGroupPurchasesIdPledges operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGroupPurchasesIdPledges(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiGroupPurchasesIdPledges(c, id)
+}
+
+// GetApiInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetApiInfo(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiInfo(c)
+}
+
+// GetApiLeaderboardItems operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLeaderboardItems(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiLeaderboardItemsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiLeaderboardItems(c, params)
+}
+
+// GetApiLeaderboardReceivers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLeaderboardReceivers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiLeaderboardReceiversParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiLeaderboardReceivers(c, params)
+}
+
+// GetApiLeaderboardSenders operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLeaderboardSenders(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiLeaderboardSendersParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiLeaderboardSenders(c, params)
+}
+
+// GetApiMfa operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMfa(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMfa(c)
+}
+
+// PostApiMfaConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaConfirm(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMfaConfirm(c)
+}
+
+// PostApiMfaDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaDisable(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMfaDisable(c)
+}
+
+// PostApiMfaEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaEnroll(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMfaEnroll(c)
+}
+
+// PostApiMfaRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaRecoveryCodes(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMfaRecoveryCodes(c)
+}
+
+// GetApiNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetApiNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiNotificationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "unreadOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unreadOnly", c.Request.URL.Query(), &params.UnreadOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unreadOnly: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "beforeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "beforeId", c.Request.URL.Query(), &params.BeforeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter beforeId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiNotifications(c, params)
+}
+
+// PostApiNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) PostApiNotificationsRead(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiNotificationsRead(c)
+}
+
+// GetApiNotificationsUnreadCount operation middleware
+func (siw *ServerInterfaceWrapper) GetApiNotificationsUnreadCount(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiNotificationsUnreadCount(c)
+}
+
+// PostApiPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPassword(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiPassword(c)
+}
+
+// PostApiPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPasswordReset(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiPasswordReset(c)
+}
+
+// GetApiProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfile(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfile(c)
+}
+
+// PutApiProfile operation middleware
+func (siw *ServerInterfaceWrapper) PutApiProfile(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiProfile(c)
+}
+
+// GetApiProfileExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfileExport(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfileExport(c)
+}
+
+// GetApiPurchases operation middleware
+func (siw *ServerInterfaceWrapper) GetApiPurchases(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiPurchases(c)
+}
+
+// PostApiSendCoin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSendCoin(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSendCoin(c)
+}
+
+// GetApiStatsUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetApiStatsUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiStatsUsernameParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiStatsUsername(c, username, params)
+}
+
+// GetApiTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeams(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeams(c)
+}
+
+// GetApiTeamsName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsName(c, name)
+}
+
+// GetApiTeamsNameTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsNameTransactions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiTeamsNameTransactionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsNameTransactions(c, name, params)
+}
+
+// PostApiTeamsNameTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTeamsNameTransfer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiTeamsNameTransfer(c, name)
+}
+
+// GetApiUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiUsersParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiUsers(c, params)
+}
+
+// GetApiUsersUsernameInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsersUsernameInfo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{"info:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiUsersUsernameInfo(c, username)
+}
+
+// GetApiWishlist operation middleware
+func (siw *ServerInterfaceWrapper) GetApiWishlist(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiWishlist(c)
+}
+
+// DeleteApiWishlistItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiWishlistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiWishlistItem(c, item)
+}
+
+// PutApiWishlistItem operation middleware
+func (siw *ServerInterfaceWrapper) PutApiWishlistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiWishlistItem(c, item)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.DELETE(options.BaseURL+"/api/admin/apiKeys/:id", wrapper.DeleteApiAdminApiKeysId)
+	router.POST(options.BaseURL+"/api/admin/auctions", wrapper.PostApiAdminAuctions)
+	router.POST(options.BaseURL+"/api/admin/currencies", wrapper.PostApiAdminCurrencies)
+	router.GET(options.BaseURL+"/api/admin/limits", wrapper.GetApiAdminLimits)
+	router.DELETE(options.BaseURL+"/api/admin/limits/:scope/:subject", wrapper.DeleteApiAdminLimitsScopeSubject)
+	router.PUT(options.BaseURL+"/api/admin/limits/:scope/:subject", wrapper.PutApiAdminLimitsScopeSubject)
+	router.PUT(options.BaseURL+"/api/admin/products/:item/currency", wrapper.PutApiAdminProductsItemCurrency)
+	router.PUT(options.BaseURL+"/api/admin/products/:item/variants/:sku", wrapper.PutApiAdminProductsItemVariantsSku)
+	router.GET(options.BaseURL+"/api/admin/promoCodes", wrapper.GetApiAdminPromoCodes)
+	router.POST(options.BaseURL+"/api/admin/promoCodes", wrapper.PostApiAdminPromoCodes)
+	router.DELETE(options.BaseURL+"/api/admin/promoCodes/:code", wrapper.DeleteApiAdminPromoCodesCode)
+	router.GET(options.BaseURL+"/api/admin/reports/:kind", wrapper.GetApiAdminReportsKind)
+	router.GET(options.BaseURL+"/api/admin/sales", wrapper.GetApiAdminSales)
+	router.POST(options.BaseURL+"/api/admin/sales", wrapper.PostApiAdminSales)
+	router.DELETE(options.BaseURL+"/api/admin/sales/:id", wrapper.DeleteApiAdminSalesId)
+	router.GET(options.BaseURL+"/api/admin/serviceAccounts", wrapper.GetApiAdminServiceAccounts)
+	router.POST(options.BaseURL+"/api/admin/serviceAccounts", wrapper.PostApiAdminServiceAccounts)
+	router.GET(options.BaseURL+"/api/admin/serviceAccounts/:name/keys", wrapper.GetApiAdminServiceAccountsNameKeys)
+	router.POST(options.BaseURL+"/api/admin/serviceAccounts/:name/keys", wrapper.PostApiAdminServiceAccountsNameKeys)
+	router.POST(options.BaseURL+"/api/admin/teams", wrapper.PostApiAdminTeams)
+	router.POST(options.BaseURL+"/api/admin/teams/:name/deposit", wrapper.PostApiAdminTeamsNameDeposit)
+	router.DELETE(options.BaseURL+"/api/admin/teams/:name/members/:username", wrapper.DeleteApiAdminTeamsNameMembersUsername)
+	router.PUT(options.BaseURL+"/api/admin/teams/:name/members/:username", wrapper.PutApiAdminTeamsNameMembersUsername)
+	router.POST(options.BaseURL+"/api/admin/users/:username/balance", wrapper.PostApiAdminUsersUsernameBalance)
+	router.POST(options.BaseURL+"/api/admin/users/:username/deactivate", wrapper.PostApiAdminUsersUsernameDeactivate)
+	router.POST(options.BaseURL+"/api/admin/users/:username/erase", wrapper.PostApiAdminUsersUsernameErase)
+	router.DELETE(options.BaseURL+"/api/admin/users/:username/mfa", wrapper.DeleteApiAdminUsersUsernameMfa)
+	router.POST(options.BaseURL+"/api/admin/users/:username/passwordReset", wrapper.PostApiAdminUsersUsernamePasswordReset)
+	router.POST(options.BaseURL+"/api/admin/users/:username/reactivate", wrapper.PostApiAdminUsersUsernameReactivate)
+	router.POST(options.BaseURL+"/api/admin/users/:username/unlock", wrapper.PostApiAdminUsersUsernameUnlock)
+	router.GET(options.BaseURL+"/api/admin/webhooks", wrapper.GetApiAdminWebhooks)
+	router.POST(options.BaseURL+"/api/admin/webhooks", wrapper.PostApiAdminWebhooks)
+	router.POST(options.BaseURL+"/api/admin/webhooks/deliveries/:deliveryId/retry", wrapper.PostApiAdminWebhooksDeliveriesDeliveryIdRetry)
+	router.DELETE(options.BaseURL+"/api/admin/webhooks/:id", wrapper.DeleteApiAdminWebhooksId)
+	router.GET(options.BaseURL+"/api/admin/webhooks/:id/deliveries", wrapper.GetApiAdminWebhooksIdDeliveries)
+	router.GET(options.BaseURL+"/api/auctions", wrapper.GetApiAuctions)
+	router.GET(options.BaseURL+"/api/auctions/:id", wrapper.GetApiAuctionsId)
+	router.POST(options.BaseURL+"/api/auctions/:id/bids", wrapper.PostApiAuctionsIdBids)
+	router.GET(options.BaseURL+"/api/audit", wrapper.GetApiAudit)
+	router.GET(options.BaseURL+"/api/audit/verify", wrapper.GetApiAuditVerify)
+	router.POST(options.BaseURL+"/api/auth", wrapper.PostApiAuth)
+	router.POST(options.BaseURL+"/api/auth/mfa", wrapper.PostApiAuthMfa)
+	router.POST(options.BaseURL+"/api/auth/mfa/enroll", wrapper.PostApiAuthMfaEnroll)
+	router.POST(options.BaseURL+"/api/auth/oidc/link", wrapper.PostApiAuthOidcLink)
+	router.GET(options.BaseURL+"/api/buy/:item", wrapper.GetApiBuyItem)
+	router.GET(options.BaseURL+"/api/catalog", wrapper.GetApiCatalog)
+	router.GET(options.BaseURL+"/api/categories", wrapper.GetApiCategories)
+	router.POST(options.BaseURL+"/api/coins/grant", wrapper.PostApiCoinsGrant)
+	router.GET(options.BaseURL+"/api/currencies", wrapper.GetApiCurrencies)
+	router.GET(options.BaseURL+"/api/groupPurchases", wrapper.GetApiGroupPurchases)
+	router.POST(options.BaseURL+"/api/groupPurchases", wrapper.PostApiGroupPurchases)
+	router.GET(options.BaseURL+"/api/groupPurchases/:id", wrapper.GetApiGroupPurchasesId)
+	router.POST(options.BaseURL+"/api/groupPurchases/:id/pledges", wrapper.PostApiGroupPurchasesIdPledges)
+	router.GET(options.BaseURL+"/api/info", wrapper.GetApiInfo)
+	router.GET(options.BaseURL+"/api/leaderboard/items", wrapper.GetApiLeaderboardItems)
+	router.GET(options.BaseURL+"/api/leaderboard/receivers", wrapper.GetApiLeaderboardReceivers)
+	router.GET(options.BaseURL+"/api/leaderboard/senders", wrapper.GetApiLeaderboardSenders)
+	router.GET(options.BaseURL+"/api/mfa", wrapper.GetApiMfa)
+	router.POST(options.BaseURL+"/api/mfa/confirm", wrapper.PostApiMfaConfirm)
+	router.POST(options.BaseURL+"/api/mfa/disable", wrapper.PostApiMfaDisable)
+	router.POST(options.BaseURL+"/api/mfa/enroll", wrapper.PostApiMfaEnroll)
+	router.POST(options.BaseURL+"/api/mfa/recoveryCodes", wrapper.PostApiMfaRecoveryCodes)
+	router.GET(options.BaseURL+"/api/notifications", wrapper.GetApiNotifications)
+	router.POST(options.BaseURL+"/api/notifications/read", wrapper.PostApiNotificationsRead)
+	router.GET(options.BaseURL+"/api/notifications/unreadCount", wrapper.GetApiNotificationsUnreadCount)
+	router.POST(options.BaseURL+"/api/password", wrapper.PostApiPassword)
+	router.POST(options.BaseURL+"/api/password/reset", wrapper.PostApiPasswordReset)
+	router.GET(options.BaseURL+"/api/profile", wrapper.GetApiProfile)
+	router.PUT(options.BaseURL+"/api/profile", wrapper.PutApiProfile)
+	router.GET(options.BaseURL+"/api/profile/export", wrapper.GetApiProfileExport)
+	router.GET(options.BaseURL+"/api/purchases", wrapper.GetApiPurchases)
+	router.POST(options.BaseURL+"/api/sendCoin", wrapper.PostApiSendCoin)
+	router.GET(options.BaseURL+"/api/stats/:username", wrapper.GetApiStatsUsername)
+	router.GET(options.BaseURL+"/api/teams", wrapper.GetApiTeams)
+	router.GET(options.BaseURL+"/api/teams/:name", wrapper.GetApiTeamsName)
+	router.GET(options.BaseURL+"/api/teams/:name/transactions", wrapper.GetApiTeamsNameTransactions)
+	router.POST(options.BaseURL+"/api/teams/:name/transfer", wrapper.PostApiTeamsNameTransfer)
+	router.GET(options.BaseURL+"/api/users", wrapper.GetApiUsers)
+	router.GET(options.BaseURL+"/api/users/:username/info", wrapper.GetApiUsersUsernameInfo)
+	router.GET(options.BaseURL+"/api/wishlist", wrapper.GetApiWishlist)
+	router.DELETE(options.BaseURL+"/api/wishlist/:item", wrapper.DeleteApiWishlistItem)
+	router.PUT(options.BaseURL+"/api/wishlist/:item", wrapper.PutApiWishlistItem)
+}
+
+type DeleteApiAdminApiKeysIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteApiAdminApiKeysIdResponseObject interface {
+	VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminApiKeysId200Response struct {
+}
+
+func (response DeleteApiAdminApiKeysId200Response) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminApiKeysId400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminApiKeysId400JSONResponse) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminApiKeysId401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminApiKeysId401JSONResponse) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminApiKeysId403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminApiKeysId403JSONResponse) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminApiKeysId404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminApiKeysId404JSONResponse) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminApiKeysId500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminApiKeysId500JSONResponse) VisitDeleteApiAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctionsRequestObject struct {
+	Body *PostApiAdminAuctionsJSONRequestBody
+}
+
+type PostApiAdminAuctionsResponseObject interface {
+	VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminAuctions200JSONResponse Auction
+
+func (response PostApiAdminAuctions200JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctions400JSONResponse ErrorResponse
+
+func (response PostApiAdminAuctions400JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctions401JSONResponse ErrorResponse
+
+func (response PostApiAdminAuctions401JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctions403JSONResponse ErrorResponse
+
+func (response PostApiAdminAuctions403JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctions404JSONResponse ErrorResponse
+
+func (response PostApiAdminAuctions404JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminAuctions500JSONResponse ErrorResponse
+
+func (response PostApiAdminAuctions500JSONResponse) VisitPostApiAdminAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminCurrenciesRequestObject struct {
+	Body *PostApiAdminCurrenciesJSONRequestBody
+}
+
+type PostApiAdminCurrenciesResponseObject interface {
+	VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminCurrencies200JSONResponse Currency
+
+func (response PostApiAdminCurrencies200JSONResponse) VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminCurrencies400JSONResponse ErrorResponse
+
+func (response PostApiAdminCurrencies400JSONResponse) VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminCurrencies401JSONResponse ErrorResponse
+
+func (response PostApiAdminCurrencies401JSONResponse) VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminCurrencies403JSONResponse ErrorResponse
+
+func (response PostApiAdminCurrencies403JSONResponse) VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminCurrencies500JSONResponse ErrorResponse
+
+func (response PostApiAdminCurrencies500JSONResponse) VisitPostApiAdminCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLimitsRequestObject struct {
+}
+
+type GetApiAdminLimitsResponseObject interface {
+	VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminLimits200JSONResponse []SpendingLimitRule
+
+func (response GetApiAdminLimits200JSONResponse) VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLimits400JSONResponse ErrorResponse
+
+func (response GetApiAdminLimits400JSONResponse) VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLimits401JSONResponse ErrorResponse
+
+func (response GetApiAdminLimits401JSONResponse) VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLimits403JSONResponse ErrorResponse
+
+func (response GetApiAdminLimits403JSONResponse) VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLimits500JSONResponse ErrorResponse
+
+func (response GetApiAdminLimits500JSONResponse) VisitGetApiAdminLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminLimitsScopeSubjectRequestObject struct {
+	Scope   DeleteApiAdminLimitsScopeSubjectParamsScope `json:"scope"`
+	Subject string                                      `json:"subject"`
+}
+
+type DeleteApiAdminLimitsScopeSubjectResponseObject interface {
+	VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminLimitsScopeSubject200Response struct {
+}
+
+func (response DeleteApiAdminLimitsScopeSubject200Response) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminLimitsScopeSubject400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminLimitsScopeSubject400JSONResponse) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminLimitsScopeSubject401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminLimitsScopeSubject401JSONResponse) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminLimitsScopeSubject403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminLimitsScopeSubject403JSONResponse) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminLimitsScopeSubject404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminLimitsScopeSubject404JSONResponse) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminLimitsScopeSubject500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminLimitsScopeSubject500JSONResponse) VisitDeleteApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminLimitsScopeSubjectRequestObject struct {
+	Scope   PutApiAdminLimitsScopeSubjectParamsScope `json:"scope"`
+	Subject string                                   `json:"subject"`
+	Body    *PutApiAdminLimitsScopeSubjectJSONRequestBody
+}
+
+type PutApiAdminLimitsScopeSubjectResponseObject interface {
+	VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error
+}
+
+type PutApiAdminLimitsScopeSubject200Response struct {
+}
+
+func (response PutApiAdminLimitsScopeSubject200Response) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiAdminLimitsScopeSubject400JSONResponse ErrorResponse
+
+func (response PutApiAdminLimitsScopeSubject400JSONResponse) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminLimitsScopeSubject401JSONResponse ErrorResponse
+
+func (response PutApiAdminLimitsScopeSubject401JSONResponse) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminLimitsScopeSubject403JSONResponse ErrorResponse
+
+func (response PutApiAdminLimitsScopeSubject403JSONResponse) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminLimitsScopeSubject404JSONResponse ErrorResponse
+
+func (response PutApiAdminLimitsScopeSubject404JSONResponse) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminLimitsScopeSubject500JSONResponse ErrorResponse
+
+func (response PutApiAdminLimitsScopeSubject500JSONResponse) VisitPutApiAdminLimitsScopeSubjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemCurrencyRequestObject struct {
+	Item string `json:"item"`
+	Body *PutApiAdminProductsItemCurrencyJSONRequestBody
+}
+
+type PutApiAdminProductsItemCurrencyResponseObject interface {
+	VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error
+}
+
+type PutApiAdminProductsItemCurrency200Response struct {
+}
+
+func (response PutApiAdminProductsItemCurrency200Response) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiAdminProductsItemCurrency400JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemCurrency400JSONResponse) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemCurrency401JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemCurrency401JSONResponse) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemCurrency403JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemCurrency403JSONResponse) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemCurrency404JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemCurrency404JSONResponse) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemCurrency500JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemCurrency500JSONResponse) VisitPutApiAdminProductsItemCurrencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSkuRequestObject struct {
+	Item string `json:"item"`
+	Sku  string `json:"sku"`
+	Body *PutApiAdminProductsItemVariantsSkuJSONRequestBody
+}
+
+type PutApiAdminProductsItemVariantsSkuResponseObject interface {
+	VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error
+}
+
+type PutApiAdminProductsItemVariantsSku200JSONResponse ProductVariant
+
+func (response PutApiAdminProductsItemVariantsSku200JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSku400JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemVariantsSku400JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSku401JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemVariantsSku401JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSku403JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemVariantsSku403JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSku404JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemVariantsSku404JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminProductsItemVariantsSku500JSONResponse ErrorResponse
+
+func (response PutApiAdminProductsItemVariantsSku500JSONResponse) VisitPutApiAdminProductsItemVariantsSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminPromoCodesRequestObject struct {
+}
+
+type GetApiAdminPromoCodesResponseObject interface {
+	VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminPromoCodes200JSONResponse []PromoCode
+
+func (response GetApiAdminPromoCodes200JSONResponse) VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminPromoCodes400JSONResponse ErrorResponse
+
+func (response GetApiAdminPromoCodes400JSONResponse) VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminPromoCodes401JSONResponse ErrorResponse
+
+func (response GetApiAdminPromoCodes401JSONResponse) VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminPromoCodes403JSONResponse ErrorResponse
+
+func (response GetApiAdminPromoCodes403JSONResponse) VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminPromoCodes500JSONResponse ErrorResponse
+
+func (response GetApiAdminPromoCodes500JSONResponse) VisitGetApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodesRequestObject struct {
+	Body *PostApiAdminPromoCodesJSONRequestBody
+}
+
+type PostApiAdminPromoCodesResponseObject interface {
+	VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminPromoCodes200JSONResponse PromoCode
+
+func (response PostApiAdminPromoCodes200JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodes400JSONResponse ErrorResponse
+
+func (response PostApiAdminPromoCodes400JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodes401JSONResponse ErrorResponse
+
+func (response PostApiAdminPromoCodes401JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodes403JSONResponse ErrorResponse
+
+func (response PostApiAdminPromoCodes403JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodes404JSONResponse ErrorResponse
+
+func (response PostApiAdminPromoCodes404JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminPromoCodes500JSONResponse ErrorResponse
+
+func (response PostApiAdminPromoCodes500JSONResponse) VisitPostApiAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminPromoCodesCodeRequestObject struct {
+	Code string `json:"code"`
+}
+
+type DeleteApiAdminPromoCodesCodeResponseObject interface {
+	VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminPromoCodesCode200Response struct {
+}
+
+func (response DeleteApiAdminPromoCodesCode200Response) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminPromoCodesCode400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminPromoCodesCode400JSONResponse) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminPromoCodesCode401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminPromoCodesCode401JSONResponse) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminPromoCodesCode403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminPromoCodesCode403JSONResponse) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminPromoCodesCode404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminPromoCodesCode404JSONResponse) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminPromoCodesCode500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminPromoCodesCode500JSONResponse) VisitDeleteApiAdminPromoCodesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminReportsKindRequestObject struct {
+	Kind   string `json:"kind"`
+	Params GetApiAdminReportsKindParams
+}
+
+type GetApiAdminReportsKindResponseObject interface {
+	VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminReportsKind200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetApiAdminReportsKind200ApplicationxNdjsonResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetApiAdminReportsKind200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetApiAdminReportsKind200TextcsvResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetApiAdminReportsKind400JSONResponse ErrorResponse
+
+func (response GetApiAdminReportsKind400JSONResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminReportsKind401JSONResponse ErrorResponse
+
+func (response GetApiAdminReportsKind401JSONResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminReportsKind403JSONResponse ErrorResponse
+
+func (response GetApiAdminReportsKind403JSONResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminReportsKind500JSONResponse ErrorResponse
+
+func (response GetApiAdminReportsKind500JSONResponse) VisitGetApiAdminReportsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminSalesRequestObject struct {
+}
+
+type GetApiAdminSalesResponseObject interface {
+	VisitGetApiAdminSalesResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminSales200JSONResponse []Sale
+
+func (response GetApiAdminSales200JSONResponse) VisitGetApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminSales400JSONResponse ErrorResponse
+
+func (response GetApiAdminSales400JSONResponse) VisitGetApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminSales401JSONResponse ErrorResponse
+
+func (response GetApiAdminSales401JSONResponse) VisitGetApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminSales403JSONResponse ErrorResponse
+
+func (response GetApiAdminSales403JSONResponse) VisitGetApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminSales500JSONResponse ErrorResponse
+
+func (response GetApiAdminSales500JSONResponse) VisitGetApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSalesRequestObject struct {
+	Body *PostApiAdminSalesJSONRequestBody
+}
+
+type PostApiAdminSalesResponseObject interface {
+	VisitPostApiAdminSalesResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminSales200JSONResponse Sale
+
+func (response PostApiAdminSales200JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSales400JSONResponse ErrorResponse
+
+func (response PostApiAdminSales400JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSales401JSONResponse ErrorResponse
+
+func (response PostApiAdminSales401JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSales403JSONResponse ErrorResponse
+
+func (response PostApiAdminSales403JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSales404JSONResponse ErrorResponse
+
+func (response PostApiAdminSales404JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminSales500JSONResponse ErrorResponse
+
+func (response PostApiAdminSales500JSONResponse) VisitPostApiAdminSalesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminSalesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteApiAdminSalesIdResponseObject interface {
+	VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminSalesId200Response struct {
+}
+
+func (response DeleteApiAdminSalesId200Response) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminSalesId400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminSalesId400JSONResponse) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminSalesId401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminSalesId401JSONResponse) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminSalesId403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminSalesId403JSONResponse) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminSalesId404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminSalesId404JSONResponse) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminSalesId500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminSalesId500JSONResponse) VisitDeleteApiAdminSalesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsRequestObject struct {
+}
+
+type GetApiAdminServiceAccountsResponseObject interface {
+	VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminServiceAccounts200JSONResponse []ServiceAccount
+
+func (response GetApiAdminServiceAccounts200JSONResponse) VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccounts400JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccounts400JSONResponse) VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccounts401JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccounts401JSONResponse) VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccounts403JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccounts403JSONResponse) VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccounts500JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccounts500JSONResponse) VisitGetApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsRequestObject struct {
+	Body *PostApiAdminServiceAccountsJSONRequestBody
+}
+
+type PostApiAdminServiceAccountsResponseObject interface {
+	VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminServiceAccounts200JSONResponse ServiceAccount
+
+func (response PostApiAdminServiceAccounts200JSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts400JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts400JSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts401JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts401JSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts403JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts403JSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts500JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts500JSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeysRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetApiAdminServiceAccountsNameKeysResponseObject interface {
+	VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminServiceAccountsNameKeys200JSONResponse []APIKey
+
+func (response GetApiAdminServiceAccountsNameKeys200JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys400JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys400JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys401JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys401JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys403JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys403JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys500JSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys500JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeysRequestObject struct {
+	Name string `json:"name"`
+	Body *PostApiAdminServiceAccountsNameKeysJSONRequestBody
+}
+
+type PostApiAdminServiceAccountsNameKeysResponseObject interface {
+	VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminServiceAccountsNameKeys200JSONResponse APIKey
+
+func (response PostApiAdminServiceAccountsNameKeys200JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys400JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys400JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys401JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys401JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys403JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys403JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys404JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys404JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys500JSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys500JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsRequestObject struct {
+	Body *PostApiAdminTeamsJSONRequestBody
+}
+
+type PostApiAdminTeamsResponseObject interface {
+	VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminTeams200JSONResponse Team
+
+func (response PostApiAdminTeams200JSONResponse) VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeams400JSONResponse ErrorResponse
+
+func (response PostApiAdminTeams400JSONResponse) VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeams401JSONResponse ErrorResponse
+
+func (response PostApiAdminTeams401JSONResponse) VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeams403JSONResponse ErrorResponse
+
+func (response PostApiAdminTeams403JSONResponse) VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeams500JSONResponse ErrorResponse
+
+func (response PostApiAdminTeams500JSONResponse) VisitPostApiAdminTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsNameDepositRequestObject struct {
+	Name string `json:"name"`
+	Body *PostApiAdminTeamsNameDepositJSONRequestBody
+}
+
+type PostApiAdminTeamsNameDepositResponseObject interface {
+	VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminTeamsNameDeposit200Response struct {
+}
+
+func (response PostApiAdminTeamsNameDeposit200Response) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminTeamsNameDeposit400JSONResponse ErrorResponse
+
+func (response PostApiAdminTeamsNameDeposit400JSONResponse) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsNameDeposit401JSONResponse ErrorResponse
+
+func (response PostApiAdminTeamsNameDeposit401JSONResponse) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsNameDeposit403JSONResponse ErrorResponse
+
+func (response PostApiAdminTeamsNameDeposit403JSONResponse) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsNameDeposit404JSONResponse ErrorResponse
+
+func (response PostApiAdminTeamsNameDeposit404JSONResponse) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminTeamsNameDeposit500JSONResponse ErrorResponse
+
+func (response PostApiAdminTeamsNameDeposit500JSONResponse) VisitPostApiAdminTeamsNameDepositResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminTeamsNameMembersUsernameRequestObject struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+type DeleteApiAdminTeamsNameMembersUsernameResponseObject interface {
+	VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminTeamsNameMembersUsername200Response struct {
+}
+
+func (response DeleteApiAdminTeamsNameMembersUsername200Response) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminTeamsNameMembersUsername400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminTeamsNameMembersUsername400JSONResponse) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminTeamsNameMembersUsername401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminTeamsNameMembersUsername401JSONResponse) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminTeamsNameMembersUsername403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminTeamsNameMembersUsername403JSONResponse) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminTeamsNameMembersUsername404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminTeamsNameMembersUsername404JSONResponse) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminTeamsNameMembersUsername500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminTeamsNameMembersUsername500JSONResponse) VisitDeleteApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminTeamsNameMembersUsernameRequestObject struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Body     *PutApiAdminTeamsNameMembersUsernameJSONRequestBody
+}
+
+type PutApiAdminTeamsNameMembersUsernameResponseObject interface {
+	VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error
+}
+
+type PutApiAdminTeamsNameMembersUsername200Response struct {
+}
+
+func (response PutApiAdminTeamsNameMembersUsername200Response) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiAdminTeamsNameMembersUsername400JSONResponse ErrorResponse
+
+func (response PutApiAdminTeamsNameMembersUsername400JSONResponse) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminTeamsNameMembersUsername401JSONResponse ErrorResponse
+
+func (response PutApiAdminTeamsNameMembersUsername401JSONResponse) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminTeamsNameMembersUsername403JSONResponse ErrorResponse
+
+func (response PutApiAdminTeamsNameMembersUsername403JSONResponse) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminTeamsNameMembersUsername404JSONResponse ErrorResponse
+
+func (response PutApiAdminTeamsNameMembersUsername404JSONResponse) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminTeamsNameMembersUsername500JSONResponse ErrorResponse
+
+func (response PutApiAdminTeamsNameMembersUsername500JSONResponse) VisitPutApiAdminTeamsNameMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameBalanceRequestObject struct {
+	Username string `json:"username"`
+	Body     *PostApiAdminUsersUsernameBalanceJSONRequestBody
+}
+
+type PostApiAdminUsersUsernameBalanceResponseObject interface {
+	VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernameBalance200Response struct {
+}
+
+func (response PostApiAdminUsersUsernameBalance200Response) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminUsersUsernameBalance400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameBalance400JSONResponse) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameBalance401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameBalance401JSONResponse) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameBalance403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameBalance403JSONResponse) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameBalance404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameBalance404JSONResponse) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameBalance500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameBalance500JSONResponse) VisitPostApiAdminUsersUsernameBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameDeactivateRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminUsersUsernameDeactivateResponseObject interface {
+	VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernameDeactivate200Response struct {
+}
+
+func (response PostApiAdminUsersUsernameDeactivate200Response) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminUsersUsernameDeactivate400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameDeactivate400JSONResponse) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameDeactivate401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameDeactivate401JSONResponse) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameDeactivate403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameDeactivate403JSONResponse) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameDeactivate404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameDeactivate404JSONResponse) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameDeactivate500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameDeactivate500JSONResponse) VisitPostApiAdminUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameEraseRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminUsersUsernameEraseResponseObject interface {
+	VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernameErase200JSONResponse ErasureResponse
+
+func (response PostApiAdminUsersUsernameErase200JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameErase400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameErase400JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameErase401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameErase401JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameErase403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameErase403JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameErase404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameErase404JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameErase500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameErase500JSONResponse) VisitPostApiAdminUsersUsernameEraseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminUsersUsernameMfaRequestObject struct {
+	Username string `json:"username"`
+}
+
+type DeleteApiAdminUsersUsernameMfaResponseObject interface {
+	VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminUsersUsernameMfa200Response struct {
+}
+
+func (response DeleteApiAdminUsersUsernameMfa200Response) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminUsersUsernameMfa400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminUsersUsernameMfa400JSONResponse) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminUsersUsernameMfa401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminUsersUsernameMfa401JSONResponse) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminUsersUsernameMfa403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminUsersUsernameMfa403JSONResponse) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminUsersUsernameMfa404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminUsersUsernameMfa404JSONResponse) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminUsersUsernameMfa500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminUsersUsernameMfa500JSONResponse) VisitDeleteApiAdminUsersUsernameMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordResetRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminUsersUsernamePasswordResetResponseObject interface {
+	VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernamePasswordReset200JSONResponse PasswordResetToken
+
+func (response PostApiAdminUsersUsernamePasswordReset200JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordReset400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernamePasswordReset400JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordReset401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernamePasswordReset401JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordReset403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernamePasswordReset403JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordReset404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernamePasswordReset404JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernamePasswordReset500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernamePasswordReset500JSONResponse) VisitPostApiAdminUsersUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameReactivateRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminUsersUsernameReactivateResponseObject interface {
+	VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernameReactivate200Response struct {
+}
+
+func (response PostApiAdminUsersUsernameReactivate200Response) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminUsersUsernameReactivate400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameReactivate400JSONResponse) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameReactivate401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameReactivate401JSONResponse) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameReactivate403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameReactivate403JSONResponse) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameReactivate404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameReactivate404JSONResponse) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameReactivate500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameReactivate500JSONResponse) VisitPostApiAdminUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameUnlockRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminUsersUsernameUnlockResponseObject interface {
+	VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminUsersUsernameUnlock200Response struct {
+}
+
+func (response PostApiAdminUsersUsernameUnlock200Response) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminUsersUsernameUnlock400JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameUnlock400JSONResponse) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameUnlock401JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameUnlock401JSONResponse) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameUnlock403JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameUnlock403JSONResponse) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameUnlock404JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameUnlock404JSONResponse) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminUsersUsernameUnlock500JSONResponse ErrorResponse
+
+func (response PostApiAdminUsersUsernameUnlock500JSONResponse) VisitPostApiAdminUsersUsernameUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksRequestObject struct {
+}
+
+type GetApiAdminWebhooksResponseObject interface {
+	VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminWebhooks200JSONResponse []WebhookSubscription
+
+func (response GetApiAdminWebhooks200JSONResponse) VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooks400JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooks400JSONResponse) VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooks401JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooks401JSONResponse) VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooks403JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooks403JSONResponse) VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooks500JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooks500JSONResponse) VisitGetApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksRequestObject struct {
+	Body *PostApiAdminWebhooksJSONRequestBody
+}
+
+type PostApiAdminWebhooksResponseObject interface {
+	VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminWebhooks200JSONResponse WebhookSubscription
+
+func (response PostApiAdminWebhooks200JSONResponse) VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooks400JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooks400JSONResponse) VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooks401JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooks401JSONResponse) VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooks403JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooks403JSONResponse) VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooks500JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooks500JSONResponse) VisitPostApiAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetryRequestObject struct {
+	DeliveryId int64 `json:"deliveryId"`
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetryResponseObject interface {
+	VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry200Response struct {
+}
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry200Response) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry400JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry400JSONResponse) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry401JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry401JSONResponse) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry403JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry403JSONResponse) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry404JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry404JSONResponse) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminWebhooksDeliveriesDeliveryIdRetry500JSONResponse ErrorResponse
+
+func (response PostApiAdminWebhooksDeliveriesDeliveryIdRetry500JSONResponse) VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminWebhooksIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteApiAdminWebhooksIdResponseObject interface {
+	VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminWebhooksId200Response struct {
+}
+
+func (response DeleteApiAdminWebhooksId200Response) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminWebhooksId400JSONResponse ErrorResponse
+
+func (response DeleteApiAdminWebhooksId400JSONResponse) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminWebhooksId401JSONResponse ErrorResponse
+
+func (response DeleteApiAdminWebhooksId401JSONResponse) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminWebhooksId403JSONResponse ErrorResponse
+
+func (response DeleteApiAdminWebhooksId403JSONResponse) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminWebhooksId404JSONResponse ErrorResponse
+
+func (response DeleteApiAdminWebhooksId404JSONResponse) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminWebhooksId500JSONResponse ErrorResponse
+
+func (response DeleteApiAdminWebhooksId500JSONResponse) VisitDeleteApiAdminWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksIdDeliveriesRequestObject struct {
+	Id     int32 `json:"id"`
+	Params GetApiAdminWebhooksIdDeliveriesParams
+}
+
+type GetApiAdminWebhooksIdDeliveriesResponseObject interface {
+	VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminWebhooksIdDeliveries200JSONResponse []WebhookDelivery
+
+func (response GetApiAdminWebhooksIdDeliveries200JSONResponse) VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksIdDeliveries400JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooksIdDeliveries400JSONResponse) VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksIdDeliveries401JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooksIdDeliveries401JSONResponse) VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksIdDeliveries403JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooksIdDeliveries403JSONResponse) VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminWebhooksIdDeliveries500JSONResponse ErrorResponse
+
+func (response GetApiAdminWebhooksIdDeliveries500JSONResponse) VisitGetApiAdminWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsRequestObject struct {
+}
+
+type GetApiAuctionsResponseObject interface {
+	VisitGetApiAuctionsResponse(w http.ResponseWriter) error
+}
+
+type GetApiAuctions200JSONResponse []Auction
+
+func (response GetApiAuctions200JSONResponse) VisitGetApiAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctions400JSONResponse ErrorResponse
+
+func (response GetApiAuctions400JSONResponse) VisitGetApiAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctions401JSONResponse ErrorResponse
+
+func (response GetApiAuctions401JSONResponse) VisitGetApiAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctions500JSONResponse ErrorResponse
+
+func (response GetApiAuctions500JSONResponse) VisitGetApiAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetApiAuctionsIdResponseObject interface {
+	VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiAuctionsId200JSONResponse Auction
+
+func (response GetApiAuctionsId200JSONResponse) VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsId400JSONResponse ErrorResponse
+
+func (response GetApiAuctionsId400JSONResponse) VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsId401JSONResponse ErrorResponse
+
+func (response GetApiAuctionsId401JSONResponse) VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsId404JSONResponse ErrorResponse
+
+func (response GetApiAuctionsId404JSONResponse) VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuctionsId500JSONResponse ErrorResponse
+
+func (response GetApiAuctionsId500JSONResponse) VisitGetApiAuctionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBidsRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostApiAuctionsIdBidsJSONRequestBody
+}
+
+type PostApiAuctionsIdBidsResponseObject interface {
+	VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuctionsIdBids200JSONResponse Auction
+
+func (response PostApiAuctionsIdBids200JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBids400JSONResponse ErrorResponse
+
+func (response PostApiAuctionsIdBids400JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBids401JSONResponse ErrorResponse
+
+func (response PostApiAuctionsIdBids401JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBids403JSONResponse ErrorResponse
+
+func (response PostApiAuctionsIdBids403JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBids404JSONResponse ErrorResponse
+
+func (response PostApiAuctionsIdBids404JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuctionsIdBids500JSONResponse ErrorResponse
+
+func (response PostApiAuctionsIdBids500JSONResponse) VisitPostApiAuctionsIdBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditRequestObject struct {
+	Params GetApiAuditParams
+}
+
+type GetApiAuditResponseObject interface {
+	VisitGetApiAuditResponse(w http.ResponseWriter) error
+}
+
+type GetApiAudit200JSONResponse []AuditEntry
+
+func (response GetApiAudit200JSONResponse) VisitGetApiAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAudit400JSONResponse ErrorResponse
+
+func (response GetApiAudit400JSONResponse) VisitGetApiAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAudit401JSONResponse ErrorResponse
+
+func (response GetApiAudit401JSONResponse) VisitGetApiAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAudit403JSONResponse ErrorResponse
+
+func (response GetApiAudit403JSONResponse) VisitGetApiAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAudit500JSONResponse ErrorResponse
+
+func (response GetApiAudit500JSONResponse) VisitGetApiAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditVerifyRequestObject struct {
+}
+
+type GetApiAuditVerifyResponseObject interface {
+	VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error
+}
+
+type GetApiAuditVerify200JSONResponse AuditVerification
+
+func (response GetApiAuditVerify200JSONResponse) VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditVerify400JSONResponse ErrorResponse
+
+func (response GetApiAuditVerify400JSONResponse) VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditVerify401JSONResponse ErrorResponse
+
+func (response GetApiAuditVerify401JSONResponse) VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditVerify403JSONResponse ErrorResponse
+
+func (response GetApiAuditVerify403JSONResponse) VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuditVerify500JSONResponse ErrorResponse
+
+func (response GetApiAuditVerify500JSONResponse) VisitGetApiAuditVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRequestObject struct {
+	Body *PostApiAuthJSONRequestBody
+}
+
+type PostApiAuthResponseObject interface {
+	VisitPostApiAuthResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuth200JSONResponse AuthResponse
+
+func (response PostApiAuth200JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth400JSONResponse ErrorResponse
+
+func (response PostApiAuth400JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth401JSONResponse ErrorResponse
+
+func (response PostApiAuth401JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth403JSONResponse ErrorResponse
+
+func (response PostApiAuth403JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth429JSONResponse ErrorResponse
+
+func (response PostApiAuth429JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth500JSONResponse ErrorResponse
+
+func (response PostApiAuth500JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaRequestObject struct {
+	Body *PostApiAuthMfaJSONRequestBody
+}
+
+type PostApiAuthMfaResponseObject interface {
+	VisitPostApiAuthMfaResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthMfa200JSONResponse AuthResponse
+
+func (response PostApiAuthMfa200JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa400JSONResponse ErrorResponse
+
+func (response PostApiAuthMfa400JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa401JSONResponse ErrorResponse
+
+func (response PostApiAuthMfa401JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa403JSONResponse ErrorResponse
+
+func (response PostApiAuthMfa403JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa429JSONResponse ErrorResponse
+
+func (response PostApiAuthMfa429JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa500JSONResponse ErrorResponse
+
+func (response PostApiAuthMfa500JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaEnrollRequestObject struct {
+	Body *PostApiAuthMfaEnrollJSONRequestBody
+}
+
+type PostApiAuthMfaEnrollResponseObject interface {
+	VisitPostApiAuthMfaEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthMfaEnroll200JSONResponse MFAEnrollment
+
+func (response PostApiAuthMfaEnroll200JSONResponse) VisitPostApiAuthMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaEnroll400JSONResponse ErrorResponse
+
+func (response PostApiAuthMfaEnroll400JSONResponse) VisitPostApiAuthMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaEnroll401JSONResponse ErrorResponse
+
+func (response PostApiAuthMfaEnroll401JSONResponse) VisitPostApiAuthMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaEnroll500JSONResponse ErrorResponse
+
+func (response PostApiAuthMfaEnroll500JSONResponse) VisitPostApiAuthMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLinkRequestObject struct {
+	Body *PostApiAuthOidcLinkJSONRequestBody
+}
+
+type PostApiAuthOidcLinkResponseObject interface {
+	VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthOidcLink200JSONResponse AuthResponse
+
+func (response PostApiAuthOidcLink200JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLink400JSONResponse ErrorResponse
+
+func (response PostApiAuthOidcLink400JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLink401JSONResponse ErrorResponse
+
+func (response PostApiAuthOidcLink401JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLink403JSONResponse ErrorResponse
+
+func (response PostApiAuthOidcLink403JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLink429JSONResponse ErrorResponse
+
+func (response PostApiAuthOidcLink429JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthOidcLink500JSONResponse ErrorResponse
+
+func (response PostApiAuthOidcLink500JSONResponse) VisitPostApiAuthOidcLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItemRequestObject struct {
+	Item   string `json:"item"`
+	Params GetApiBuyItemParams
+}
+
+type GetApiBuyItemResponseObject interface {
+	VisitGetApiBuyItemResponse(w http.ResponseWriter) error
+}
+
+type GetApiBuyItem200Response struct {
+}
+
+func (response GetApiBuyItem200Response) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetApiBuyItem400JSONResponse ErrorResponse
+
+func (response GetApiBuyItem400JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem401JSONResponse ErrorResponse
+
+func (response GetApiBuyItem401JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem403JSONResponse ErrorResponse
+
+func (response GetApiBuyItem403JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem500JSONResponse ErrorResponse
+
+func (response GetApiBuyItem500JSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCatalogRequestObject struct {
+	Params GetApiCatalogParams
+}
+
+type GetApiCatalogResponseObject interface {
+	VisitGetApiCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetApiCatalog200JSONResponse []CatalogItem
+
+func (response GetApiCatalog200JSONResponse) VisitGetApiCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCatalog400JSONResponse ErrorResponse
+
+func (response GetApiCatalog400JSONResponse) VisitGetApiCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCatalog401JSONResponse ErrorResponse
+
+func (response GetApiCatalog401JSONResponse) VisitGetApiCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCatalog500JSONResponse ErrorResponse
+
+func (response GetApiCatalog500JSONResponse) VisitGetApiCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCategoriesRequestObject struct {
+	Params GetApiCategoriesParams
+}
+
+type GetApiCategoriesResponseObject interface {
+	VisitGetApiCategoriesResponse(w http.ResponseWriter) error
+}
+
+type GetApiCategories200JSONResponse []Category
+
+func (response GetApiCategories200JSONResponse) VisitGetApiCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCategories400JSONResponse ErrorResponse
+
+func (response GetApiCategories400JSONResponse) VisitGetApiCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCategories401JSONResponse ErrorResponse
+
+func (response GetApiCategories401JSONResponse) VisitGetApiCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCategories500JSONResponse ErrorResponse
+
+func (response GetApiCategories500JSONResponse) VisitGetApiCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrantRequestObject struct {
+	Body *PostApiCoinsGrantJSONRequestBody
+}
+
+type PostApiCoinsGrantResponseObject interface {
+	VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error
+}
+
+type PostApiCoinsGrant200Response struct {
+}
+
+func (response PostApiCoinsGrant200Response) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiCoinsGrant400JSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant400JSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant401JSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant401JSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant403JSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant403JSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant404JSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant404JSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant500JSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant500JSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCurrenciesRequestObject struct {
+}
+
+type GetApiCurrenciesResponseObject interface {
+	VisitGetApiCurrenciesResponse(w http.ResponseWriter) error
+}
+
+type GetApiCurrencies200JSONResponse []Currency
+
+func (response GetApiCurrencies200JSONResponse) VisitGetApiCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCurrencies400JSONResponse ErrorResponse
+
+func (response GetApiCurrencies400JSONResponse) VisitGetApiCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCurrencies401JSONResponse ErrorResponse
+
+func (response GetApiCurrencies401JSONResponse) VisitGetApiCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiCurrencies500JSONResponse ErrorResponse
+
+func (response GetApiCurrencies500JSONResponse) VisitGetApiCurrenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesRequestObject struct {
+}
+
+type GetApiGroupPurchasesResponseObject interface {
+	VisitGetApiGroupPurchasesResponse(w http.ResponseWriter) error
+}
+
+type GetApiGroupPurchases200JSONResponse []GroupPurchase
+
+func (response GetApiGroupPurchases200JSONResponse) VisitGetApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchases400JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchases400JSONResponse) VisitGetApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchases401JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchases401JSONResponse) VisitGetApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchases500JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchases500JSONResponse) VisitGetApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesRequestObject struct {
+	Body *PostApiGroupPurchasesJSONRequestBody
+}
+
+type PostApiGroupPurchasesResponseObject interface {
+	VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error
+}
+
+type PostApiGroupPurchases200JSONResponse GroupPurchase
+
+func (response PostApiGroupPurchases200JSONResponse) VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchases400JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchases400JSONResponse) VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchases401JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchases401JSONResponse) VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchases404JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchases404JSONResponse) VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchases500JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchases500JSONResponse) VisitPostApiGroupPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetApiGroupPurchasesIdResponseObject interface {
+	VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiGroupPurchasesId200JSONResponse GroupPurchase
+
+func (response GetApiGroupPurchasesId200JSONResponse) VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesId400JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchasesId400JSONResponse) VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesId401JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchasesId401JSONResponse) VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesId404JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchasesId404JSONResponse) VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiGroupPurchasesId500JSONResponse ErrorResponse
+
+func (response GetApiGroupPurchasesId500JSONResponse) VisitGetApiGroupPurchasesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledgesRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostApiGroupPurchasesIdPledgesJSONRequestBody
+}
+
+type PostApiGroupPurchasesIdPledgesResponseObject interface {
+	VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error
+}
+
+type PostApiGroupPurchasesIdPledges200JSONResponse GroupPurchase
+
+func (response PostApiGroupPurchasesIdPledges200JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledges400JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchasesIdPledges400JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledges401JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchasesIdPledges401JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledges403JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchasesIdPledges403JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledges404JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchasesIdPledges404JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiGroupPurchasesIdPledges500JSONResponse ErrorResponse
+
+func (response PostApiGroupPurchasesIdPledges500JSONResponse) VisitPostApiGroupPurchasesIdPledgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfoRequestObject struct {
+}
+
+type GetApiInfoResponseObject interface {
+	VisitGetApiInfoResponse(w http.ResponseWriter) error
+}
+
+type GetApiInfo200JSONResponse InfoResponse
+
+func (response GetApiInfo200JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo400JSONResponse ErrorResponse
+
+func (response GetApiInfo400JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo401JSONResponse ErrorResponse
+
+func (response GetApiInfo401JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo500JSONResponse ErrorResponse
+
+func (response GetApiInfo500JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardItemsRequestObject struct {
+	Params GetApiLeaderboardItemsParams
+}
+
+type GetApiLeaderboardItemsResponseObject interface {
+	VisitGetApiLeaderboardItemsResponse(w http.ResponseWriter) error
+}
+
+type GetApiLeaderboardItems200JSONResponse []ItemRanking
+
+func (response GetApiLeaderboardItems200JSONResponse) VisitGetApiLeaderboardItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardItems400JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardItems400JSONResponse) VisitGetApiLeaderboardItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardItems401JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardItems401JSONResponse) VisitGetApiLeaderboardItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardItems500JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardItems500JSONResponse) VisitGetApiLeaderboardItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardReceiversRequestObject struct {
+	Params GetApiLeaderboardReceiversParams
+}
+
+type GetApiLeaderboardReceiversResponseObject interface {
+	VisitGetApiLeaderboardReceiversResponse(w http.ResponseWriter) error
+}
+
+type GetApiLeaderboardReceivers200JSONResponse []LeaderboardEntry
+
+func (response GetApiLeaderboardReceivers200JSONResponse) VisitGetApiLeaderboardReceiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardReceivers400JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardReceivers400JSONResponse) VisitGetApiLeaderboardReceiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardReceivers401JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardReceivers401JSONResponse) VisitGetApiLeaderboardReceiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardReceivers500JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardReceivers500JSONResponse) VisitGetApiLeaderboardReceiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardSendersRequestObject struct {
+	Params GetApiLeaderboardSendersParams
+}
+
+type GetApiLeaderboardSendersResponseObject interface {
+	VisitGetApiLeaderboardSendersResponse(w http.ResponseWriter) error
+}
+
+type GetApiLeaderboardSenders200JSONResponse []LeaderboardEntry
+
+func (response GetApiLeaderboardSenders200JSONResponse) VisitGetApiLeaderboardSendersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardSenders400JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardSenders400JSONResponse) VisitGetApiLeaderboardSendersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardSenders401JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardSenders401JSONResponse) VisitGetApiLeaderboardSendersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiLeaderboardSenders500JSONResponse ErrorResponse
+
+func (response GetApiLeaderboardSenders500JSONResponse) VisitGetApiLeaderboardSendersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiMfaRequestObject struct {
+}
+
+type GetApiMfaResponseObject interface {
+	VisitGetApiMfaResponse(w http.ResponseWriter) error
+}
+
+type GetApiMfa200JSONResponse MFAStatus
+
+func (response GetApiMfa200JSONResponse) VisitGetApiMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiMfa400JSONResponse ErrorResponse
+
+func (response GetApiMfa400JSONResponse) VisitGetApiMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiMfa401JSONResponse ErrorResponse
+
+func (response GetApiMfa401JSONResponse) VisitGetApiMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiMfa500JSONResponse ErrorResponse
+
+func (response GetApiMfa500JSONResponse) VisitGetApiMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirmRequestObject struct {
+	Body *PostApiMfaConfirmJSONRequestBody
+}
+
+type PostApiMfaConfirmResponseObject interface {
+	VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaConfirm200JSONResponse RecoveryCodes
+
+func (response PostApiMfaConfirm200JSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirm400JSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm400JSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirm401JSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm401JSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirm500JSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm500JSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisableRequestObject struct {
+	Body *PostApiMfaDisableJSONRequestBody
+}
+
+type PostApiMfaDisableResponseObject interface {
+	VisitPostApiMfaDisableResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaDisable200Response struct {
+}
+
+func (response PostApiMfaDisable200Response) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiMfaDisable400JSONResponse ErrorResponse
+
+func (response PostApiMfaDisable400JSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisable401JSONResponse ErrorResponse
+
+func (response PostApiMfaDisable401JSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisable403JSONResponse ErrorResponse
+
+func (response PostApiMfaDisable403JSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisable500JSONResponse ErrorResponse
+
+func (response PostApiMfaDisable500JSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnrollRequestObject struct {
+}
+
+type PostApiMfaEnrollResponseObject interface {
+	VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaEnroll200JSONResponse MFAEnrollment
+
+func (response PostApiMfaEnroll200JSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll400JSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll400JSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll401JSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll401JSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll500JSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll500JSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaRecoveryCodesRequestObject struct {
+	Body *PostApiMfaRecoveryCodesJSONRequestBody
+}
+
+type PostApiMfaRecoveryCodesResponseObject interface {
+	VisitPostApiMfaRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaRecoveryCodes200JSONResponse RecoveryCodes
+
+func (response PostApiMfaRecoveryCodes200JSONResponse) VisitPostApiMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaRecoveryCodes400JSONResponse ErrorResponse
+
+func (response PostApiMfaRecoveryCodes400JSONResponse) VisitPostApiMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaRecoveryCodes401JSONResponse ErrorResponse
+
+func (response PostApiMfaRecoveryCodes401JSONResponse) VisitPostApiMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaRecoveryCodes500JSONResponse ErrorResponse
+
+func (response PostApiMfaRecoveryCodes500JSONResponse) VisitPostApiMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotificationsRequestObject struct {
+	Params GetApiNotificationsParams
+}
+
+type GetApiNotificationsResponseObject interface {
+	VisitGetApiNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetApiNotifications200JSONResponse []Notification
+
+func (response GetApiNotifications200JSONResponse) VisitGetApiNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotifications400JSONResponse ErrorResponse
+
+func (response GetApiNotifications400JSONResponse) VisitGetApiNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotifications401JSONResponse ErrorResponse
+
+func (response GetApiNotifications401JSONResponse) VisitGetApiNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotifications500JSONResponse ErrorResponse
+
+func (response GetApiNotifications500JSONResponse) VisitGetApiNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiNotificationsReadRequestObject struct {
+	Body *PostApiNotificationsReadJSONRequestBody
+}
+
+type PostApiNotificationsReadResponseObject interface {
+	VisitPostApiNotificationsReadResponse(w http.ResponseWriter) error
+}
+
+type PostApiNotificationsRead200Response struct {
+}
+
+func (response PostApiNotificationsRead200Response) VisitPostApiNotificationsReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiNotificationsRead400JSONResponse ErrorResponse
+
+func (response PostApiNotificationsRead400JSONResponse) VisitPostApiNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiNotificationsRead401JSONResponse ErrorResponse
+
+func (response PostApiNotificationsRead401JSONResponse) VisitPostApiNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiNotificationsRead500JSONResponse ErrorResponse
+
+func (response PostApiNotificationsRead500JSONResponse) VisitPostApiNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotificationsUnreadCountRequestObject struct {
+}
+
+type GetApiNotificationsUnreadCountResponseObject interface {
+	VisitGetApiNotificationsUnreadCountResponse(w http.ResponseWriter) error
+}
+
+type GetApiNotificationsUnreadCount200JSONResponse UnreadCountResponse
+
+func (response GetApiNotificationsUnreadCount200JSONResponse) VisitGetApiNotificationsUnreadCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotificationsUnreadCount400JSONResponse ErrorResponse
+
+func (response GetApiNotificationsUnreadCount400JSONResponse) VisitGetApiNotificationsUnreadCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotificationsUnreadCount401JSONResponse ErrorResponse
+
+func (response GetApiNotificationsUnreadCount401JSONResponse) VisitGetApiNotificationsUnreadCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiNotificationsUnreadCount500JSONResponse ErrorResponse
+
+func (response GetApiNotificationsUnreadCount500JSONResponse) VisitGetApiNotificationsUnreadCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordRequestObject struct {
+	Body *PostApiPasswordJSONRequestBody
+}
+
+type PostApiPasswordResponseObject interface {
+	VisitPostApiPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostApiPassword200Response struct {
+}
+
+func (response PostApiPassword200Response) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiPassword400JSONResponse ErrorResponse
+
+func (response PostApiPassword400JSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword401JSONResponse ErrorResponse
+
+func (response PostApiPassword401JSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword403JSONResponse ErrorResponse
+
+func (response PostApiPassword403JSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword429JSONResponse ErrorResponse
+
+func (response PostApiPassword429JSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword500JSONResponse ErrorResponse
+
+func (response PostApiPassword500JSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordResetRequestObject struct {
+	Body *PostApiPasswordResetJSONRequestBody
+}
+
+type PostApiPasswordResetResponseObject interface {
+	VisitPostApiPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostApiPasswordReset200Response struct {
+}
+
+func (response PostApiPasswordReset200Response) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiPasswordReset400JSONResponse ErrorResponse
+
+func (response PostApiPasswordReset400JSONResponse) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordReset500JSONResponse ErrorResponse
+
+func (response PostApiPasswordReset500JSONResponse) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfileRequestObject struct {
+}
+
+type GetApiProfileResponseObject interface {
+	VisitGetApiProfileResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfile200JSONResponse Profile
+
+func (response GetApiProfile200JSONResponse) VisitGetApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfile400JSONResponse ErrorResponse
+
+func (response GetApiProfile400JSONResponse) VisitGetApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfile401JSONResponse ErrorResponse
+
+func (response GetApiProfile401JSONResponse) VisitGetApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfile500JSONResponse ErrorResponse
+
+func (response GetApiProfile500JSONResponse) VisitGetApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfileRequestObject struct {
+	Body *PutApiProfileJSONRequestBody
+}
+
+type PutApiProfileResponseObject interface {
+	VisitPutApiProfileResponse(w http.ResponseWriter) error
+}
+
+type PutApiProfile200JSONResponse Profile
+
+func (response PutApiProfile200JSONResponse) VisitPutApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfile400JSONResponse ErrorResponse
+
+func (response PutApiProfile400JSONResponse) VisitPutApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfile401JSONResponse ErrorResponse
+
+func (response PutApiProfile401JSONResponse) VisitPutApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfile500JSONResponse ErrorResponse
+
+func (response PutApiProfile500JSONResponse) VisitPutApiProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfileExportRequestObject struct {
+}
+
+type GetApiProfileExportResponseObject interface {
+	VisitGetApiProfileExportResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfileExport200JSONResponse DataExport
+
+func (response GetApiProfileExport200JSONResponse) VisitGetApiProfileExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfileExport400JSONResponse ErrorResponse
+
+func (response GetApiProfileExport400JSONResponse) VisitGetApiProfileExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfileExport401JSONResponse ErrorResponse
+
+func (response GetApiProfileExport401JSONResponse) VisitGetApiProfileExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfileExport500JSONResponse ErrorResponse
+
+func (response GetApiProfileExport500JSONResponse) VisitGetApiProfileExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiPurchasesRequestObject struct {
+}
+
+type GetApiPurchasesResponseObject interface {
+	VisitGetApiPurchasesResponse(w http.ResponseWriter) error
+}
+
+type GetApiPurchases200JSONResponse []Purchase
+
+func (response GetApiPurchases200JSONResponse) VisitGetApiPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiPurchases400JSONResponse ErrorResponse
+
+func (response GetApiPurchases400JSONResponse) VisitGetApiPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiPurchases401JSONResponse ErrorResponse
+
+func (response GetApiPurchases401JSONResponse) VisitGetApiPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiPurchases500JSONResponse ErrorResponse
+
+func (response GetApiPurchases500JSONResponse) VisitGetApiPurchasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoinRequestObject struct {
+	Body *PostApiSendCoinJSONRequestBody
+}
+
+type PostApiSendCoinResponseObject interface {
+	VisitPostApiSendCoinResponse(w http.ResponseWriter) error
+}
+
+type PostApiSendCoin200Response struct {
+}
+
+func (response PostApiSendCoin200Response) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiSendCoin400JSONResponse ErrorResponse
+
+func (response PostApiSendCoin400JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin401JSONResponse ErrorResponse
+
+func (response PostApiSendCoin401JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin403JSONResponse ErrorResponse
+
+func (response PostApiSendCoin403JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin500JSONResponse ErrorResponse
+
+func (response PostApiSendCoin500JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiStatsUsernameRequestObject struct {
+	Username string `json:"username"`
+	Params   GetApiStatsUsernameParams
+}
+
+type GetApiStatsUsernameResponseObject interface {
+	VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error
+}
+
+type GetApiStatsUsername200JSONResponse UserStats
+
+func (response GetApiStatsUsername200JSONResponse) VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiStatsUsername400JSONResponse ErrorResponse
+
+func (response GetApiStatsUsername400JSONResponse) VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiStatsUsername401JSONResponse ErrorResponse
+
+func (response GetApiStatsUsername401JSONResponse) VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiStatsUsername404JSONResponse ErrorResponse
+
+func (response GetApiStatsUsername404JSONResponse) VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiStatsUsername500JSONResponse ErrorResponse
+
+func (response GetApiStatsUsername500JSONResponse) VisitGetApiStatsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsRequestObject struct {
+}
+
+type GetApiTeamsResponseObject interface {
+	VisitGetApiTeamsResponse(w http.ResponseWriter) error
+}
+
+type GetApiTeams200JSONResponse []Team
+
+func (response GetApiTeams200JSONResponse) VisitGetApiTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeams400JSONResponse ErrorResponse
+
+func (response GetApiTeams400JSONResponse) VisitGetApiTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeams401JSONResponse ErrorResponse
+
+func (response GetApiTeams401JSONResponse) VisitGetApiTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeams500JSONResponse ErrorResponse
+
+func (response GetApiTeams500JSONResponse) VisitGetApiTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetApiTeamsNameResponseObject interface {
+	VisitGetApiTeamsNameResponse(w http.ResponseWriter) error
+}
+
+type GetApiTeamsName200JSONResponse Team
+
+func (response GetApiTeamsName200JSONResponse) VisitGetApiTeamsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsName400JSONResponse ErrorResponse
+
+func (response GetApiTeamsName400JSONResponse) VisitGetApiTeamsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsName401JSONResponse ErrorResponse
+
+func (response GetApiTeamsName401JSONResponse) VisitGetApiTeamsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsName404JSONResponse ErrorResponse
+
+func (response GetApiTeamsName404JSONResponse) VisitGetApiTeamsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsName500JSONResponse ErrorResponse
+
+func (response GetApiTeamsName500JSONResponse) VisitGetApiTeamsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactionsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetApiTeamsNameTransactionsParams
+}
+
+type GetApiTeamsNameTransactionsResponseObject interface {
+	VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetApiTeamsNameTransactions200JSONResponse []TeamTransaction
+
+func (response GetApiTeamsNameTransactions200JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactions400JSONResponse ErrorResponse
+
+func (response GetApiTeamsNameTransactions400JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactions401JSONResponse ErrorResponse
+
+func (response GetApiTeamsNameTransactions401JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactions403JSONResponse ErrorResponse
+
+func (response GetApiTeamsNameTransactions403JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactions404JSONResponse ErrorResponse
+
+func (response GetApiTeamsNameTransactions404JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTeamsNameTransactions500JSONResponse ErrorResponse
+
+func (response GetApiTeamsNameTransactions500JSONResponse) VisitGetApiTeamsNameTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiTeamsNameTransferRequestObject struct {
+	Name string `json:"name"`
+	Body *PostApiTeamsNameTransferJSONRequestBody
+}
+
+type PostApiTeamsNameTransferResponseObject interface {
+	VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error
+}
+
+type PostApiTeamsNameTransfer200Response struct {
+}
+
+func (response PostApiTeamsNameTransfer200Response) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiTeamsNameTransfer400JSONResponse ErrorResponse
+
+func (response PostApiTeamsNameTransfer400JSONResponse) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiTeamsNameTransfer401JSONResponse ErrorResponse
+
+func (response PostApiTeamsNameTransfer401JSONResponse) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiTeamsNameTransfer403JSONResponse ErrorResponse
+
+func (response PostApiTeamsNameTransfer403JSONResponse) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiTeamsNameTransfer404JSONResponse ErrorResponse
+
+func (response PostApiTeamsNameTransfer404JSONResponse) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiTeamsNameTransfer500JSONResponse ErrorResponse
+
+func (response PostApiTeamsNameTransfer500JSONResponse) VisitPostApiTeamsNameTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersRequestObject struct {
+	Params GetApiUsersParams
+}
+
+type GetApiUsersResponseObject interface {
+	VisitGetApiUsersResponse(w http.ResponseWriter) error
+}
+
+type GetApiUsers200JSONResponse []DirectoryEntry
+
+func (response GetApiUsers200JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsers400JSONResponse ErrorResponse
+
+func (response GetApiUsers400JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsers401JSONResponse ErrorResponse
+
+func (response GetApiUsers401JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsers500JSONResponse ErrorResponse
+
+func (response GetApiUsers500JSONResponse) VisitGetApiUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfoRequestObject struct {
+	Username string `json:"username"`
+}
+
+type GetApiUsersUsernameInfoResponseObject interface {
+	VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error
+}
+
+type GetApiUsersUsernameInfo200JSONResponse InfoResponse
+
+func (response GetApiUsersUsernameInfo200JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfo400JSONResponse ErrorResponse
+
+func (response GetApiUsersUsernameInfo400JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfo401JSONResponse ErrorResponse
+
+func (response GetApiUsersUsernameInfo401JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfo403JSONResponse ErrorResponse
+
+func (response GetApiUsersUsernameInfo403JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfo404JSONResponse ErrorResponse
+
+func (response GetApiUsersUsernameInfo404JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiUsersUsernameInfo500JSONResponse ErrorResponse
+
+func (response GetApiUsersUsernameInfo500JSONResponse) VisitGetApiUsersUsernameInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiWishlistRequestObject struct {
+}
+
+type GetApiWishlistResponseObject interface {
+	VisitGetApiWishlistResponse(w http.ResponseWriter) error
+}
+
+type GetApiWishlist200JSONResponse []WishlistItem
+
+func (response GetApiWishlist200JSONResponse) VisitGetApiWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiWishlist400JSONResponse ErrorResponse
+
+func (response GetApiWishlist400JSONResponse) VisitGetApiWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiWishlist401JSONResponse ErrorResponse
+
+func (response GetApiWishlist401JSONResponse) VisitGetApiWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiWishlist500JSONResponse ErrorResponse
+
+func (response GetApiWishlist500JSONResponse) VisitGetApiWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiWishlistItemRequestObject struct {
+	Item string `json:"item"`
+}
+
+type DeleteApiWishlistItemResponseObject interface {
+	VisitDeleteApiWishlistItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiWishlistItem200Response struct {
+}
+
+func (response DeleteApiWishlistItem200Response) VisitDeleteApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiWishlistItem400JSONResponse ErrorResponse
+
+func (response DeleteApiWishlistItem400JSONResponse) VisitDeleteApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiWishlistItem401JSONResponse ErrorResponse
+
+func (response DeleteApiWishlistItem401JSONResponse) VisitDeleteApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiWishlistItem500JSONResponse ErrorResponse
+
+func (response DeleteApiWishlistItem500JSONResponse) VisitDeleteApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiWishlistItemRequestObject struct {
+	Item string `json:"item"`
+}
+
+type PutApiWishlistItemResponseObject interface {
+	VisitPutApiWishlistItemResponse(w http.ResponseWriter) error
+}
+
+type PutApiWishlistItem200Response struct {
+}
+
+func (response PutApiWishlistItem200Response) VisitPutApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiWishlistItem400JSONResponse ErrorResponse
+
+func (response PutApiWishlistItem400JSONResponse) VisitPutApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiWishlistItem401JSONResponse ErrorResponse
+
+func (response PutApiWishlistItem401JSONResponse) VisitPutApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiWishlistItem500JSONResponse ErrorResponse
+
+func (response PutApiWishlistItem500JSONResponse) VisitPutApiWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Отозвать API-ключ (только для администраторов).
+	// (DELETE /api/admin/apiKeys/{id})
+	DeleteApiAdminApiKeysId(ctx context.Context, request DeleteApiAdminApiKeysIdRequestObject) (DeleteApiAdminApiKeysIdResponseObject, error)
+	// Создать аукцион предмета (только для администраторов). Аукцион закрывается автоматически по истечении срока.
+	// (POST /api/admin/auctions)
+	PostApiAdminAuctions(ctx context.Context, request PostApiAdminAuctionsRequestObject) (PostApiAdminAuctionsResponseObject, error)
+	// Создать валюту, например непереводимые бонусные баллы (только для администраторов).
+	// (POST /api/admin/currencies)
+	PostApiAdminCurrencies(ctx context.Context, request PostApiAdminCurrenciesRequestObject) (PostApiAdminCurrenciesResponseObject, error)
+	// Получить лимиты на переводы и покупки для пользователей и ролей (только для администраторов).
+	// (GET /api/admin/limits)
+	GetApiAdminLimits(ctx context.Context, request GetApiAdminLimitsRequestObject) (GetApiAdminLimitsResponseObject, error)
+	// Удалить лимиты пользователя или роли (только для администраторов).
+	// (DELETE /api/admin/limits/{scope}/{subject})
+	DeleteApiAdminLimitsScopeSubject(ctx context.Context, request DeleteApiAdminLimitsScopeSubjectRequestObject) (DeleteApiAdminLimitsScopeSubjectResponseObject, error)
+	// Задать лимиты пользователя или роли (только для администраторов). Собственные лимиты пользователя имеют приоритет над лимитами его роли.
+	// (PUT /api/admin/limits/{scope}/{subject})
+	PutApiAdminLimitsScopeSubject(ctx context.Context, request PutApiAdminLimitsScopeSubjectRequestObject) (PutApiAdminLimitsScopeSubjectResponseObject, error)
+	// Изменить валюту, в которой указана цена предмета (только для администраторов). Сама цена не меняется.
+	// (PUT /api/admin/products/{item}/currency)
+	PutApiAdminProductsItemCurrency(ctx context.Context, request PutApiAdminProductsItemCurrencyRequestObject) (PutApiAdminProductsItemCurrencyResponseObject, error)
+	// Добавить вариант предмета или изменить его размер, цвет, остаток и цену (только для администраторов).
+	// (PUT /api/admin/products/{item}/variants/{sku})
+	PutApiAdminProductsItemVariantsSku(ctx context.Context, request PutApiAdminProductsItemVariantsSkuRequestObject) (PutApiAdminProductsItemVariantsSkuResponseObject, error)
+	// Получить список промокодов (только для администраторов).
+	// (GET /api/admin/promoCodes)
+	GetApiAdminPromoCodes(ctx context.Context, request GetApiAdminPromoCodesRequestObject) (GetApiAdminPromoCodesResponseObject, error)
+	// Создать промокод (только для администраторов).
+	// (POST /api/admin/promoCodes)
+	PostApiAdminPromoCodes(ctx context.Context, request PostApiAdminPromoCodesRequestObject) (PostApiAdminPromoCodesResponseObject, error)
+	// Удалить промокод, который еще не использовали (только для администраторов).
+	// (DELETE /api/admin/promoCodes/{code})
+	DeleteApiAdminPromoCodesCode(ctx context.Context, request DeleteApiAdminPromoCodesCodeRequestObject) (DeleteApiAdminPromoCodesCodeResponseObject, error)
+	// Выгрузить отчёт о переводах, покупках или балансах за период (только для администраторов).
+	// (GET /api/admin/reports/{kind})
+	GetApiAdminReportsKind(ctx context.Context, request GetApiAdminReportsKindRequestObject) (GetApiAdminReportsKindResponseObject, error)
+	// Получить список распродаж, включая прошедшие и будущие (только для администраторов).
+	// (GET /api/admin/sales)
+	GetApiAdminSales(ctx context.Context, request GetApiAdminSalesRequestObject) (GetApiAdminSalesResponseObject, error)
+	// Запланировать распродажу предмета или категории (только для администраторов).
+	// (POST /api/admin/sales)
+	PostApiAdminSales(ctx context.Context, request PostApiAdminSalesRequestObject) (PostApiAdminSalesResponseObject, error)
+	// Удалить распродажу, по которой еще не было покупок (только для администраторов).
+	// (DELETE /api/admin/sales/{id})
+	DeleteApiAdminSalesId(ctx context.Context, request DeleteApiAdminSalesIdRequestObject) (DeleteApiAdminSalesIdResponseObject, error)
+	// Получить список сервисных аккаунтов (только для администраторов).
+	// (GET /api/admin/serviceAccounts)
+	GetApiAdminServiceAccounts(ctx context.Context, request GetApiAdminServiceAccountsRequestObject) (GetApiAdminServiceAccountsResponseObject, error)
+	// Создать сервисный аккаунт (только для администраторов).
+	// (POST /api/admin/serviceAccounts)
+	PostApiAdminServiceAccounts(ctx context.Context, request PostApiAdminServiceAccountsRequestObject) (PostApiAdminServiceAccountsResponseObject, error)
+	// Получить список API-ключей сервисного аккаунта, включая отозванные (только для администраторов).
+	// (GET /api/admin/serviceAccounts/{name}/keys)
+	GetApiAdminServiceAccountsNameKeys(ctx context.Context, request GetApiAdminServiceAccountsNameKeysRequestObject) (GetApiAdminServiceAccountsNameKeysResponseObject, error)
+	// Выпустить API-ключ сервисного аккаунта (только для администраторов). Ключ возвращается только в этом ответе.
+	// (POST /api/admin/serviceAccounts/{name}/keys)
+	PostApiAdminServiceAccountsNameKeys(ctx context.Context, request PostApiAdminServiceAccountsNameKeysRequestObject) (PostApiAdminServiceAccountsNameKeysResponseObject, error)
+	// Создать команду с пустым кошельком (только для администраторов).
+	// (POST /api/admin/teams)
+	PostApiAdminTeams(ctx context.Context, request PostApiAdminTeamsRequestObject) (PostApiAdminTeamsResponseObject, error)
+	// Пополнить кошелек команды новыми монетами (только для администраторов).
+	// (POST /api/admin/teams/{name}/deposit)
+	PostApiAdminTeamsNameDeposit(ctx context.Context, request PostApiAdminTeamsNameDepositRequestObject) (PostApiAdminTeamsNameDepositResponseObject, error)
+	// Исключить пользователя из команды (только для администраторов).
+	// (DELETE /api/admin/teams/{name}/members/{username})
+	DeleteApiAdminTeamsNameMembersUsername(ctx context.Context, request DeleteApiAdminTeamsNameMembersUsernameRequestObject) (DeleteApiAdminTeamsNameMembersUsernameResponseObject, error)
+	// Добавить пользователя в команду или изменить его роль в ней (только для администраторов).
+	// (PUT /api/admin/teams/{name}/members/{username})
+	PutApiAdminTeamsNameMembersUsername(ctx context.Context, request PutApiAdminTeamsNameMembersUsernameRequestObject) (PutApiAdminTeamsNameMembersUsernameResponseObject, error)
+	// Скорректировать баланс пользователя (только для администраторов). Отрицательная сумма списывает монеты; пользователь получает уведомление.
+	// (POST /api/admin/users/{username}/balance)
+	PostApiAdminUsersUsernameBalance(ctx context.Context, request PostApiAdminUsersUsernameBalanceRequestObject) (PostApiAdminUsersUsernameBalanceResponseObject, error)
+	// Деактивировать пользователя (только для администраторов). Пользователь больше не может войти и получать монеты, история сохраняется.
+	// (POST /api/admin/users/{username}/deactivate)
+	PostApiAdminUsersUsernameDeactivate(ctx context.Context, request PostApiAdminUsersUsernameDeactivateRequestObject) (PostApiAdminUsersUsernameDeactivateResponseObject, error)
+	// Удалить пользователя по его запросу (только для администраторов). Имя пользователя в истории переводов и покупок и в журнале аудита заменяется псевдонимом, IP-адреса и User-Agent его записей аудита стираются, личные данные удаляются. Зарегистрироваться под этим именем повторно нельзя.
+	// (POST /api/admin/users/{username}/erase)
+	PostApiAdminUsersUsernameErase(ctx context.Context, request PostApiAdminUsersUsernameEraseRequestObject) (PostApiAdminUsersUsernameEraseResponseObject, error)
+	// Сбросить двухфакторную аутентификацию пользователя, потерявшего доступ к ней (только для администраторов). Администратор настраивает ее заново при следующем входе.
+	// (DELETE /api/admin/users/{username}/mfa)
+	DeleteApiAdminUsersUsernameMfa(ctx context.Context, request DeleteApiAdminUsersUsernameMfaRequestObject) (DeleteApiAdminUsersUsernameMfaResponseObject, error)
+	// Выдать одноразовый токен сброса пароля пользователя (только для администраторов). Ранее выданные неиспользованные токены отзываются.
+	// (POST /api/admin/users/{username}/passwordReset)
+	PostApiAdminUsersUsernamePasswordReset(ctx context.Context, request PostApiAdminUsersUsernamePasswordResetRequestObject) (PostApiAdminUsersUsernamePasswordResetResponseObject, error)
+	// Снова активировать деактивированного пользователя (только для администраторов).
+	// (POST /api/admin/users/{username}/reactivate)
+	PostApiAdminUsersUsernameReactivate(ctx context.Context, request PostApiAdminUsersUsernameReactivateRequestObject) (PostApiAdminUsersUsernameReactivateResponseObject, error)
+	// Снять блокировку входа пользователя после неудачных попыток (только для администраторов).
+	// (POST /api/admin/users/{username}/unlock)
+	PostApiAdminUsersUsernameUnlock(ctx context.Context, request PostApiAdminUsersUsernameUnlockRequestObject) (PostApiAdminUsersUsernameUnlockResponseObject, error)
+	// Получить список подписок на вебхуки (только для администраторов).
+	// (GET /api/admin/webhooks)
+	GetApiAdminWebhooks(ctx context.Context, request GetApiAdminWebhooksRequestObject) (GetApiAdminWebhooksResponseObject, error)
+	// Создать подписку на вебхуки (только для администраторов).
+	// (POST /api/admin/webhooks)
+	PostApiAdminWebhooks(ctx context.Context, request PostApiAdminWebhooksRequestObject) (PostApiAdminWebhooksResponseObject, error)
+	// Повторно отправить доставку, исчерпавшую попытки (только для администраторов).
+	// (POST /api/admin/webhooks/deliveries/{deliveryId}/retry)
+	PostApiAdminWebhooksDeliveriesDeliveryIdRetry(ctx context.Context, request PostApiAdminWebhooksDeliveriesDeliveryIdRetryRequestObject) (PostApiAdminWebhooksDeliveriesDeliveryIdRetryResponseObject, error)
+	// Удалить подписку на вебхуки вместе с журналом доставок (только для администраторов).
+	// (DELETE /api/admin/webhooks/{id})
+	DeleteApiAdminWebhooksId(ctx context.Context, request DeleteApiAdminWebhooksIdRequestObject) (DeleteApiAdminWebhooksIdResponseObject, error)
+	// Получить журнал доставок подписки, начиная с самых новых (только для администраторов).
+	// (GET /api/admin/webhooks/{id}/deliveries)
+	GetApiAdminWebhooksIdDeliveries(ctx context.Context, request GetApiAdminWebhooksIdDeliveriesRequestObject) (GetApiAdminWebhooksIdDeliveriesResponseObject, error)
+	// Получить открытые аукционы, начиная с ближайших к завершению.
+	// (GET /api/auctions)
+	GetApiAuctions(ctx context.Context, request GetApiAuctionsRequestObject) (GetApiAuctionsResponseObject, error)
+	// Получить аукцион вместе со ставками.
+	// (GET /api/auctions/{id})
+	GetApiAuctionsId(ctx context.Context, request GetApiAuctionsIdRequestObject) (GetApiAuctionsIdResponseObject, error)
+	// Сделать ставку. Монеты ставки блокируются до тех пор, пока ставку не перебьют, а по завершении аукциона списываются в оплату предмета.
+	// (POST /api/auctions/{id}/bids)
+	PostApiAuctionsIdBids(ctx context.Context, request PostApiAuctionsIdBidsRequestObject) (PostApiAuctionsIdBidsResponseObject, error)
+	// Получить записи журнала аудита, начиная с самых новых (только для аудиторов).
+	// (GET /api/audit)
+	GetApiAudit(ctx context.Context, request GetApiAuditRequestObject) (GetApiAuditResponseObject, error)
+	// Проверить целостность цепочки хешей журнала аудита (только для аудиторов).
+	// (GET /api/audit/verify)
+	GetApiAuditVerify(ctx context.Context, request GetApiAuditVerifyRequestObject) (GetApiAuditVerifyResponseObject, error)
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// (POST /api/auth)
+	PostApiAuth(ctx context.Context, request PostApiAuthRequestObject) (PostApiAuthResponseObject, error)
+	// Завершить вход кодом TOTP или кодом восстановления. Не требует аутентификации. Администратор, настраивающий TOTP при входе, получает в ответе коды восстановления.
+	// (POST /api/auth/mfa)
+	PostApiAuthMfa(ctx context.Context, request PostApiAuthMfaRequestObject) (PostApiAuthMfaResponseObject, error)
+	// Начать настройку TOTP по токену входа. Нужно администраторам, у которых двухфакторная аутентификация еще не настроена. Не требует аутентификации.
+	// (POST /api/auth/mfa/enroll)
+	PostApiAuthMfaEnroll(ctx context.Context, request PostApiAuthMfaEnrollRequestObject) (PostApiAuthMfaEnrollResponseObject, error)
+	// Подтвердить паролем связь внешней учетной записи с существующим пользователем и войти. Не требует аутентификации.
+	// (POST /api/auth/oidc/link)
+	PostApiAuthOidcLink(ctx context.Context, request PostApiAuthOidcLinkRequestObject) (PostApiAuthOidcLinkResponseObject, error)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetApiBuyItem(ctx context.Context, request GetApiBuyItemRequestObject) (GetApiBuyItemResponseObject, error)
+	// Получить каталог товаров с фильтрацией и сортировкой.
+	// (GET /api/catalog)
+	GetApiCatalog(ctx context.Context, request GetApiCatalogRequestObject) (GetApiCatalogResponseObject, error)
+	// Получить список категорий товаров.
+	// (GET /api/categories)
+	GetApiCategories(ctx context.Context, request GetApiCategoriesRequestObject) (GetApiCategoriesResponseObject, error)
+	// Начислить пользователю монеты. Доступно администраторам и сервисным аккаунтам с областью coins:grant.
+	// (POST /api/coins/grant)
+	PostApiCoinsGrant(ctx context.Context, request PostApiCoinsGrantRequestObject) (PostApiCoinsGrantResponseObject, error)
+	// Получить список валют магазина.
+	// (GET /api/currencies)
+	GetApiCurrencies(ctx context.Context, request GetApiCurrenciesRequestObject) (GetApiCurrenciesResponseObject, error)
+	// Получить открытые групповые покупки, начиная с ближайших к сроку.
+	// (GET /api/groupPurchases)
+	GetApiGroupPurchases(ctx context.Context, request GetApiGroupPurchasesRequestObject) (GetApiGroupPurchasesResponseObject, error)
+	// Начать групповую покупку предмета в подарок коллеге. Предмет покупается по обычной цене, как только взносы ее покроют; если этого не произошло к сроку, взносы возвращаются.
+	// (POST /api/groupPurchases)
+	PostApiGroupPurchases(ctx context.Context, request PostApiGroupPurchasesRequestObject) (PostApiGroupPurchasesResponseObject, error)
+	// Получить групповую покупку вместе со взносами.
+	// (GET /api/groupPurchases/{id})
+	GetApiGroupPurchasesId(ctx context.Context, request GetApiGroupPurchasesIdRequestObject) (GetApiGroupPurchasesIdResponseObject, error)
+	// Внести монеты в групповую покупку. Монеты блокируются до покупки предмета или возврата взносов.
+	// (POST /api/groupPurchases/{id}/pledges)
+	PostApiGroupPurchasesIdPledges(ctx context.Context, request PostApiGroupPurchasesIdPledgesRequestObject) (PostApiGroupPurchasesIdPledgesResponseObject, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetApiInfo(ctx context.Context, request GetApiInfoRequestObject) (GetApiInfoResponseObject, error)
+	// Получить самые покупаемые предметы за период.
+	// (GET /api/leaderboard/items)
+	GetApiLeaderboardItems(ctx context.Context, request GetApiLeaderboardItemsRequestObject) (GetApiLeaderboardItemsResponseObject, error)
+	// Получить пользователей, получивших больше всего монет за период.
+	// (GET /api/leaderboard/receivers)
+	GetApiLeaderboardReceivers(ctx context.Context, request GetApiLeaderboardReceiversRequestObject) (GetApiLeaderboardReceiversResponseObject, error)
+	// Получить пользователей, отправивших больше всего монет за период.
+	// (GET /api/leaderboard/senders)
+	GetApiLeaderboardSenders(ctx context.Context, request GetApiLeaderboardSendersRequestObject) (GetApiLeaderboardSendersResponseObject, error)
+	// Получить состояние двухфакторной аутентификации текущего пользователя.
+	// (GET /api/mfa)
+	GetApiMfa(ctx context.Context, request GetApiMfaRequestObject) (GetApiMfaResponseObject, error)
+	// Подтвердить настройку TOTP первым кодом и включить двухфакторную аутентификацию. Коды восстановления возвращаются только в этом ответе.
+	// (POST /api/mfa/confirm)
+	PostApiMfaConfirm(ctx context.Context, request PostApiMfaConfirmRequestObject) (PostApiMfaConfirmResponseObject, error)
+	// Отключить двухфакторную аутентификацию кодом TOTP или кодом восстановления. Администраторам недоступно.
+	// (POST /api/mfa/disable)
+	PostApiMfaDisable(ctx context.Context, request PostApiMfaDisableRequestObject) (PostApiMfaDisableResponseObject, error)
+	// Начать настройку TOTP. Заменяет неподтвержденную настройку, если она есть.
+	// (POST /api/mfa/enroll)
+	PostApiMfaEnroll(ctx context.Context, request PostApiMfaEnrollRequestObject) (PostApiMfaEnrollResponseObject, error)
+	// Заменить коды восстановления новыми. Требует код TOTP.
+	// (POST /api/mfa/recoveryCodes)
+	PostApiMfaRecoveryCodes(ctx context.Context, request PostApiMfaRecoveryCodesRequestObject) (PostApiMfaRecoveryCodesResponseObject, error)
+	// Получить уведомления пользователя, начиная с самых новых.
+	// (GET /api/notifications)
+	GetApiNotifications(ctx context.Context, request GetApiNotificationsRequestObject) (GetApiNotificationsResponseObject, error)
+	// Отметить уведомления прочитанными.
+	// (POST /api/notifications/read)
+	PostApiNotificationsRead(ctx context.Context, request PostApiNotificationsReadRequestObject) (PostApiNotificationsReadResponseObject, error)
+	// Получить количество непрочитанных уведомлений.
+	// (GET /api/notifications/unreadCount)
+	GetApiNotificationsUnreadCount(ctx context.Context, request GetApiNotificationsUnreadCountRequestObject) (GetApiNotificationsUnreadCountResponseObject, error)
+	// Сменить пароль текущего пользователя.
+	// (POST /api/password)
+	PostApiPassword(ctx context.Context, request PostApiPasswordRequestObject) (PostApiPasswordResponseObject, error)
+	// Задать новый пароль по одноразовому токену сброса, выданному администратором. Не требует аутентификации.
+	// (POST /api/password/reset)
+	PostApiPasswordReset(ctx context.Context, request PostApiPasswordResetRequestObject) (PostApiPasswordResetResponseObject, error)
+	// Получить профиль текущего пользователя.
+	// (GET /api/profile)
+	GetApiProfile(ctx context.Context, request GetApiProfileRequestObject) (GetApiProfileResponseObject, error)
+	// Изменить профиль текущего пользователя. Изменяются только переданные поля, пустая строка очищает поле.
+	// (PUT /api/profile)
+	PutApiProfile(ctx context.Context, request PutApiProfileRequestObject) (PutApiProfileResponseObject, error)
+	// Выгрузить все данные текущего пользователя — профиль, баланс, переводы, покупки и события журнала аудита.
+	// (GET /api/profile/export)
+	GetApiProfileExport(ctx context.Context, request GetApiProfileExportRequestObject) (GetApiProfileExportResponseObject, error)
+	// Получить историю покупок.
+	// (GET /api/purchases)
+	GetApiPurchases(ctx context.Context, request GetApiPurchasesRequestObject) (GetApiPurchasesResponseObject, error)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostApiSendCoin(ctx context.Context, request PostApiSendCoinRequestObject) (PostApiSendCoinResponseObject, error)
+	// Получить статистику пользователя за период.
+	// (GET /api/stats/{username})
+	GetApiStatsUsername(ctx context.Context, request GetApiStatsUsernameRequestObject) (GetApiStatsUsernameResponseObject, error)
+	// Получить список команд с балансами их кошельков.
+	// (GET /api/teams)
+	GetApiTeams(ctx context.Context, request GetApiTeamsRequestObject) (GetApiTeamsResponseObject, error)
+	// Получить команду вместе с ее участниками.
+	// (GET /api/teams/{name})
+	GetApiTeamsName(ctx context.Context, request GetApiTeamsNameRequestObject) (GetApiTeamsNameResponseObject, error)
+	// Получить последние операции кошелька команды, новые первыми. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{name}/transactions)
+	GetApiTeamsNameTransactions(ctx context.Context, request GetApiTeamsNameTransactionsRequestObject) (GetApiTeamsNameTransactionsResponseObject, error)
+	// Перевести монеты из кошелька команды ее участнику (только для руководителей команды).
+	// (POST /api/teams/{name}/transfer)
+	PostApiTeamsNameTransfer(ctx context.Context, request PostApiTeamsNameTransferRequestObject) (PostApiTeamsNameTransferResponseObject, error)
+	// Найти активных пользователей по имени, отображаемому имени или отделу, например для выбора получателя перевода.
+	// (GET /api/users)
+	GetApiUsers(ctx context.Context, request GetApiUsersRequestObject) (GetApiUsersResponseObject, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций пользователя. Доступно самому пользователю, администраторам и сервисным аккаунтам с областью info:read.
+	// (GET /api/users/{username}/info)
+	GetApiUsersUsernameInfo(ctx context.Context, request GetApiUsersUsernameInfoRequestObject) (GetApiUsersUsernameInfoResponseObject, error)
+	// Получить список желаемых предметов.
+	// (GET /api/wishlist)
+	GetApiWishlist(ctx context.Context, request GetApiWishlistRequestObject) (GetApiWishlistResponseObject, error)
+	// Удалить предмет из списка желаемого.
+	// (DELETE /api/wishlist/{item})
+	DeleteApiWishlistItem(ctx context.Context, request DeleteApiWishlistItemRequestObject) (DeleteApiWishlistItemResponseObject, error)
+	// Добавить предмет в список желаемого.
+	// (PUT /api/wishlist/{item})
+	PutApiWishlistItem(ctx context.Context, request PutApiWishlistItemRequestObject) (PutApiWishlistItemResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// DeleteApiAdminApiKeysId operation middleware
+func (sh *strictHandler) DeleteApiAdminApiKeysId(ctx *gin.Context, id int32) {
+	var request DeleteApiAdminApiKeysIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminApiKeysId(ctx, request.(DeleteApiAdminApiKeysIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminApiKeysId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminApiKeysIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminApiKeysIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminAuctions operation middleware
+func (sh *strictHandler) PostApiAdminAuctions(ctx *gin.Context) {
+	var request PostApiAdminAuctionsRequestObject
+
+	var body PostApiAdminAuctionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminAuctions(ctx, request.(PostApiAdminAuctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminAuctions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminAuctionsResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminAuctionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminCurrencies operation middleware
+func (sh *strictHandler) PostApiAdminCurrencies(ctx *gin.Context) {
+	var request PostApiAdminCurrenciesRequestObject
+
+	var body PostApiAdminCurrenciesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminCurrencies(ctx, request.(PostApiAdminCurrenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminCurrencies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminCurrenciesResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminCurrenciesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminLimits operation middleware
+func (sh *strictHandler) GetApiAdminLimits(ctx *gin.Context) {
+	var request GetApiAdminLimitsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminLimits(ctx, request.(GetApiAdminLimitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminLimits")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminLimitsResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminLimitsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminLimitsScopeSubject operation middleware
+func (sh *strictHandler) DeleteApiAdminLimitsScopeSubject(ctx *gin.Context, scope DeleteApiAdminLimitsScopeSubjectParamsScope, subject string) {
+	var request DeleteApiAdminLimitsScopeSubjectRequestObject
+
+	request.Scope = scope
+	request.Subject = subject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminLimitsScopeSubject(ctx, request.(DeleteApiAdminLimitsScopeSubjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminLimitsScopeSubject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminLimitsScopeSubjectResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminLimitsScopeSubjectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiAdminLimitsScopeSubject operation middleware
+func (sh *strictHandler) PutApiAdminLimitsScopeSubject(ctx *gin.Context, scope PutApiAdminLimitsScopeSubjectParamsScope, subject string) {
+	var request PutApiAdminLimitsScopeSubjectRequestObject
+
+	request.Scope = scope
+	request.Subject = subject
+
+	var body PutApiAdminLimitsScopeSubjectJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiAdminLimitsScopeSubject(ctx, request.(PutApiAdminLimitsScopeSubjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiAdminLimitsScopeSubject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiAdminLimitsScopeSubjectResponseObject); ok {
+		if err := validResponse.VisitPutApiAdminLimitsScopeSubjectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiAdminProductsItemCurrency operation middleware
+func (sh *strictHandler) PutApiAdminProductsItemCurrency(ctx *gin.Context, item string) {
+	var request PutApiAdminProductsItemCurrencyRequestObject
+
+	request.Item = item
+
+	var body PutApiAdminProductsItemCurrencyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiAdminProductsItemCurrency(ctx, request.(PutApiAdminProductsItemCurrencyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiAdminProductsItemCurrency")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiAdminProductsItemCurrencyResponseObject); ok {
+		if err := validResponse.VisitPutApiAdminProductsItemCurrencyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiAdminProductsItemVariantsSku operation middleware
+func (sh *strictHandler) PutApiAdminProductsItemVariantsSku(ctx *gin.Context, item string, sku string) {
+	var request PutApiAdminProductsItemVariantsSkuRequestObject
+
+	request.Item = item
+	request.Sku = sku
+
+	var body PutApiAdminProductsItemVariantsSkuJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiAdminProductsItemVariantsSku(ctx, request.(PutApiAdminProductsItemVariantsSkuRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiAdminProductsItemVariantsSku")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiAdminProductsItemVariantsSkuResponseObject); ok {
+		if err := validResponse.VisitPutApiAdminProductsItemVariantsSkuResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminPromoCodes operation middleware
+func (sh *strictHandler) GetApiAdminPromoCodes(ctx *gin.Context) {
+	var request GetApiAdminPromoCodesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminPromoCodes(ctx, request.(GetApiAdminPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminPromoCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminPromoCodesResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminPromoCodesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminPromoCodes operation middleware
+func (sh *strictHandler) PostApiAdminPromoCodes(ctx *gin.Context) {
+	var request PostApiAdminPromoCodesRequestObject
+
+	var body PostApiAdminPromoCodesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminPromoCodes(ctx, request.(PostApiAdminPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminPromoCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminPromoCodesResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminPromoCodesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminPromoCodesCode operation middleware
+func (sh *strictHandler) DeleteApiAdminPromoCodesCode(ctx *gin.Context, code string) {
+	var request DeleteApiAdminPromoCodesCodeRequestObject
+
+	request.Code = code
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminPromoCodesCode(ctx, request.(DeleteApiAdminPromoCodesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminPromoCodesCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminPromoCodesCodeResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminPromoCodesCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminReportsKind operation middleware
+func (sh *strictHandler) GetApiAdminReportsKind(ctx *gin.Context, kind string, params GetApiAdminReportsKindParams) {
+	var request GetApiAdminReportsKindRequestObject
+
+	request.Kind = kind
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminReportsKind(ctx, request.(GetApiAdminReportsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminReportsKind")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminReportsKindResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminReportsKindResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminSales operation middleware
+func (sh *strictHandler) GetApiAdminSales(ctx *gin.Context) {
+	var request GetApiAdminSalesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminSales(ctx, request.(GetApiAdminSalesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminSales")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminSalesResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminSalesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminSales operation middleware
+func (sh *strictHandler) PostApiAdminSales(ctx *gin.Context) {
+	var request PostApiAdminSalesRequestObject
+
+	var body PostApiAdminSalesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminSales(ctx, request.(PostApiAdminSalesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminSales")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminSalesResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminSalesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminSalesId operation middleware
+func (sh *strictHandler) DeleteApiAdminSalesId(ctx *gin.Context, id int32) {
+	var request DeleteApiAdminSalesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminSalesId(ctx, request.(DeleteApiAdminSalesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminSalesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminSalesIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminSalesIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminServiceAccounts operation middleware
+func (sh *strictHandler) GetApiAdminServiceAccounts(ctx *gin.Context) {
+	var request GetApiAdminServiceAccountsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminServiceAccounts(ctx, request.(GetApiAdminServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminServiceAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminServiceAccountsResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminServiceAccountsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminServiceAccounts operation middleware
+func (sh *strictHandler) PostApiAdminServiceAccounts(ctx *gin.Context) {
+	var request PostApiAdminServiceAccountsRequestObject
+
+	var body PostApiAdminServiceAccountsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminServiceAccounts(ctx, request.(PostApiAdminServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminServiceAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminServiceAccountsResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminServiceAccountsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminServiceAccountsNameKeys operation middleware
+func (sh *strictHandler) GetApiAdminServiceAccountsNameKeys(ctx *gin.Context, name string) {
+	var request GetApiAdminServiceAccountsNameKeysRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminServiceAccountsNameKeys(ctx, request.(GetApiAdminServiceAccountsNameKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminServiceAccountsNameKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminServiceAccountsNameKeysResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminServiceAccountsNameKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminServiceAccountsNameKeys operation middleware
+func (sh *strictHandler) PostApiAdminServiceAccountsNameKeys(ctx *gin.Context, name string) {
+	var request PostApiAdminServiceAccountsNameKeysRequestObject
+
+	request.Name = name
+
+	var body PostApiAdminServiceAccountsNameKeysJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminServiceAccountsNameKeys(ctx, request.(PostApiAdminServiceAccountsNameKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminServiceAccountsNameKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminServiceAccountsNameKeysResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminServiceAccountsNameKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminTeams operation middleware
+func (sh *strictHandler) PostApiAdminTeams(ctx *gin.Context) {
+	var request PostApiAdminTeamsRequestObject
+
+	var body PostApiAdminTeamsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminTeams(ctx, request.(PostApiAdminTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminTeams")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminTeamsResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminTeamsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminTeamsNameDeposit operation middleware
+func (sh *strictHandler) PostApiAdminTeamsNameDeposit(ctx *gin.Context, name string) {
+	var request PostApiAdminTeamsNameDepositRequestObject
+
+	request.Name = name
+
+	var body PostApiAdminTeamsNameDepositJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminTeamsNameDeposit(ctx, request.(PostApiAdminTeamsNameDepositRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminTeamsNameDeposit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminTeamsNameDepositResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminTeamsNameDepositResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminTeamsNameMembersUsername operation middleware
+func (sh *strictHandler) DeleteApiAdminTeamsNameMembersUsername(ctx *gin.Context, name string, username string) {
+	var request DeleteApiAdminTeamsNameMembersUsernameRequestObject
+
+	request.Name = name
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminTeamsNameMembersUsername(ctx, request.(DeleteApiAdminTeamsNameMembersUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminTeamsNameMembersUsername")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminTeamsNameMembersUsernameResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminTeamsNameMembersUsernameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiAdminTeamsNameMembersUsername operation middleware
+func (sh *strictHandler) PutApiAdminTeamsNameMembersUsername(ctx *gin.Context, name string, username string) {
+	var request PutApiAdminTeamsNameMembersUsernameRequestObject
+
+	request.Name = name
+	request.Username = username
+
+	var body PutApiAdminTeamsNameMembersUsernameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiAdminTeamsNameMembersUsername(ctx, request.(PutApiAdminTeamsNameMembersUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiAdminTeamsNameMembersUsername")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiAdminTeamsNameMembersUsernameResponseObject); ok {
+		if err := validResponse.VisitPutApiAdminTeamsNameMembersUsernameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernameBalance operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernameBalance(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernameBalanceRequestObject
+
+	request.Username = username
+
+	var body PostApiAdminUsersUsernameBalanceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernameBalance(ctx, request.(PostApiAdminUsersUsernameBalanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernameBalance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernameBalanceResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernameBalanceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernameDeactivate operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernameDeactivate(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernameDeactivateRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernameDeactivate(ctx, request.(PostApiAdminUsersUsernameDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernameDeactivate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernameDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernameDeactivateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernameErase operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernameErase(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernameEraseRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernameErase(ctx, request.(PostApiAdminUsersUsernameEraseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernameErase")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernameEraseResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernameEraseResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminUsersUsernameMfa operation middleware
+func (sh *strictHandler) DeleteApiAdminUsersUsernameMfa(ctx *gin.Context, username string) {
+	var request DeleteApiAdminUsersUsernameMfaRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminUsersUsernameMfa(ctx, request.(DeleteApiAdminUsersUsernameMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminUsersUsernameMfa")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminUsersUsernameMfaResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminUsersUsernameMfaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernamePasswordReset operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernamePasswordReset(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernamePasswordResetRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernamePasswordReset(ctx, request.(PostApiAdminUsersUsernamePasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernamePasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernamePasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernamePasswordResetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernameReactivate operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernameReactivate(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernameReactivateRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernameReactivate(ctx, request.(PostApiAdminUsersUsernameReactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernameReactivate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernameReactivateResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernameReactivateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminUsersUsernameUnlock operation middleware
+func (sh *strictHandler) PostApiAdminUsersUsernameUnlock(ctx *gin.Context, username string) {
+	var request PostApiAdminUsersUsernameUnlockRequestObject
+
+	request.Username = username
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminUsersUsernameUnlock(ctx, request.(PostApiAdminUsersUsernameUnlockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminUsersUsernameUnlock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminUsersUsernameUnlockResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminUsersUsernameUnlockResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminWebhooks operation middleware
+func (sh *strictHandler) GetApiAdminWebhooks(ctx *gin.Context) {
+	var request GetApiAdminWebhooksRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminWebhooks(ctx, request.(GetApiAdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminWebhooks operation middleware
+func (sh *strictHandler) PostApiAdminWebhooks(ctx *gin.Context) {
+	var request PostApiAdminWebhooksRequestObject
+
+	var body PostApiAdminWebhooksJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminWebhooks(ctx, request.(PostApiAdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAdminWebhooksDeliveriesDeliveryIdRetry operation middleware
+func (sh *strictHandler) PostApiAdminWebhooksDeliveriesDeliveryIdRetry(ctx *gin.Context, deliveryId int64) {
+	var request PostApiAdminWebhooksDeliveriesDeliveryIdRetryRequestObject
+
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminWebhooksDeliveriesDeliveryIdRetry(ctx, request.(PostApiAdminWebhooksDeliveriesDeliveryIdRetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminWebhooksDeliveriesDeliveryIdRetry")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAdminWebhooksDeliveriesDeliveryIdRetryResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminWebhooksDeliveriesDeliveryIdRetryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminWebhooksId operation middleware
+func (sh *strictHandler) DeleteApiAdminWebhooksId(ctx *gin.Context, id int32) {
+	var request DeleteApiAdminWebhooksIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminWebhooksId(ctx, request.(DeleteApiAdminWebhooksIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminWebhooksId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiAdminWebhooksIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminWebhooksIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAdminWebhooksIdDeliveries operation middleware
+func (sh *strictHandler) GetApiAdminWebhooksIdDeliveries(ctx *gin.Context, id int32, params GetApiAdminWebhooksIdDeliveriesParams) {
+	var request GetApiAdminWebhooksIdDeliveriesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminWebhooksIdDeliveries(ctx, request.(GetApiAdminWebhooksIdDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminWebhooksIdDeliveries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAdminWebhooksIdDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminWebhooksIdDeliveriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAuctions operation middleware
+func (sh *strictHandler) GetApiAuctions(ctx *gin.Context) {
+	var request GetApiAuctionsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuctions(ctx, request.(GetApiAuctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuctions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAuctionsResponseObject); ok {
+		if err := validResponse.VisitGetApiAuctionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAuctionsId operation middleware
+func (sh *strictHandler) GetApiAuctionsId(ctx *gin.Context, id int32) {
+	var request GetApiAuctionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuctionsId(ctx, request.(GetApiAuctionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuctionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAuctionsIdResponseObject); ok {
+		if err := validResponse.VisitGetApiAuctionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAuctionsIdBids operation middleware
+func (sh *strictHandler) PostApiAuctionsIdBids(ctx *gin.Context, id int32) {
+	var request PostApiAuctionsIdBidsRequestObject
+
+	request.Id = id
+
+	var body PostApiAuctionsIdBidsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuctionsIdBids(ctx, request.(PostApiAuctionsIdBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuctionsIdBids")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAuctionsIdBidsResponseObject); ok {
+		if err := validResponse.VisitPostApiAuctionsIdBidsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAudit operation middleware
+func (sh *strictHandler) GetApiAudit(ctx *gin.Context, params GetApiAuditParams) {
+	var request GetApiAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAudit(ctx, request.(GetApiAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAuditResponseObject); ok {
+		if err := validResponse.VisitGetApiAuditResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiAuditVerify operation middleware
+func (sh *strictHandler) GetApiAuditVerify(ctx *gin.Context) {
+	var request GetApiAuditVerifyRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuditVerify(ctx, request.(GetApiAuditVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuditVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAuditVerifyResponseObject); ok {
+		if err := validResponse.VisitGetApiAuditVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAuth operation middleware
+func (sh *strictHandler) PostApiAuth(ctx *gin.Context) {
+	var request PostApiAuthRequestObject
+
+	var body PostApiAuthJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuth(ctx, request.(PostApiAuthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAuthResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAuthMfa operation middleware
+func (sh *strictHandler) PostApiAuthMfa(ctx *gin.Context) {
+	var request PostApiAuthMfaRequestObject
+
+	var body PostApiAuthMfaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthMfa(ctx, request.(PostApiAuthMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthMfa")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAuthMfaResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthMfaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAuthMfaEnroll operation middleware
+func (sh *strictHandler) PostApiAuthMfaEnroll(ctx *gin.Context) {
+	var request PostApiAuthMfaEnrollRequestObject
+
+	var body PostApiAuthMfaEnrollJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthMfaEnroll(ctx, request.(PostApiAuthMfaEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthMfaEnroll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAuthMfaEnrollResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthMfaEnrollResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiAuthOidcLink operation middleware
+func (sh *strictHandler) PostApiAuthOidcLink(ctx *gin.Context) {
+	var request PostApiAuthOidcLinkRequestObject
+
+	var body PostApiAuthOidcLinkJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthOidcLink(ctx, request.(PostApiAuthOidcLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthOidcLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAuthOidcLinkResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthOidcLinkResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiBuyItem operation middleware
+func (sh *strictHandler) GetApiBuyItem(ctx *gin.Context, item string, params GetApiBuyItemParams) {
+	var request GetApiBuyItemRequestObject
+
+	request.Item = item
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiBuyItem(ctx, request.(GetApiBuyItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiBuyItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiBuyItemResponseObject); ok {
+		if err := validResponse.VisitGetApiBuyItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiCatalog operation middleware
+func (sh *strictHandler) GetApiCatalog(ctx *gin.Context, params GetApiCatalogParams) {
+	var request GetApiCatalogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiCatalog(ctx, request.(GetApiCatalogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiCatalog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiCatalogResponseObject); ok {
+		if err := validResponse.VisitGetApiCatalogResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiCategories operation middleware
+func (sh *strictHandler) GetApiCategories(ctx *gin.Context, params GetApiCategoriesParams) {
+	var request GetApiCategoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiCategories(ctx, request.(GetApiCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiCategories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiCategoriesResponseObject); ok {
+		if err := validResponse.VisitGetApiCategoriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiCoinsGrant operation middleware
+func (sh *strictHandler) PostApiCoinsGrant(ctx *gin.Context) {
+	var request PostApiCoinsGrantRequestObject
+
+	var body PostApiCoinsGrantJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiCoinsGrant(ctx, request.(PostApiCoinsGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiCoinsGrant")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiCoinsGrantResponseObject); ok {
+		if err := validResponse.VisitPostApiCoinsGrantResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiCurrencies operation middleware
+func (sh *strictHandler) GetApiCurrencies(ctx *gin.Context) {
+	var request GetApiCurrenciesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiCurrencies(ctx, request.(GetApiCurrenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiCurrencies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiCurrenciesResponseObject); ok {
+		if err := validResponse.VisitGetApiCurrenciesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiGroupPurchases operation middleware
+func (sh *strictHandler) GetApiGroupPurchases(ctx *gin.Context) {
+	var request GetApiGroupPurchasesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiGroupPurchases(ctx, request.(GetApiGroupPurchasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiGroupPurchases")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiGroupPurchasesResponseObject); ok {
+		if err := validResponse.VisitGetApiGroupPurchasesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiGroupPurchases operation middleware
+func (sh *strictHandler) PostApiGroupPurchases(ctx *gin.Context) {
+	var request PostApiGroupPurchasesRequestObject
+
+	var body PostApiGroupPurchasesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiGroupPurchases(ctx, request.(PostApiGroupPurchasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiGroupPurchases")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiGroupPurchasesResponseObject); ok {
+		if err := validResponse.VisitPostApiGroupPurchasesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiGroupPurchasesId operation middleware
+func (sh *strictHandler) GetApiGroupPurchasesId(ctx *gin.Context, id int32) {
+	var request GetApiGroupPurchasesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiGroupPurchasesId(ctx, request.(GetApiGroupPurchasesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiGroupPurchasesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiGroupPurchasesIdResponseObject); ok {
+		if err := validResponse.VisitGetApiGroupPurchasesIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiGroupPurchasesIdPledges operation middleware
+func (sh *strictHandler) PostApiGroupPurchasesIdPledges(ctx *gin.Context, id int32) {
+	var request PostApiGroupPurchasesIdPledgesRequestObject
+
+	request.Id = id
+
+	var body PostApiGroupPurchasesIdPledgesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiGroupPurchasesIdPledges(ctx, request.(PostApiGroupPurchasesIdPledgesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiGroupPurchasesIdPledges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiGroupPurchasesIdPledgesResponseObject); ok {
+		if err := validResponse.VisitPostApiGroupPurchasesIdPledgesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiInfo operation middleware
+func (sh *strictHandler) GetApiInfo(ctx *gin.Context) {
+	var request GetApiInfoRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiInfo(ctx, request.(GetApiInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiInfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiInfoResponseObject); ok {
+		if err := validResponse.VisitGetApiInfoResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiLeaderboardItems operation middleware
+func (sh *strictHandler) GetApiLeaderboardItems(ctx *gin.Context, params GetApiLeaderboardItemsParams) {
+	var request GetApiLeaderboardItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiLeaderboardItems(ctx, request.(GetApiLeaderboardItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiLeaderboardItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiLeaderboardItemsResponseObject); ok {
+		if err := validResponse.VisitGetApiLeaderboardItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiLeaderboardReceivers operation middleware
+func (sh *strictHandler) GetApiLeaderboardReceivers(ctx *gin.Context, params GetApiLeaderboardReceiversParams) {
+	var request GetApiLeaderboardReceiversRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiLeaderboardReceivers(ctx, request.(GetApiLeaderboardReceiversRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiLeaderboardReceivers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiLeaderboardReceiversResponseObject); ok {
+		if err := validResponse.VisitGetApiLeaderboardReceiversResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiLeaderboardSenders operation middleware
+func (sh *strictHandler) GetApiLeaderboardSenders(ctx *gin.Context, params GetApiLeaderboardSendersParams) {
+	var request GetApiLeaderboardSendersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiLeaderboardSenders(ctx, request.(GetApiLeaderboardSendersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiLeaderboardSenders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiLeaderboardSendersResponseObject); ok {
+		if err := validResponse.VisitGetApiLeaderboardSendersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiMfa operation middleware
+func (sh *strictHandler) GetApiMfa(ctx *gin.Context) {
+	var request GetApiMfaRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiMfa(ctx, request.(GetApiMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiMfa")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiMfaResponseObject); ok {
+		if err := validResponse.VisitGetApiMfaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiMfaConfirm operation middleware
+func (sh *strictHandler) PostApiMfaConfirm(ctx *gin.Context) {
+	var request PostApiMfaConfirmRequestObject
+
+	var body PostApiMfaConfirmJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaConfirm(ctx, request.(PostApiMfaConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiMfaConfirmResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaConfirmResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiMfaDisable operation middleware
+func (sh *strictHandler) PostApiMfaDisable(ctx *gin.Context) {
+	var request PostApiMfaDisableRequestObject
+
+	var body PostApiMfaDisableJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaDisable(ctx, request.(PostApiMfaDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiMfaDisableResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaDisableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiMfaEnroll operation middleware
+func (sh *strictHandler) PostApiMfaEnroll(ctx *gin.Context) {
+	var request PostApiMfaEnrollRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaEnroll(ctx, request.(PostApiMfaEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaEnroll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiMfaEnrollResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaEnrollResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiMfaRecoveryCodes operation middleware
+func (sh *strictHandler) PostApiMfaRecoveryCodes(ctx *gin.Context) {
+	var request PostApiMfaRecoveryCodesRequestObject
+
+	var body PostApiMfaRecoveryCodesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaRecoveryCodes(ctx, request.(PostApiMfaRecoveryCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaRecoveryCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiMfaRecoveryCodesResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaRecoveryCodesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiNotifications operation middleware
+func (sh *strictHandler) GetApiNotifications(ctx *gin.Context, params GetApiNotificationsParams) {
+	var request GetApiNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiNotifications(ctx, request.(GetApiNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetApiNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiNotificationsRead operation middleware
+func (sh *strictHandler) PostApiNotificationsRead(ctx *gin.Context) {
+	var request PostApiNotificationsReadRequestObject
+
+	var body PostApiNotificationsReadJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiNotificationsRead(ctx, request.(PostApiNotificationsReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiNotificationsRead")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiNotificationsReadResponseObject); ok {
+		if err := validResponse.VisitPostApiNotificationsReadResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiNotificationsUnreadCount operation middleware
+func (sh *strictHandler) GetApiNotificationsUnreadCount(ctx *gin.Context) {
+	var request GetApiNotificationsUnreadCountRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiNotificationsUnreadCount(ctx, request.(GetApiNotificationsUnreadCountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiNotificationsUnreadCount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiNotificationsUnreadCountResponseObject); ok {
+		if err := validResponse.VisitGetApiNotificationsUnreadCountResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiPassword operation middleware
+func (sh *strictHandler) PostApiPassword(ctx *gin.Context) {
+	var request PostApiPasswordRequestObject
+
+	var body PostApiPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPassword(ctx, request.(PostApiPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiPasswordResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiPasswordReset operation middleware
+func (sh *strictHandler) PostApiPasswordReset(ctx *gin.Context) {
+	var request PostApiPasswordResetRequestObject
+
+	var body PostApiPasswordResetJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPasswordReset(ctx, request.(PostApiPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiProfile operation middleware
+func (sh *strictHandler) GetApiProfile(ctx *gin.Context) {
+	var request GetApiProfileRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfile(ctx, request.(GetApiProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfileResponseObject); ok {
+		if err := validResponse.VisitGetApiProfileResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiProfile operation middleware
+func (sh *strictHandler) PutApiProfile(ctx *gin.Context) {
+	var request PutApiProfileRequestObject
+
+	var body PutApiProfileJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiProfile(ctx, request.(PutApiProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiProfileResponseObject); ok {
+		if err := validResponse.VisitPutApiProfileResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiProfileExport operation middleware
+func (sh *strictHandler) GetApiProfileExport(ctx *gin.Context) {
+	var request GetApiProfileExportRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfileExport(ctx, request.(GetApiProfileExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfileExport")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetApiInfoRequestObject struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfileExportResponseObject); ok {
+		if err := validResponse.VisitGetApiProfileExportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiInfoResponseObject interface {
-	VisitGetApiInfoResponse(w http.ResponseWriter) error
-}
+// GetApiPurchases operation middleware
+func (sh *strictHandler) GetApiPurchases(ctx *gin.Context) {
+	var request GetApiPurchasesRequestObject
 
-type GetApiInfo200JSONResponse InfoResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiPurchases(ctx, request.(GetApiPurchasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiPurchases")
+	}
 
-func (response GetApiInfo200JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiPurchasesResponseObject); ok {
+		if err := validResponse.VisitGetApiPurchasesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiInfo400JSONResponse ErrorResponse
-
-func (response GetApiInfo400JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PostApiSendCoin operation middleware
+func (sh *strictHandler) PostApiSendCoin(ctx *gin.Context) {
+	var request PostApiSendCoinRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiSendCoinJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type GetApiInfo401JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiSendCoin(ctx, request.(PostApiSendCoinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiSendCoin")
+	}
 
-func (response GetApiInfo401JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiSendCoinResponseObject); ok {
+		if err := validResponse.VisitPostApiSendCoinResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiInfo500JSONResponse ErrorResponse
-
-func (response GetApiInfo500JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetApiStatsUsername operation middleware
+func (sh *strictHandler) GetApiStatsUsername(ctx *gin.Context, username string, params GetApiStatsUsernameParams) {
+	var request GetApiStatsUsernameRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Username = username
+	request.Params = params
 
-type PostApiSendCoinRequestObject struct {
-	Body *PostApiSendCoinJSONRequestBody
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiStatsUsername(ctx, request.(GetApiStatsUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiStatsUsername")
+	}
 
-type PostApiSendCoinResponseObject interface {
-	VisitPostApiSendCoinResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PostApiSendCoin200Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiStatsUsernameResponseObject); ok {
+		if err := validResponse.VisitGetApiStatsUsernameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostApiSendCoin200Response) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// GetApiTeams operation middleware
+func (sh *strictHandler) GetApiTeams(ctx *gin.Context) {
+	var request GetApiTeamsRequestObject
 
-type PostApiSendCoin400JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiTeams(ctx, request.(GetApiTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiTeams")
+	}
 
-func (response PostApiSendCoin400JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiTeamsResponseObject); ok {
+		if err := validResponse.VisitGetApiTeamsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiSendCoin401JSONResponse ErrorResponse
-
-func (response PostApiSendCoin401JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetApiTeamsName operation middleware
+func (sh *strictHandler) GetApiTeamsName(ctx *gin.Context, name string) {
+	var request GetApiTeamsNameRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
 
-type PostApiSendCoin500JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiTeamsName(ctx, request.(GetApiTeamsNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiTeamsName")
+	}
 
-func (response PostApiSendCoin500JSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiTeamsNameResponseObject); ok {
+		if err := validResponse.VisitGetApiTeamsNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostApiAuth(ctx context.Context, request PostApiAuthRequestObject) (PostApiAuthResponseObject, error)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetApiBuyItem(ctx context.Context, request GetApiBuyItemRequestObject) (GetApiBuyItemResponseObject, error)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetApiInfo(ctx context.Context, request GetApiInfoRequestObject) (GetApiInfoResponseObject, error)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostApiSendCoin(ctx context.Context, request PostApiSendCoinRequestObject) (PostApiSendCoinResponseObject, error)
-}
+// GetApiTeamsNameTransactions operation middleware
+func (sh *strictHandler) GetApiTeamsNameTransactions(ctx *gin.Context, name string, params GetApiTeamsNameTransactionsParams) {
+	var request GetApiTeamsNameTransactionsRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	request.Name = name
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiTeamsNameTransactions(ctx, request.(GetApiTeamsNameTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiTeamsNameTransactions")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiTeamsNameTransactionsResponseObject); ok {
+		if err := validResponse.VisitGetApiTeamsNameTransactionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostApiAuth operation middleware
-func (sh *strictHandler) PostApiAuth(ctx *gin.Context) {
-	var request PostApiAuthRequestObject
+// PostApiTeamsNameTransfer operation middleware
+func (sh *strictHandler) PostApiTeamsNameTransfer(ctx *gin.Context, name string) {
+	var request PostApiTeamsNameTransferRequestObject
 
-	var body PostApiAuthJSONRequestBody
+	request.Name = name
+
+	var body PostApiTeamsNameTransferJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -427,10 +10732,10 @@ func (sh *strictHandler) PostApiAuth(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiAuth(ctx, request.(PostApiAuthRequestObject))
+		return sh.ssi.PostApiTeamsNameTransfer(ctx, request.(PostApiTeamsNameTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiAuth")
+		handler = middleware(handler, "PostApiTeamsNameTransfer")
 	}
 
 	response, err := handler(ctx, request)
@@ -438,8 +10743,8 @@ func (sh *strictHandler) PostApiAuth(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiAuthResponseObject); ok {
-		if err := validResponse.VisitPostApiAuthResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiTeamsNameTransferResponseObject); ok {
+		if err := validResponse.VisitPostApiTeamsNameTransferResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -447,17 +10752,17 @@ func (sh *strictHandler) PostApiAuth(ctx *gin.Context) {
 	}
 }
 
-// GetApiBuyItem operation middleware
-func (sh *strictHandler) GetApiBuyItem(ctx *gin.Context, item string) {
-	var request GetApiBuyItemRequestObject
+// GetApiUsers operation middleware
+func (sh *strictHandler) GetApiUsers(ctx *gin.Context, params GetApiUsersParams) {
+	var request GetApiUsersRequestObject
 
-	request.Item = item
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiBuyItem(ctx, request.(GetApiBuyItemRequestObject))
+		return sh.ssi.GetApiUsers(ctx, request.(GetApiUsersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiBuyItem")
+		handler = middleware(handler, "GetApiUsers")
 	}
 
 	response, err := handler(ctx, request)
@@ -465,8 +10770,8 @@ func (sh *strictHandler) GetApiBuyItem(ctx *gin.Context, item string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiBuyItemResponseObject); ok {
-		if err := validResponse.VisitGetApiBuyItemResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiUsersResponseObject); ok {
+		if err := validResponse.VisitGetApiUsersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -474,15 +10779,17 @@ func (sh *strictHandler) GetApiBuyItem(ctx *gin.Context, item string) {
 	}
 }
 
-// GetApiInfo operation middleware
-func (sh *strictHandler) GetApiInfo(ctx *gin.Context) {
-	var request GetApiInfoRequestObject
+// GetApiUsersUsernameInfo operation middleware
+func (sh *strictHandler) GetApiUsersUsernameInfo(ctx *gin.Context, username string) {
+	var request GetApiUsersUsernameInfoRequestObject
+
+	request.Username = username
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiInfo(ctx, request.(GetApiInfoRequestObject))
+		return sh.ssi.GetApiUsersUsernameInfo(ctx, request.(GetApiUsersUsernameInfoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiInfo")
+		handler = middleware(handler, "GetApiUsersUsernameInfo")
 	}
 
 	response, err := handler(ctx, request)
@@ -490,8 +10797,8 @@ func (sh *strictHandler) GetApiInfo(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiInfoResponseObject); ok {
-		if err := validResponse.VisitGetApiInfoResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiUsersUsernameInfoResponseObject); ok {
+		if err := validResponse.VisitGetApiUsersUsernameInfoResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -499,23 +10806,42 @@ func (sh *strictHandler) GetApiInfo(ctx *gin.Context) {
 	}
 }
 
-// PostApiSendCoin operation middleware
-func (sh *strictHandler) PostApiSendCoin(ctx *gin.Context) {
-	var request PostApiSendCoinRequestObject
+// GetApiWishlist operation middleware
+func (sh *strictHandler) GetApiWishlist(ctx *gin.Context) {
+	var request GetApiWishlistRequestObject
 
-	var body PostApiSendCoinJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiWishlist(ctx, request.(GetApiWishlistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiWishlist")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiWishlistResponseObject); ok {
+		if err := validResponse.VisitGetApiWishlistResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// DeleteApiWishlistItem operation middleware
+func (sh *strictHandler) DeleteApiWishlistItem(ctx *gin.Context, item string) {
+	var request DeleteApiWishlistItemRequestObject
+
+	request.Item = item
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiSendCoin(ctx, request.(PostApiSendCoinRequestObject))
+		return sh.ssi.DeleteApiWishlistItem(ctx, request.(DeleteApiWishlistItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiSendCoin")
+		handler = middleware(handler, "DeleteApiWishlistItem")
 	}
 
 	response, err := handler(ctx, request)
@@ -523,8 +10849,35 @@ func (sh *strictHandler) PostApiSendCoin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiSendCoinResponseObject); ok {
-		if err := validResponse.VisitPostApiSendCoinResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteApiWishlistItemResponseObject); ok {
+		if err := validResponse.VisitDeleteApiWishlistItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiWishlistItem operation middleware
+func (sh *strictHandler) PutApiWishlistItem(ctx *gin.Context, item string) {
+	var request PutApiWishlistItemRequestObject
+
+	request.Item = item
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiWishlistItem(ctx, request.(PutApiWishlistItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiWishlistItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiWishlistItemResponseObject); ok {
+		if err := validResponse.VisitPutApiWishlistItemResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiGroupPurchases(ctx context.Context, req GetApiGroupPurchasesRequestObject) (GetApiGroupPurchasesResponseObject, error) {
+	purchases, err := s.merchService.ListGroupPurchases(ctx)
+	if err != nil {
+		return GetApiGroupPurchases500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiGroupPurchases200JSONResponse{}
+	for _, g := range purchases {
+		resp = append(resp, toAPIGroupPurchase(g))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiGroupPurchases(ctx context.Context, req PostApiGroupPurchasesRequestObject) (PostApiGroupPurchasesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiGroupPurchases400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiGroupPurchases400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	variant := ""
+	if req.Body.Variant != nil {
+		variant = *req.Body.Variant
+	}
+	purchase, err := s.merchService.CreateGroupPurchase(ctx, username, req.Body.Item, variant, req.Body.Recipient, req.Body.Deadline)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrItemNotFound), errors.Is(err, model.ErrVariantNotFound), errors.Is(err, model.ErrUserNotFound):
+			return PostApiGroupPurchases404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidGroupPurchase), errors.Is(err, model.ErrItemInactive),
+			errors.Is(err, model.ErrVariantRequired), errors.Is(err, model.ErrUserDeactivated),
+			errors.Is(err, model.ErrGroupPurchaseForSelf):
+			return PostApiGroupPurchases400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiGroupPurchases500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiGroupPurchases200JSONResponse(toAPIGroupPurchase(*purchase)), nil
+}
+
+func (s *APIServer) GetApiGroupPurchasesId(ctx context.Context, req GetApiGroupPurchasesIdRequestObject) (GetApiGroupPurchasesIdResponseObject, error) {
+	purchase, err := s.merchService.GetGroupPurchase(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrGroupPurchaseNotFound) {
+			return GetApiGroupPurchasesId404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiGroupPurchasesId500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := toAPIGroupPurchase(*purchase)
+	pledges := []Pledge{}
+	for _, p := range purchase.Pledges {
+		pledges = append(pledges, Pledge{Username: p.Username, Amount: int(p.Amount), CreatedAt: p.CreatedAt})
+	}
+	resp.Pledges = &pledges
+	return GetApiGroupPurchasesId200JSONResponse(resp), nil
+}
+
+func (s *APIServer) PostApiGroupPurchasesIdPledges(ctx context.Context, req PostApiGroupPurchasesIdPledgesRequestObject) (PostApiGroupPurchasesIdPledgesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiGroupPurchasesIdPledges400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiGroupPurchasesIdPledges400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	purchase, err := s.merchService.Pledge(ctx, username, req.Id, int32(req.Body.Amount))
+	if err != nil {
+		var exceeded *model.LimitExceededError
+		if errors.As(err, &exceeded) {
+			return PostApiGroupPurchasesIdPledges403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		switch {
+		case errors.Is(err, model.ErrGroupPurchaseNotFound):
+			return PostApiGroupPurchasesIdPledges404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAmount), errors.Is(err, model.ErrGroupPurchaseClosed),
+			errors.Is(err, model.ErrPledgeTooHigh), errors.Is(err, model.ErrInsufficientFunds),
+			errors.Is(err, model.ErrUserDeactivated), errors.Is(err, model.ErrOutOfStock),
+			errors.Is(err, model.ErrGroupPurchaseForSelf):
+			return PostApiGroupPurchasesIdPledges400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiGroupPurchasesIdPledges500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiGroupPurchasesIdPledges200JSONResponse(toAPIGroupPurchase(*purchase)), nil
+}
+
+func toAPIGroupPurchase(g model.GroupPurchase) GroupPurchase {
+	return GroupPurchase{
+		Id:        g.ID,
+		Item:      g.Item,
+		Variant:   optionalString(g.Variant),
+		Recipient: g.Recipient,
+		Price:     int(g.Price),
+		Pledged:   int(g.Pledged),
+		Deadline:  g.Deadline,
+		Status:    g.Status,
+		CreatedBy: g.CreatedBy,
+		CreatedAt: g.CreatedAt,
+	}
+}
@@ -2,16 +2,27 @@ package api
 
 import (
 	"context"
+	"errors"
+	"math"
+	"strconv"
 
+	"merchshop/internal/model"
+	"merchshop/internal/oidc"
 	"merchshop/internal/service"
+
+	"github.com/gin-gonic/gin"
 )
 
 type APIServer struct {
 	merchService *service.MerchService
+	events       *service.EventHub
+	oidc         *oidc.Provider
 }
 
-func NewAPIServer(ms *service.MerchService) *APIServer {
-	return &APIServer{merchService: ms}
+// NewAPIServer creates the API handlers. Single sign-on is served only when
+// provider is not nil.
+func NewAPIServer(ms *service.MerchService, events *service.EventHub, provider *oidc.Provider) *APIServer {
+	return &APIServer{merchService: ms, events: events, oidc: provider}
 }
 
 func (s *APIServer) PostApiAuth(ctx context.Context, req PostApiAuthRequestObject) (PostApiAuthResponseObject, error) {
@@ -21,12 +32,21 @@ func (s *APIServer) PostApiAuth(ctx context.Context, req PostApiAuthRequestObjec
 	username := req.Body.Username
 	password := req.Body.Password
 
-	token, err := s.merchService.Authenticate(ctx, username, password)
+	result, err := s.merchService.Authenticate(ctx, username, password)
 	if err != nil {
+		var blocked *model.LoginBlockedError
+		if errors.As(err, &blocked) {
+			if c, ok := ctx.(*gin.Context); ok {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			}
+			return PostApiAuth429JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		if errors.Is(err, model.ErrUserDeactivated) || errors.Is(err, model.ErrUsernameErased) {
+			return PostApiAuth403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
 		return PostApiAuth500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
 	}
-	resp := PostApiAuth200JSONResponse(AuthResponse{Token: &token})
-	return resp, nil
+	return PostApiAuth200JSONResponse(toAPIAuthResponse(result)), nil
 }
 
 func (s *APIServer) GetApiBuyItem(ctx context.Context, req GetApiBuyItemRequestObject) (GetApiBuyItemResponseObject, error) {
@@ -34,7 +54,22 @@ func (s *APIServer) GetApiBuyItem(ctx context.Context, req GetApiBuyItemRequestO
 	if !ok || username == "" {
 		return GetApiBuyItem400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
 	}
-	if err := s.merchService.BuyItem(ctx, username, req.Item); err != nil {
+	variant := ""
+	if req.Params.Variant != nil {
+		variant = *req.Params.Variant
+	}
+	promoCode := ""
+	if req.Params.PromoCode != nil {
+		promoCode = *req.Params.PromoCode
+	}
+	if err := s.merchService.BuyItem(ctx, username, req.Item, variant, promoCode); err != nil {
+		var exceeded *model.LimitExceededError
+		if errors.As(err, &exceeded) {
+			return GetApiBuyItem403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		if errors.Is(err, model.ErrInsufficientFunds) {
+			return GetApiBuyItem400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
 		return GetApiBuyItem500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
 	}
 	return GetApiBuyItem200Response{}, nil
@@ -49,10 +84,14 @@ func (s *APIServer) GetApiInfo(ctx context.Context, req GetApiInfoRequestObject)
 	if err != nil {
 		return GetApiInfo500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
 	}
+	return GetApiInfo200JSONResponse(toAPIInfo(info)), nil
+}
 
+func toAPIInfo(info *model.Info) InfoResponse {
 	var invAPI []struct {
 		Quantity *int    `json:"quantity,omitempty"`
 		Type     *string `json:"type,omitempty"`
+		Variant  *string `json:"variant,omitempty"`
 	}
 	for _, item := range info.Inventory {
 		qty := int(item.Amount)
@@ -60,52 +99,70 @@ func (s *APIServer) GetApiInfo(ctx context.Context, req GetApiInfoRequestObject)
 		invAPI = append(invAPI, struct {
 			Quantity *int    `json:"quantity,omitempty"`
 			Type     *string `json:"type,omitempty"`
+			Variant  *string `json:"variant,omitempty"`
 		}{
 			Quantity: &qty,
 			Type:     &typ,
+			Variant:  optionalString(item.Variant),
 		})
 	}
 
 	var sentAPI []struct {
-		Amount *int    `json:"amount,omitempty"`
-		ToUser *string `json:"toUser,omitempty"`
+		Amount   *int    `json:"amount,omitempty"`
+		Currency *string `json:"currency,omitempty"`
+		Team     *string `json:"team,omitempty"`
+		ToUser   *string `json:"toUser,omitempty"`
 	}
 	for _, t := range info.CoinHistory.Sent {
 		amt := int(t.Amount)
 		to := t.ToUsername
 		sentAPI = append(sentAPI, struct {
-			Amount *int    `json:"amount,omitempty"`
-			ToUser *string `json:"toUser,omitempty"`
+			Amount   *int    `json:"amount,omitempty"`
+			Currency *string `json:"currency,omitempty"`
+			Team     *string `json:"team,omitempty"`
+			ToUser   *string `json:"toUser,omitempty"`
 		}{
-			Amount: &amt,
-			ToUser: &to,
+			Amount:   &amt,
+			Currency: &t.Currency,
+			Team:     optionalString(t.Team),
+			ToUser:   &to,
 		})
 	}
 
 	var receivedAPI []struct {
 		Amount   *int    `json:"amount,omitempty"`
+		Currency *string `json:"currency,omitempty"`
 		FromUser *string `json:"fromUser,omitempty"`
+		Team     *string `json:"team,omitempty"`
 	}
 	for _, t := range info.CoinHistory.Received {
 		amt := int(t.Amount)
 		from := t.FromUsername
 		receivedAPI = append(receivedAPI, struct {
 			Amount   *int    `json:"amount,omitempty"`
+			Currency *string `json:"currency,omitempty"`
 			FromUser *string `json:"fromUser,omitempty"`
+			Team     *string `json:"team,omitempty"`
 		}{
 			Amount:   &amt,
+			Currency: &t.Currency,
 			FromUser: &from,
+			Team:     optionalString(t.Team),
 		})
 	}
 
 	var coinHistory struct {
 		Received *[]struct {
 			Amount   *int    `json:"amount,omitempty"`
+			Currency *string `json:"currency,omitempty"`
 			FromUser *string `json:"fromUser,omitempty"`
+			Team     *string `json:"team,omitempty"`
 		} `json:"received,omitempty"`
 		Sent *[]struct {
-			Amount *int    `json:"amount,omitempty"`
-			ToUser *string `json:"toUser,omitempty"`
+			Amount   *int    `json:"amount,omitempty"`
+			Currency *string `json:"currency,omitempty"`
+			Team     *string `json:"team,omitempty"`
+			ToUser   *string `json:"toUser,omitempty"`
 		} `json:"sent,omitempty"`
 	}
 	if len(receivedAPI) > 0 {
@@ -116,15 +173,45 @@ func (s *APIServer) GetApiInfo(ctx context.Context, req GetApiInfoRequestObject)
 	}
 
 	coinsVal := int(info.Coins)
+	balances := toAPIBalances(info.Balances)
 
-	resp := GetApiInfo200JSONResponse(InfoResponse{
+	return InfoResponse{
 		Coins:       &coinsVal,
+		Balances:    &balances,
 		Inventory:   &invAPI,
 		CoinHistory: &coinHistory,
-	})
+		Limits:      toAPISpendingAllowance(info.Allowance),
+	}
+}
+
+func (s *APIServer) GetApiPurchases(ctx context.Context, req GetApiPurchasesRequestObject) (GetApiPurchasesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiPurchases400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	purchases, err := s.merchService.GetPurchases(ctx, username)
+	if err != nil {
+		return GetApiPurchases500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiPurchases200JSONResponse{}
+	for _, p := range purchases {
+		resp = append(resp, toAPIPurchase(p))
+	}
 	return resp, nil
 }
 
+func toAPIPurchase(p model.Purchase) Purchase {
+	return Purchase{
+		Item:      p.Item,
+		Variant:   optionalString(p.Variant),
+		Price:     int(p.Price),
+		Currency:  p.Currency,
+		Sale:      optionalString(p.Sale),
+		PromoCode: optionalString(p.PromoCode),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
 func (s *APIServer) PostApiSendCoin(ctx context.Context, req PostApiSendCoinRequestObject) (PostApiSendCoinResponseObject, error) {
 	fromUsername, ok := ctx.Value("username").(string)
 	if !ok || fromUsername == "" {
@@ -133,7 +220,20 @@ func (s *APIServer) PostApiSendCoin(ctx context.Context, req PostApiSendCoinRequ
 	if req.Body == nil {
 		return PostApiSendCoin400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
 	}
-	if err := s.merchService.SendCoin(ctx, fromUsername, req.Body.ToUser, int32(req.Body.Amount)); err != nil {
+	currency := model.DefaultCurrency
+	if req.Body.Currency != nil {
+		currency = *req.Body.Currency
+	}
+	if err := s.merchService.SendCoin(ctx, fromUsername, req.Body.ToUser, currency, int32(req.Body.Amount)); err != nil {
+		var exceeded *model.LimitExceededError
+		if errors.As(err, &exceeded) {
+			return PostApiSendCoin403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		switch {
+		case errors.Is(err, model.ErrUserDeactivated), errors.Is(err, model.ErrCurrencyNotFound),
+			errors.Is(err, model.ErrCurrencyNotTransferable), errors.Is(err, model.ErrInsufficientFunds):
+			return PostApiSendCoin400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
 		return PostApiSendCoin500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
 	}
 	return PostApiSendCoin200Response{}, nil
@@ -143,6 +243,14 @@ func ptr(s string) *string {
 	return &s
 }
 
+// optionalString maps an empty string to an omitted field.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func ptrInt(i int) *int {
 	return &i
 }
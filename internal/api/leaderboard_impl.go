@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+func leaderboardLimit(limit *int) (int32, bool) {
+	if limit == nil {
+		return defaultLeaderboardLimit, true
+	}
+	if *limit <= 0 || *limit > maxLeaderboardLimit {
+		return 0, false
+	}
+	return int32(*limit), true
+}
+
+func (s *APIServer) GetApiLeaderboardReceivers(ctx context.Context, req GetApiLeaderboardReceiversRequestObject) (GetApiLeaderboardReceiversResponseObject, error) {
+	limit, ok := leaderboardLimit(req.Params.Limit)
+	if !ok {
+		return GetApiLeaderboardReceivers400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 100")}), nil
+	}
+	period := model.Period{From: req.Params.From, To: req.Params.To}
+	entries, err := s.merchService.GetLeaderboard(ctx, period, false, limit)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPeriod) {
+			return GetApiLeaderboardReceivers400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiLeaderboardReceivers500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiLeaderboardReceivers200JSONResponse(toAPILeaderboard(entries)), nil
+}
+
+func (s *APIServer) GetApiLeaderboardSenders(ctx context.Context, req GetApiLeaderboardSendersRequestObject) (GetApiLeaderboardSendersResponseObject, error) {
+	limit, ok := leaderboardLimit(req.Params.Limit)
+	if !ok {
+		return GetApiLeaderboardSenders400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 100")}), nil
+	}
+	period := model.Period{From: req.Params.From, To: req.Params.To}
+	entries, err := s.merchService.GetLeaderboard(ctx, period, true, limit)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPeriod) {
+			return GetApiLeaderboardSenders400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiLeaderboardSenders500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiLeaderboardSenders200JSONResponse(toAPILeaderboard(entries)), nil
+}
+
+func (s *APIServer) GetApiLeaderboardItems(ctx context.Context, req GetApiLeaderboardItemsRequestObject) (GetApiLeaderboardItemsResponseObject, error) {
+	limit, ok := leaderboardLimit(req.Params.Limit)
+	if !ok {
+		return GetApiLeaderboardItems400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 100")}), nil
+	}
+	period := model.Period{From: req.Params.From, To: req.Params.To}
+	items, err := s.merchService.GetTopItems(ctx, period, limit)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPeriod) {
+			return GetApiLeaderboardItems400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiLeaderboardItems500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiLeaderboardItems200JSONResponse{}
+	for _, item := range items {
+		resp = append(resp, ItemRanking{
+			Item:      item.Item,
+			Purchases: int(item.Purchases),
+			Coins:     int64(item.Coins),
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) GetApiStatsUsername(ctx context.Context, req GetApiStatsUsernameRequestObject) (GetApiStatsUsernameResponseObject, error) {
+	period := model.Period{From: req.Params.From, To: req.Params.To}
+	stats, err := s.merchService.GetUserStats(ctx, req.Username, period)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPeriod):
+			return GetApiStatsUsername400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return GetApiStatsUsername404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiStatsUsername500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiStatsUsername200JSONResponse(UserStats{
+		Username:          stats.Username,
+		TotalReceived:     int64(stats.TotalReceived),
+		TotalSent:         int64(stats.TotalSent),
+		TotalSpent:        int64(stats.TotalSpent),
+		ColleaguesThanked: int(stats.ColleaguesThanked),
+	}), nil
+}
+
+func toAPILeaderboard(entries []model.LeaderboardEntry) []LeaderboardEntry {
+	resp := []LeaderboardEntry{}
+	for _, e := range entries {
+		resp = append(resp, LeaderboardEntry{
+			Username:  e.Username,
+			Coins:     int64(e.Coins),
+			Transfers: int(e.Transfers),
+		})
+	}
+	return resp
+}
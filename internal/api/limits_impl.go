@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiAdminLimits(ctx context.Context, req GetApiAdminLimitsRequestObject) (GetApiAdminLimitsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminLimits400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	rules, err := s.merchService.ListSpendingLimits(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminLimits403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminLimits500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminLimits200JSONResponse{}
+	for _, rule := range rules {
+		resp = append(resp, SpendingLimitRule{
+			Scope:     SpendingLimitRuleScope(rule.Scope),
+			Subject:   rule.Subject,
+			Limits:    toAPISpendingLimits(rule.Limits),
+			UpdatedAt: rule.UpdatedAt,
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PutApiAdminLimitsScopeSubject(ctx context.Context, req PutApiAdminLimitsScopeSubjectRequestObject) (PutApiAdminLimitsScopeSubjectResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PutApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	limits, ok := fromAPISpendingLimits(*req.Body)
+	if !ok {
+		return PutApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr("limits must not be negative")}), nil
+	}
+	err := s.merchService.SetSpendingLimits(ctx, username, model.SpendingLimitRule{
+		Scope:   string(req.Scope),
+		Subject: req.Subject,
+		Limits:  limits,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PutApiAdminLimitsScopeSubject403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PutApiAdminLimitsScopeSubject404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidLimitScope):
+			return PutApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiAdminLimitsScopeSubject500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiAdminLimitsScopeSubject200Response{}, nil
+}
+
+func (s *APIServer) DeleteApiAdminLimitsScopeSubject(ctx context.Context, req DeleteApiAdminLimitsScopeSubjectRequestObject) (DeleteApiAdminLimitsScopeSubjectResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.DeleteSpendingLimits(ctx, username, string(req.Scope), req.Subject); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminLimitsScopeSubject403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrSpendingLimitNotFound):
+			return DeleteApiAdminLimitsScopeSubject404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidLimitScope):
+			return DeleteApiAdminLimitsScopeSubject400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminLimitsScopeSubject500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminLimitsScopeSubject200Response{}, nil
+}
+
+func optionalLimit(v *uint32) *int {
+	if v == nil {
+		return nil
+	}
+	return ptrInt(int(*v))
+}
+
+func toAPISpendingLimits(l model.SpendingLimits) SpendingLimits {
+	return SpendingLimits{
+		DailyTransfer:   optionalLimit(l.DailyTransfer),
+		MaxTransfer:     optionalLimit(l.MaxTransfer),
+		MonthlyPurchase: optionalLimit(l.MonthlyPurchase),
+	}
+}
+
+// fromAPISpendingLimits reports false if any of the limits is negative.
+func fromAPISpendingLimits(l SpendingLimits) (model.SpendingLimits, bool) {
+	valid := true
+	limit := func(v *int) *uint32 {
+		if v == nil {
+			return nil
+		}
+		if *v < 0 {
+			valid = false
+			return nil
+		}
+		u := uint32(*v)
+		return &u
+	}
+	limits := model.SpendingLimits{
+		DailyTransfer:   limit(l.DailyTransfer),
+		MaxTransfer:     limit(l.MaxTransfer),
+		MonthlyPurchase: limit(l.MonthlyPurchase),
+	}
+	return limits, valid
+}
+
+func toAPIAllowance(a *model.Allowance) *Allowance {
+	if a == nil {
+		return nil
+	}
+	return &Allowance{Limit: int(a.Limit), Remaining: int(a.Remaining)}
+}
+
+func toAPISpendingAllowance(a model.SpendingAllowance) *SpendingAllowance {
+	return &SpendingAllowance{
+		DailyTransfer:   toAPIAllowance(a.DailyTransfer),
+		MaxTransfer:     optionalLimit(a.MaxTransfer),
+		MonthlyPurchase: toAPIAllowance(a.MonthlyPurchase),
+	}
+}
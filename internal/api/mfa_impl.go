@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"merchshop/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (s *APIServer) PostApiAuthMfa(ctx context.Context, req PostApiAuthMfaRequestObject) (PostApiAuthMfaResponseObject, error) {
+	if req.Body == nil {
+		return PostApiAuthMfa400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	result, err := s.merchService.CompleteMFALogin(ctx, req.Body.ChallengeToken, req.Body.Code)
+	if err != nil {
+		var blocked *model.LoginBlockedError
+		switch {
+		case errors.As(err, &blocked):
+			if c, ok := ctx.(*gin.Context); ok {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			}
+			return PostApiAuthMfa429JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidMFAChallenge), errors.Is(err, model.ErrInvalidMFACode):
+			return PostApiAuthMfa401JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserDeactivated):
+			return PostApiAuthMfa403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrMFANotEnrolled):
+			return PostApiAuthMfa400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAuthMfa500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAuthMfa200JSONResponse(toAPIAuthResponse(result)), nil
+}
+
+func (s *APIServer) PostApiAuthMfaEnroll(ctx context.Context, req PostApiAuthMfaEnrollRequestObject) (PostApiAuthMfaEnrollResponseObject, error) {
+	if req.Body == nil {
+		return PostApiAuthMfaEnroll400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	enrollment, err := s.merchService.EnrollMFAWithChallenge(ctx, req.Body.ChallengeToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidMFAChallenge):
+			return PostApiAuthMfaEnroll401JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrMFAAlreadyEnabled):
+			return PostApiAuthMfaEnroll400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAuthMfaEnroll500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAuthMfaEnroll200JSONResponse(toAPIMFAEnrollment(enrollment)), nil
+}
+
+func (s *APIServer) GetApiMfa(ctx context.Context, req GetApiMfaRequestObject) (GetApiMfaResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiMfa400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	status, err := s.merchService.GetMFAStatus(ctx, username)
+	if err != nil {
+		return GetApiMfa500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiMfa200JSONResponse(MFAStatus{
+		Enabled:           status.Enabled,
+		RecoveryCodesLeft: int(status.RecoveryCodesLeft),
+	}), nil
+}
+
+func (s *APIServer) PostApiMfaEnroll(ctx context.Context, req PostApiMfaEnrollRequestObject) (PostApiMfaEnrollResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiMfaEnroll400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	enrollment, err := s.merchService.EnrollMFA(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrMFAAlreadyEnabled) {
+			return PostApiMfaEnroll400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiMfaEnroll500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiMfaEnroll200JSONResponse(toAPIMFAEnrollment(enrollment)), nil
+}
+
+func (s *APIServer) PostApiMfaConfirm(ctx context.Context, req PostApiMfaConfirmRequestObject) (PostApiMfaConfirmResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiMfaConfirm400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiMfaConfirm400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	codes, err := s.merchService.ConfirmMFA(ctx, username, req.Body.Code)
+	if err != nil {
+		if isMFAClientError(err) {
+			return PostApiMfaConfirm400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiMfaConfirm500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiMfaConfirm200JSONResponse(RecoveryCodes{RecoveryCodes: codes}), nil
+}
+
+func (s *APIServer) PostApiMfaRecoveryCodes(ctx context.Context, req PostApiMfaRecoveryCodesRequestObject) (PostApiMfaRecoveryCodesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiMfaRecoveryCodes400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiMfaRecoveryCodes400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	codes, err := s.merchService.RenewRecoveryCodes(ctx, username, req.Body.Code)
+	if err != nil {
+		if isMFAClientError(err) {
+			return PostApiMfaRecoveryCodes400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiMfaRecoveryCodes500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiMfaRecoveryCodes200JSONResponse(RecoveryCodes{RecoveryCodes: codes}), nil
+}
+
+func (s *APIServer) PostApiMfaDisable(ctx context.Context, req PostApiMfaDisableRequestObject) (PostApiMfaDisableResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiMfaDisable400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiMfaDisable400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if err := s.merchService.DisableMFA(ctx, username, req.Body.Code); err != nil {
+		switch {
+		case errors.Is(err, model.ErrMFARequired):
+			return PostApiMfaDisable403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case isMFAClientError(err):
+			return PostApiMfaDisable400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiMfaDisable500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiMfaDisable200Response{}, nil
+}
+
+func (s *APIServer) DeleteApiAdminUsersUsernameMfa(ctx context.Context, req DeleteApiAdminUsersUsernameMfaRequestObject) (DeleteApiAdminUsersUsernameMfaResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminUsersUsernameMfa400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.ResetUserMFA(ctx, username, req.Username); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminUsersUsernameMfa403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrMFANotEnrolled):
+			return DeleteApiAdminUsersUsernameMfa404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminUsersUsernameMfa500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminUsersUsernameMfa200Response{}, nil
+}
+
+func isMFAClientError(err error) bool {
+	return errors.Is(err, model.ErrInvalidMFACode) ||
+		errors.Is(err, model.ErrMFANotEnrolled) ||
+		errors.Is(err, model.ErrMFAAlreadyEnabled)
+}
+
+// toAPIAuthResponse returns either the token or the challenge the login has
+// to be completed with.
+func toAPIAuthResponse(result *model.AuthResult) AuthResponse {
+	var resp AuthResponse
+	if result.Challenge != nil {
+		required := true
+		resp.MfaRequired = &required
+		resp.ChallengeToken = &result.Challenge.Token
+		resp.ChallengeExpiresAt = &result.Challenge.ExpiresAt
+		resp.EnrollmentRequired = &result.Challenge.EnrollmentRequired
+		return resp
+	}
+	resp.Token = &result.Token
+	if len(result.RecoveryCodes) > 0 {
+		resp.RecoveryCodes = &result.RecoveryCodes
+	}
+	return resp
+}
+
+func toAPIMFAEnrollment(e *model.MFAEnrollment) MFAEnrollment {
+	return MFAEnrollment{Secret: e.Secret, OtpauthUri: e.URI}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultNotificationsLimit = 50
+	maxNotificationsLimit     = 500
+)
+
+func (s *APIServer) GetApiNotifications(ctx context.Context, req GetApiNotificationsRequestObject) (GetApiNotificationsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiNotifications400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	limit := defaultNotificationsLimit
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+		if limit <= 0 || limit > maxNotificationsLimit {
+			return GetApiNotifications400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 500")}), nil
+		}
+	}
+	filter := model.NotificationFilter{
+		BeforeID: req.Params.BeforeId,
+		Limit:    int32(limit),
+	}
+	if req.Params.UnreadOnly != nil {
+		filter.UnreadOnly = *req.Params.UnreadOnly
+	}
+	notifications, err := s.merchService.GetNotifications(ctx, username, filter)
+	if err != nil {
+		return GetApiNotifications500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiNotifications200JSONResponse{}
+	for _, n := range notifications {
+		data := n.Data
+		if data == nil {
+			data = map[string]interface{}{}
+		}
+		resp = append(resp, Notification{
+			Id:        n.ID,
+			Kind:      n.Kind,
+			Data:      data,
+			CreatedAt: n.CreatedAt,
+			ReadAt:    n.ReadAt,
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiNotificationsRead(ctx context.Context, req PostApiNotificationsReadRequestObject) (PostApiNotificationsReadResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiNotificationsRead400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiNotificationsRead400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	var ids []int64
+	if req.Body.Ids != nil {
+		ids = *req.Body.Ids
+	}
+	if err := s.merchService.MarkNotificationsRead(ctx, username, ids); err != nil {
+		if errors.Is(err, model.ErrNotificationNotFound) {
+			return PostApiNotificationsRead400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiNotificationsRead500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiNotificationsRead200Response{}, nil
+}
+
+func (s *APIServer) GetApiNotificationsUnreadCount(ctx context.Context, req GetApiNotificationsUnreadCountRequestObject) (GetApiNotificationsUnreadCountResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiNotificationsUnreadCount400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	count, err := s.merchService.CountUnreadNotifications(ctx, username)
+	if err != nil {
+		return GetApiNotificationsUnreadCount500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiNotificationsUnreadCount200JSONResponse(UnreadCountResponse{Count: int(count)}), nil
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"merchshop/internal/model"
+	"merchshop/internal/oidc"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	oidcCookieName   = "merch_oidc"
+	oidcCookiePath   = "/api/auth/oidc"
+	oidcCookieMaxAge = 10 * 60
+)
+
+// OIDCLogin starts the single sign-on flow: it keeps the login secrets in a
+// short-lived cookie and redirects the browser to the identity provider. It
+// is registered directly on the gin router since the generated strict
+// handlers cannot redirect or set cookies.
+//
+// (GET /api/auth/oidc/login)
+func (s *APIServer) OIDCLogin(c *gin.Context) {
+	state, err := oidc.NewLoginState()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{Errors: ptr(err.Error())})
+		return
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcCookieName, strings.Join([]string{state.State, state.Nonce, state.Verifier}, "."),
+		oidcCookieMaxAge, oidcCookiePath, "", c.Request.TLS != nil, true)
+	c.Redirect(http.StatusFound, s.oidc.AuthCodeURL(state))
+}
+
+// OIDCCallback completes the single sign-on flow and responds with the merch
+// token, like /api/auth.
+//
+// (GET /api/auth/oidc/callback)
+func (s *APIServer) OIDCCallback(c *gin.Context) {
+	if e := c.Query("error"); e != "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Errors: ptr("identity provider error: " + e)})
+		return
+	}
+	cookie, err := c.Cookie(oidcCookieName)
+	c.SetCookie(oidcCookieName, "", -1, oidcCookiePath, "", c.Request.TLS != nil, true)
+	parts := strings.Split(cookie, ".")
+	if err != nil || len(parts) != 3 || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(c.Query("state"))) != 1 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, ErrorResponse{Errors: ptr("missing or mismatched login state")})
+		return
+	}
+	state := oidc.LoginState{State: parts[0], Nonce: parts[1], Verifier: parts[2]}
+
+	claims, err := s.oidc.Exchange(c.Request.Context(), c.Query("code"), state)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) || errors.Is(err, oidc.ErrNonceMismatch) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Errors: ptr(err.Error())})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusBadGateway, ErrorResponse{Errors: ptr(err.Error())})
+		return
+	}
+	result, err := s.merchService.AuthenticateExternal(c, model.ExternalIdentity{
+		Issuer:        s.oidc.Issuer(),
+		Subject:       claims.Subject,
+		Username:      claims.Username,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+	})
+	if err != nil {
+		var link *model.IdentityLinkError
+		switch {
+		case errors.As(err, &link):
+			c.AbortWithStatusJSON(http.StatusConflict, IdentityLinkRequired{
+				Errors:        err.Error(),
+				LinkToken:     &link.Token,
+				LinkExpiresAt: &link.ExpiresAt,
+			})
+		case errors.Is(err, model.ErrIdentityConflict):
+			c.AbortWithStatusJSON(http.StatusConflict, IdentityLinkRequired{Errors: err.Error()})
+		case errors.Is(err, model.ErrInvalidIdentity):
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Errors: ptr(err.Error())})
+		case errors.Is(err, model.ErrUserDeactivated), errors.Is(err, model.ErrUsernameErased):
+			c.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{Errors: ptr(err.Error())})
+		default:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{Errors: ptr(err.Error())})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, toAPIAuthResponse(result))
+}
+
+func (s *APIServer) PostApiAuthOidcLink(ctx context.Context, req PostApiAuthOidcLinkRequestObject) (PostApiAuthOidcLinkResponseObject, error) {
+	if req.Body == nil {
+		return PostApiAuthOidcLink400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	result, err := s.merchService.ConfirmIdentityLink(ctx, req.Body.LinkToken, req.Body.Password)
+	if err != nil {
+		var blocked *model.LoginBlockedError
+		switch {
+		case errors.As(err, &blocked):
+			if c, ok := ctx.(*gin.Context); ok {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			}
+			return PostApiAuthOidcLink429JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidLinkToken), errors.Is(err, model.ErrInvalidPassword):
+			return PostApiAuthOidcLink401JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserDeactivated):
+			return PostApiAuthOidcLink403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAuthOidcLink500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAuthOidcLink200JSONResponse(toAPIAuthResponse(result)), nil
+}
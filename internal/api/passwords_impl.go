@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"merchshop/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (s *APIServer) PostApiPassword(ctx context.Context, req PostApiPasswordRequestObject) (PostApiPasswordResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiPassword400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiPassword400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if err := s.merchService.ChangePassword(ctx, username, req.Body.CurrentPassword, req.Body.NewPassword); err != nil {
+		var blocked *model.LoginBlockedError
+		switch {
+		case errors.As(err, &blocked):
+			if c, ok := ctx.(*gin.Context); ok {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			}
+			return PostApiPassword429JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrWeakPassword), errors.Is(err, model.ErrExternalPasswords):
+			return PostApiPassword400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidPassword):
+			return PostApiPassword403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiPassword500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiPassword200Response{}, nil
+}
+
+func (s *APIServer) PostApiPasswordReset(ctx context.Context, req PostApiPasswordResetRequestObject) (PostApiPasswordResetResponseObject, error) {
+	if req.Body == nil {
+		return PostApiPasswordReset400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if err := s.merchService.ResetPassword(ctx, req.Body.Token, req.Body.NewPassword); err != nil {
+		if errors.Is(err, model.ErrWeakPassword) || errors.Is(err, model.ErrInvalidResetToken) || errors.Is(err, model.ErrExternalPasswords) {
+			return PostApiPasswordReset400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiPasswordReset500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiPasswordReset200Response{}, nil
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultDirectoryLimit = 20
+	maxDirectoryLimit     = 100
+)
+
+func (s *APIServer) GetApiProfile(ctx context.Context, req GetApiProfileRequestObject) (GetApiProfileResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiProfile400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	user, err := s.merchService.GetProfile(ctx, username)
+	if err != nil {
+		return GetApiProfile500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return GetApiProfile200JSONResponse(toAPIProfile(user)), nil
+}
+
+func (s *APIServer) PutApiProfile(ctx context.Context, req PutApiProfileRequestObject) (PutApiProfileResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiProfile400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PutApiProfile400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	user, err := s.merchService.UpdateProfile(ctx, username, model.ProfileUpdate{
+		DisplayName: req.Body.DisplayName,
+		Email:       req.Body.Email,
+		Department:  req.Body.Department,
+		AvatarURL:   req.Body.AvatarUrl,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidEmail), errors.Is(err, model.ErrInvalidAvatarURL), errors.Is(err, model.ErrProfileTooLong):
+			return PutApiProfile400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiProfile500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiProfile200JSONResponse(toAPIProfile(user)), nil
+}
+
+func (s *APIServer) GetApiUsers(ctx context.Context, req GetApiUsersRequestObject) (GetApiUsersResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiUsers400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	limit := defaultDirectoryLimit
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+		if limit <= 0 || limit > maxDirectoryLimit {
+			return GetApiUsers400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 100")}), nil
+		}
+	}
+	query := ""
+	if req.Params.Q != nil {
+		query = *req.Params.Q
+	}
+	entries, err := s.merchService.SearchUsers(ctx, query, int32(limit))
+	if err != nil {
+		return GetApiUsers500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiUsers200JSONResponse{}
+	for _, e := range entries {
+		resp = append(resp, DirectoryEntry{
+			Username:    e.Username,
+			DisplayName: e.DisplayName,
+			Department:  e.Department,
+			AvatarUrl:   e.AvatarURL,
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminUsersUsernameDeactivate(ctx context.Context, req PostApiAdminUsersUsernameDeactivateRequestObject) (PostApiAdminUsersUsernameDeactivateResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernameDeactivate400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.DeactivateUser(ctx, username, req.Username); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernameDeactivate403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernameDeactivate404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernameDeactivate500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernameDeactivate200Response{}, nil
+}
+
+func (s *APIServer) PostApiAdminUsersUsernameReactivate(ctx context.Context, req PostApiAdminUsersUsernameReactivateRequestObject) (PostApiAdminUsersUsernameReactivateResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernameReactivate400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.ReactivateUser(ctx, username, req.Username); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernameReactivate403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernameReactivate404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernameReactivate500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernameReactivate200Response{}, nil
+}
+
+func (s *APIServer) GetApiProfileExport(ctx context.Context, req GetApiProfileExportRequestObject) (GetApiProfileExportResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiProfileExport400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	export, err := s.merchService.ExportUserData(ctx, username)
+	if err != nil {
+		return GetApiProfileExport500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := DataExport{
+		ExportedAt:  export.ExportedAt,
+		Profile:     toAPIProfile(&export.User),
+		Role:        export.User.Role,
+		Coins:       int(export.User.Coins),
+		Balances:    toAPIBalances(export.Balances),
+		Transfers:   []TransferRecord{},
+		Purchases:   []Purchase{},
+		AuditEvents: []AuditEntry{},
+	}
+	for _, t := range export.Transfers {
+		resp.Transfers = append(resp.Transfers, TransferRecord{
+			FromUser:  t.FromUsername,
+			ToUser:    t.ToUsername,
+			Amount:    int(t.Amount),
+			Currency:  t.Currency,
+			Team:      optionalString(t.Team),
+			CreatedAt: t.CreatedAt,
+		})
+	}
+	for _, p := range export.Purchases {
+		resp.Purchases = append(resp.Purchases, toAPIPurchase(p))
+	}
+	for _, e := range export.AuditEvents {
+		resp.AuditEvents = append(resp.AuditEvents, toAPIAuditEntry(e))
+	}
+	return GetApiProfileExport200JSONResponse(resp), nil
+}
+
+func (s *APIServer) PostApiAdminUsersUsernameErase(ctx context.Context, req PostApiAdminUsersUsernameEraseRequestObject) (PostApiAdminUsersUsernameEraseResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernameErase400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	pseudonym, err := s.merchService.EraseUser(ctx, username, req.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernameErase403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernameErase404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrEraseSelf):
+			return PostApiAdminUsersUsernameErase400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernameErase500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernameErase200JSONResponse(ErasureResponse{Pseudonym: pseudonym}), nil
+}
+
+func toAPIProfile(u *model.User) Profile {
+	return Profile{
+		Username:    u.Username,
+		DisplayName: u.Profile.DisplayName,
+		Email:       u.Profile.Email,
+		Department:  u.Profile.Department,
+		AvatarUrl:   u.Profile.AvatarURL,
+		Active:      u.DeactivatedAt == nil,
+	}
+}
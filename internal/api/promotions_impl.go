@@ -0,0 +1,224 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiAdminSales(ctx context.Context, req GetApiAdminSalesRequestObject) (GetApiAdminSalesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminSales400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	sales, err := s.merchService.ListSales(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminSales403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminSales500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminSales200JSONResponse{}
+	for _, sale := range sales {
+		resp = append(resp, toAPISale(sale))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminSales(ctx context.Context, req PostApiAdminSalesRequestObject) (PostApiAdminSalesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminSales400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminSales400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	discount, ok := fromAPIDiscount(req.Body.PercentOff, req.Body.AmountOff)
+	if !ok {
+		return PostApiAdminSales400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidDiscount.Error())}), nil
+	}
+	sale := model.Sale{
+		Name:     req.Body.Name,
+		Discount: discount,
+		StartsAt: req.Body.StartsAt,
+		EndsAt:   req.Body.EndsAt,
+	}
+	if req.Body.Item != nil {
+		sale.Item = *req.Body.Item
+	}
+	if req.Body.Category != nil {
+		sale.Category = *req.Body.Category
+	}
+	created, err := s.merchService.CreateSale(ctx, username, sale)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminSales403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrItemNotFound), errors.Is(err, model.ErrCategoryNotFound):
+			return PostApiAdminSales404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidSale), errors.Is(err, model.ErrInvalidDiscount):
+			return PostApiAdminSales400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminSales500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminSales200JSONResponse(toAPISale(*created)), nil
+}
+
+func (s *APIServer) DeleteApiAdminSalesId(ctx context.Context, req DeleteApiAdminSalesIdRequestObject) (DeleteApiAdminSalesIdResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminSalesId400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.DeleteSale(ctx, username, req.Id); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminSalesId403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrSaleNotFound):
+			return DeleteApiAdminSalesId404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrSaleUsed):
+			return DeleteApiAdminSalesId400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminSalesId500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminSalesId200Response{}, nil
+}
+
+func (s *APIServer) GetApiAdminPromoCodes(ctx context.Context, req GetApiAdminPromoCodesRequestObject) (GetApiAdminPromoCodesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	promos, err := s.merchService.ListPromoCodes(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminPromoCodes403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminPromoCodes500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminPromoCodes200JSONResponse{}
+	for _, promo := range promos {
+		resp = append(resp, toAPIPromoCode(promo))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminPromoCodes(ctx context.Context, req PostApiAdminPromoCodesRequestObject) (PostApiAdminPromoCodesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	discount, ok := fromAPIDiscount(req.Body.PercentOff, req.Body.AmountOff)
+	if !ok {
+		return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidDiscount.Error())}), nil
+	}
+	promo := model.PromoCode{
+		Code:         req.Body.Code,
+		Discount:     discount,
+		PerUserLimit: 1,
+		StartsAt:     req.Body.StartsAt,
+		EndsAt:       req.Body.EndsAt,
+	}
+	if req.Body.Item != nil {
+		promo.Item = *req.Body.Item
+	}
+	if req.Body.Category != nil {
+		promo.Category = *req.Body.Category
+	}
+	if req.Body.MaxUses != nil {
+		if *req.Body.MaxUses <= 0 {
+			return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidPromoCode.Error())}), nil
+		}
+		maxUses := uint32(*req.Body.MaxUses)
+		promo.MaxUses = &maxUses
+	}
+	if req.Body.PerUserLimit != nil {
+		if *req.Body.PerUserLimit <= 0 {
+			return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr(model.ErrInvalidPromoCode.Error())}), nil
+		}
+		promo.PerUserLimit = uint32(*req.Body.PerUserLimit)
+	}
+	created, err := s.merchService.CreatePromoCode(ctx, username, promo)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminPromoCodes403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrItemNotFound), errors.Is(err, model.ErrCategoryNotFound):
+			return PostApiAdminPromoCodes404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidPromoCode), errors.Is(err, model.ErrInvalidDiscount),
+			errors.Is(err, model.ErrInvalidPeriod), errors.Is(err, model.ErrPromoCodeExists):
+			return PostApiAdminPromoCodes400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminPromoCodes500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminPromoCodes200JSONResponse(toAPIPromoCode(*created)), nil
+}
+
+func (s *APIServer) DeleteApiAdminPromoCodesCode(ctx context.Context, req DeleteApiAdminPromoCodesCodeRequestObject) (DeleteApiAdminPromoCodesCodeResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminPromoCodesCode400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.DeletePromoCode(ctx, username, req.Code); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminPromoCodesCode403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrPromoCodeNotFound):
+			return DeleteApiAdminPromoCodesCode404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrPromoCodeUsed):
+			return DeleteApiAdminPromoCodesCode400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminPromoCodesCode500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminPromoCodesCode200Response{}, nil
+}
+
+// fromAPIDiscount reports false unless exactly one of the discounts is set
+// and it is positive.
+func fromAPIDiscount(percentOff, amountOff *int) (model.Discount, bool) {
+	switch {
+	case percentOff != nil && amountOff == nil && *percentOff > 0:
+		return model.Discount{PercentOff: uint32(*percentOff)}, true
+	case amountOff != nil && percentOff == nil && *amountOff > 0:
+		return model.Discount{AmountOff: uint32(*amountOff)}, true
+	}
+	return model.Discount{}, false
+}
+
+func optionalDiscount(v uint32) *int {
+	if v == 0 {
+		return nil
+	}
+	return ptrInt(int(v))
+}
+
+func toAPISale(sale model.Sale) Sale {
+	return Sale{
+		Id:         sale.ID,
+		Name:       sale.Name,
+		Item:       optionalString(sale.Item),
+		Category:   optionalString(sale.Category),
+		PercentOff: optionalDiscount(sale.Discount.PercentOff),
+		AmountOff:  optionalDiscount(sale.Discount.AmountOff),
+		StartsAt:   sale.StartsAt,
+		EndsAt:     sale.EndsAt,
+	}
+}
+
+func toAPIPromoCode(promo model.PromoCode) PromoCode {
+	return PromoCode{
+		Code:         promo.Code,
+		Item:         optionalString(promo.Item),
+		Category:     optionalString(promo.Category),
+		PercentOff:   optionalDiscount(promo.Discount.PercentOff),
+		AmountOff:    optionalDiscount(promo.Discount.AmountOff),
+		MaxUses:      optionalLimit(promo.MaxUses),
+		PerUserLimit: int(promo.PerUserLimit),
+		Uses:         int(promo.Uses),
+		StartsAt:     promo.StartsAt,
+		EndsAt:       promo.EndsAt,
+	}
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"merchshop/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (s *APIServer) GetApiAdminReportsKind(ctx context.Context, req GetApiAdminReportsKindRequestObject) (GetApiAdminReportsKindResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminReportsKind400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	kind := model.ReportKind(req.Kind)
+	format := model.ReportFormatCSV
+	if req.Params.Format != nil {
+		format = model.ReportFormat(*req.Params.Format)
+	}
+	period := model.Period{From: req.Params.From, To: req.Params.To}
+	if err := s.merchService.ValidateReport(kind, format, period); err != nil {
+		return GetApiAdminReportsKind400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	if err := s.merchService.RequireAdmin(ctx, username); err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminReportsKind403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminReportsKind500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	if err := s.merchService.AuditReportExport(ctx, username, kind, format, period); err != nil {
+		return GetApiAdminReportsKind500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+
+	ext := "csv"
+	if format == model.ReportFormatJSONL {
+		ext = "jsonl"
+	}
+	exportCtx := ctx
+	if c, ok := ctx.(*gin.Context); ok {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", string(kind)+"."+ext))
+		exportCtx = c.Request.Context()
+	}
+
+	// The report is written into a pipe while the response copies from it,
+	// so rows go out as they are read. An error past this point can only cut
+	// the response short.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.merchService.ExportReport(exportCtx, pw, kind, format, period))
+	}()
+	if format == model.ReportFormatJSONL {
+		return GetApiAdminReportsKind200ApplicationxNdjsonResponse{Body: pr}, nil
+	}
+	return GetApiAdminReportsKind200TextcsvResponse{Body: pr}, nil
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultTeamTransactionsLimit = 50
+	maxTeamTransactionsLimit     = 500
+)
+
+func (s *APIServer) GetApiTeams(ctx context.Context, req GetApiTeamsRequestObject) (GetApiTeamsResponseObject, error) {
+	teams, err := s.merchService.ListTeams(ctx)
+	if err != nil {
+		return GetApiTeams500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiTeams200JSONResponse{}
+	for _, t := range teams {
+		resp = append(resp, toAPITeam(t))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) GetApiTeamsName(ctx context.Context, req GetApiTeamsNameRequestObject) (GetApiTeamsNameResponseObject, error) {
+	team, err := s.merchService.GetTeam(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, model.ErrTeamNotFound) {
+			return GetApiTeamsName404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiTeamsName500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := toAPITeam(*team)
+	members := []TeamMember{}
+	for _, m := range team.Members {
+		members = append(members, TeamMember{Username: m.Username, Role: TeamMemberRole(m.Role), JoinedAt: m.JoinedAt})
+	}
+	resp.Members = &members
+	return GetApiTeamsName200JSONResponse(resp), nil
+}
+
+func (s *APIServer) PostApiTeamsNameTransfer(ctx context.Context, req PostApiTeamsNameTransferRequestObject) (PostApiTeamsNameTransferResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiTeamsNameTransfer400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiTeamsNameTransfer400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	reason := ""
+	if req.Body.Reason != nil {
+		reason = *req.Body.Reason
+	}
+	err := s.merchService.TeamTransfer(ctx, username, req.Name, req.Body.ToUser, int32(req.Body.Amount), reason)
+	if err != nil {
+		var exceeded *model.LimitExceededError
+		if errors.As(err, &exceeded) {
+			return PostApiTeamsNameTransfer403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiTeamsNameTransfer403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrTeamNotFound), errors.Is(err, model.ErrUserNotFound):
+			return PostApiTeamsNameTransfer404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAmount), errors.Is(err, model.ErrNotTeamMember),
+			errors.Is(err, model.ErrInsufficientTeamFunds), errors.Is(err, model.ErrUserDeactivated),
+			errors.Is(err, model.ErrTeamTransferToSelf):
+			return PostApiTeamsNameTransfer400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiTeamsNameTransfer500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiTeamsNameTransfer200Response{}, nil
+}
+
+func (s *APIServer) GetApiTeamsNameTransactions(ctx context.Context, req GetApiTeamsNameTransactionsRequestObject) (GetApiTeamsNameTransactionsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiTeamsNameTransactions400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	limit := defaultTeamTransactionsLimit
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+		if limit <= 0 || limit > maxTeamTransactionsLimit {
+			return GetApiTeamsNameTransactions400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 500")}), nil
+		}
+	}
+	txs, err := s.merchService.GetTeamTransactions(ctx, username, req.Name, int32(limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return GetApiTeamsNameTransactions403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrTeamNotFound):
+			return GetApiTeamsNameTransactions404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiTeamsNameTransactions500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiTeamsNameTransactions200JSONResponse{}
+	for _, t := range txs {
+		resp = append(resp, TeamTransaction{
+			Id:        t.ID,
+			Kind:      TeamTransactionKind(t.Kind),
+			ToUser:    optionalString(t.Username),
+			Amount:    int(t.Amount),
+			Actor:     t.Actor,
+			Reason:    t.Reason,
+			CreatedAt: t.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminTeams(ctx context.Context, req PostApiAdminTeamsRequestObject) (PostApiAdminTeamsResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminTeams400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminTeams400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	description := ""
+	if req.Body.Description != nil {
+		description = *req.Body.Description
+	}
+	team, err := s.merchService.CreateTeam(ctx, username, req.Body.Name, description)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminTeams403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidTeam), errors.Is(err, model.ErrTeamExists):
+			return PostApiAdminTeams400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminTeams500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminTeams200JSONResponse(toAPITeam(*team)), nil
+}
+
+func (s *APIServer) PutApiAdminTeamsNameMembersUsername(ctx context.Context, req PutApiAdminTeamsNameMembersUsernameRequestObject) (PutApiAdminTeamsNameMembersUsernameResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiAdminTeamsNameMembersUsername400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PutApiAdminTeamsNameMembersUsername400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	if err := s.merchService.SetTeamMember(ctx, username, req.Name, req.Username, string(req.Body.Role)); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PutApiAdminTeamsNameMembersUsername403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrTeamNotFound), errors.Is(err, model.ErrUserNotFound):
+			return PutApiAdminTeamsNameMembersUsername404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidTeamRole), errors.Is(err, model.ErrUserDeactivated):
+			return PutApiAdminTeamsNameMembersUsername400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiAdminTeamsNameMembersUsername500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiAdminTeamsNameMembersUsername200Response{}, nil
+}
+
+func (s *APIServer) DeleteApiAdminTeamsNameMembersUsername(ctx context.Context, req DeleteApiAdminTeamsNameMembersUsernameRequestObject) (DeleteApiAdminTeamsNameMembersUsernameResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminTeamsNameMembersUsername400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.RemoveTeamMember(ctx, username, req.Name, req.Username); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminTeamsNameMembersUsername403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrNotTeamMember):
+			return DeleteApiAdminTeamsNameMembersUsername404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminTeamsNameMembersUsername500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminTeamsNameMembersUsername200Response{}, nil
+}
+
+func (s *APIServer) PostApiAdminTeamsNameDeposit(ctx context.Context, req PostApiAdminTeamsNameDepositRequestObject) (PostApiAdminTeamsNameDepositResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminTeamsNameDeposit400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminTeamsNameDeposit400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	reason := ""
+	if req.Body.Reason != nil {
+		reason = *req.Body.Reason
+	}
+	if err := s.merchService.DepositTeamCoins(ctx, username, req.Name, int32(req.Body.Amount), reason); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminTeamsNameDeposit403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrTeamNotFound):
+			return PostApiAdminTeamsNameDeposit404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidAmount):
+			return PostApiAdminTeamsNameDeposit400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminTeamsNameDeposit500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminTeamsNameDeposit200Response{}, nil
+}
+
+func toAPITeam(t model.Team) Team {
+	return Team{
+		Name:        t.Name,
+		Description: t.Description,
+		Coins:       int(t.Coins),
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) PostApiAdminUsersUsernameUnlock(ctx context.Context, req PostApiAdminUsersUsernameUnlockRequestObject) (PostApiAdminUsersUsernameUnlockResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernameUnlock400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.UnlockUser(ctx, username, req.Username); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernameUnlock403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernameUnlock404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernameUnlock500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernameUnlock200Response{}, nil
+}
+
+func (s *APIServer) PostApiAdminUsersUsernamePasswordReset(ctx context.Context, req PostApiAdminUsersUsernamePasswordResetRequestObject) (PostApiAdminUsersUsernamePasswordResetResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminUsersUsernamePasswordReset400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	reset, err := s.merchService.IssuePasswordReset(ctx, username, req.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminUsersUsernamePasswordReset403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrUserNotFound):
+			return PostApiAdminUsersUsernamePasswordReset404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrExternalPasswords):
+			return PostApiAdminUsersUsernamePasswordReset400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminUsersUsernamePasswordReset500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminUsersUsernamePasswordReset200JSONResponse(PasswordResetToken{
+		Username:  reset.Username,
+		Token:     reset.Token,
+		ExpiresAt: reset.ExpiresAt,
+	}), nil
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+func (s *APIServer) GetApiAdminWebhooks(ctx context.Context, req GetApiAdminWebhooksRequestObject) (GetApiAdminWebhooksResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminWebhooks400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	subs, err := s.merchService.ListWebhooks(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminWebhooks403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminWebhooks500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminWebhooks200JSONResponse{}
+	for _, sub := range subs {
+		resp = append(resp, toAPIWebhookSubscription(sub, false))
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminWebhooks(ctx context.Context, req PostApiAdminWebhooksRequestObject) (PostApiAdminWebhooksResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminWebhooks400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if req.Body == nil {
+		return PostApiAdminWebhooks400JSONResponse(ErrorResponse{Errors: ptr("invalid request body")}), nil
+	}
+	var eventTypes []string
+	if req.Body.EventTypes != nil {
+		eventTypes = *req.Body.EventTypes
+	}
+	sub, err := s.merchService.CreateWebhook(ctx, username, req.Body.Url, eventTypes)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminWebhooks403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrInvalidWebhookURL), errors.Is(err, model.ErrUnknownWebhookEvent):
+			return PostApiAdminWebhooks400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminWebhooks500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminWebhooks200JSONResponse(toAPIWebhookSubscription(*sub, true)), nil
+}
+
+func (s *APIServer) DeleteApiAdminWebhooksId(ctx context.Context, req DeleteApiAdminWebhooksIdRequestObject) (DeleteApiAdminWebhooksIdResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiAdminWebhooksId400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.DeleteWebhook(ctx, username, req.Id); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return DeleteApiAdminWebhooksId403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrWebhookNotFound):
+			return DeleteApiAdminWebhooksId404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiAdminWebhooksId500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiAdminWebhooksId200Response{}, nil
+}
+
+func (s *APIServer) GetApiAdminWebhooksIdDeliveries(ctx context.Context, req GetApiAdminWebhooksIdDeliveriesRequestObject) (GetApiAdminWebhooksIdDeliveriesResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiAdminWebhooksIdDeliveries400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	limit := defaultDeliveriesLimit
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+		if limit <= 0 || limit > maxDeliveriesLimit {
+			return GetApiAdminWebhooksIdDeliveries400JSONResponse(ErrorResponse{Errors: ptr("limit must be between 1 and 500")}), nil
+		}
+	}
+	filter := model.WebhookDeliveryFilter{Limit: int32(limit)}
+	if req.Params.Status != nil {
+		filter.Status = *req.Params.Status
+	}
+	deliveries, err := s.merchService.GetWebhookDeliveries(ctx, username, req.Id, filter)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return GetApiAdminWebhooksIdDeliveries403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return GetApiAdminWebhooksIdDeliveries500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiAdminWebhooksIdDeliveries200JSONResponse{}
+	for _, d := range deliveries {
+		delivery := WebhookDelivery{
+			Id:          d.ID,
+			EventId:     d.EventID,
+			EventType:   d.EventType,
+			Status:      d.Status,
+			Attempts:    int(d.Attempts),
+			LastError:   optionalString(d.LastError),
+			DeliveredAt: d.DeliveredAt,
+			CreatedAt:   d.CreatedAt,
+		}
+		if d.Status == model.WebhookDeliveryPending {
+			delivery.NextAttemptAt = &d.NextAttemptAt
+		}
+		if d.LastStatusCode != nil {
+			delivery.LastStatusCode = ptrInt(int(*d.LastStatusCode))
+		}
+		resp = append(resp, delivery)
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PostApiAdminWebhooksDeliveriesDeliveryIdRetry(ctx context.Context, req PostApiAdminWebhooksDeliveriesDeliveryIdRetryRequestObject) (PostApiAdminWebhooksDeliveriesDeliveryIdRetryResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PostApiAdminWebhooksDeliveriesDeliveryIdRetry400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.RetryWebhookDelivery(ctx, username, req.DeliveryId); err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return PostApiAdminWebhooksDeliveriesDeliveryIdRetry403JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		case errors.Is(err, model.ErrWebhookDeliveryNotFound):
+			return PostApiAdminWebhooksDeliveriesDeliveryIdRetry404JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PostApiAdminWebhooksDeliveriesDeliveryIdRetry500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PostApiAdminWebhooksDeliveriesDeliveryIdRetry200Response{}, nil
+}
+
+// toAPIWebhookSubscription converts a subscription, exposing the signing
+// secret only when withSecret is set.
+func toAPIWebhookSubscription(sub model.WebhookSubscription, withSecret bool) WebhookSubscription {
+	resp := WebhookSubscription{
+		Id:         sub.ID,
+		Url:        sub.URL,
+		EventTypes: sub.EventTypes,
+		Active:     sub.Active,
+		CreatedBy:  sub.CreatedBy,
+		CreatedAt:  sub.CreatedAt,
+	}
+	if resp.EventTypes == nil {
+		resp.EventTypes = []string{}
+	}
+	if withSecret {
+		resp.Secret = &sub.Secret
+	}
+	return resp
+}
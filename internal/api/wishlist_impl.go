@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/model"
+)
+
+func (s *APIServer) GetApiWishlist(ctx context.Context, req GetApiWishlistRequestObject) (GetApiWishlistResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return GetApiWishlist400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	wishlist, err := s.merchService.GetWishlist(ctx, username)
+	if err != nil {
+		return GetApiWishlist500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	resp := GetApiWishlist200JSONResponse{}
+	for _, w := range wishlist {
+		resp = append(resp, WishlistItem{
+			Item:         w.Item,
+			Price:        int(w.Price),
+			Currency:     w.Currency,
+			MissingCoins: int(w.Missing),
+			AddedAt:      w.AddedAt,
+		})
+	}
+	return resp, nil
+}
+
+func (s *APIServer) PutApiWishlistItem(ctx context.Context, req PutApiWishlistItemRequestObject) (PutApiWishlistItemResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return PutApiWishlistItem400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.AddToWishlist(ctx, username, req.Item); err != nil {
+		if errors.Is(err, model.ErrItemNotFound) {
+			return PutApiWishlistItem400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return PutApiWishlistItem500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return PutApiWishlistItem200Response{}, nil
+}
+
+func (s *APIServer) DeleteApiWishlistItem(ctx context.Context, req DeleteApiWishlistItemRequestObject) (DeleteApiWishlistItemResponseObject, error) {
+	username, ok := ctx.Value("username").(string)
+	if !ok || username == "" {
+		return DeleteApiWishlistItem400JSONResponse(ErrorResponse{Errors: ptr("missing or invalid user")}), nil
+	}
+	if err := s.merchService.RemoveFromWishlist(ctx, username, req.Item); err != nil {
+		if errors.Is(err, model.ErrWishlistItemNotFound) {
+			return DeleteApiWishlistItem400JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+		}
+		return DeleteApiWishlistItem500JSONResponse(ErrorResponse{Errors: ptr(err.Error())}), nil
+	}
+	return DeleteApiWishlistItem200Response{}, nil
+}
@@ -0,0 +1,19 @@
+CREATE TABLE product_variants (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    sku TEXT NOT NULL UNIQUE,
+    size TEXT,
+    color TEXT,
+    -- NULL stock is unlimited.
+    stock INTEGER CHECK (stock >= 0),
+    price INTEGER CHECK (price > 0),
+    is_default BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX product_variants_item_idx ON product_variants (item);
+
+-- The default variant is bought when no SKU is given.
+CREATE UNIQUE INDEX product_variants_default_idx ON product_variants (item) WHERE is_default;
+
+ALTER TABLE purchases
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE SET NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS promo_code,
+    DROP COLUMN IF EXISTS sale_id;
+DROP TABLE IF EXISTS promo_codes;
+DROP TABLE IF EXISTS sales;
@@ -0,0 +1,37 @@
+CREATE TABLE sales (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    item TEXT REFERENCES products(item) ON DELETE CASCADE,
+    category TEXT,
+    percent_off INTEGER CHECK (percent_off BETWEEN 1 AND 100),
+    amount_off INTEGER CHECK (amount_off > 0),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    CHECK ((item IS NULL) <> (category IS NULL)),
+    CHECK ((percent_off IS NULL) <> (amount_off IS NULL)),
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX sales_period_idx ON sales (starts_at, ends_at);
+
+CREATE TABLE promo_codes (
+    code TEXT PRIMARY KEY,
+    item TEXT REFERENCES products(item) ON DELETE CASCADE,
+    category TEXT,
+    percent_off INTEGER CHECK (percent_off BETWEEN 1 AND 100),
+    amount_off INTEGER CHECK (amount_off > 0),
+    max_uses INTEGER CHECK (max_uses > 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    per_user_limit INTEGER NOT NULL DEFAULT 1 CHECK (per_user_limit > 0),
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    CHECK ((percent_off IS NULL) <> (amount_off IS NULL))
+);
+
+-- Purchases keep the promotion they were made with, so promotions that have
+-- been used cannot be deleted.
+ALTER TABLE purchases
+    ADD COLUMN sale_id INTEGER REFERENCES sales(id) ON DELETE RESTRICT,
+    ADD COLUMN promo_code TEXT REFERENCES promo_codes(code) ON DELETE RESTRICT;
+
+CREATE INDEX purchases_promo_code_idx ON purchases (promo_code, username);
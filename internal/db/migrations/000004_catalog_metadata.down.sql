@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS product_images;
+ALTER TABLE products
+    DROP COLUMN IF EXISTS active,
+    DROP COLUMN IF EXISTS description_en,
+    DROP COLUMN IF EXISTS description_ru,
+    DROP COLUMN IF EXISTS name_en,
+    DROP COLUMN IF EXISTS name_ru;
+ALTER TABLE promo_codes DROP CONSTRAINT IF EXISTS promo_codes_category_fkey;
+ALTER TABLE sales DROP CONSTRAINT IF EXISTS sales_category_fkey;
+ALTER TABLE products DROP COLUMN IF EXISTS category;
+DROP TABLE IF EXISTS categories;
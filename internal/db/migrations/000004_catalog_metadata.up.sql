@@ -0,0 +1,56 @@
+CREATE TABLE categories (
+    slug TEXT PRIMARY KEY,
+    name_ru TEXT NOT NULL DEFAULT '',
+    name_en TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO categories (slug, name_ru, name_en) VALUES
+  ('clothing', 'Одежда', 'Clothing'),
+  ('accessories', 'Аксессуары', 'Accessories'),
+  ('stationery', 'Канцелярия', 'Stationery');
+
+ALTER TABLE products
+    ADD COLUMN category TEXT REFERENCES categories(slug) ON DELETE SET NULL;
+
+UPDATE products SET category = 'clothing' WHERE item IN ('t-shirt', 'hoody', 'pink-hoody', 'socks');
+UPDATE products SET category = 'accessories' WHERE item IN ('cup', 'powerbank', 'umbrella', 'wallet');
+UPDATE products SET category = 'stationery' WHERE item IN ('book', 'pen');
+
+-- Sales and promo codes may target a category since the promotions
+-- migration; categories only exist from here on.
+ALTER TABLE sales
+    ADD CONSTRAINT sales_category_fkey FOREIGN KEY (category) REFERENCES categories(slug) ON DELETE CASCADE;
+ALTER TABLE promo_codes
+    ADD CONSTRAINT promo_codes_category_fkey FOREIGN KEY (category) REFERENCES categories(slug) ON DELETE CASCADE;
+
+ALTER TABLE products
+    ADD COLUMN name_ru TEXT NOT NULL DEFAULT '',
+    ADD COLUMN name_en TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_ru TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_en TEXT NOT NULL DEFAULT '',
+    ADD COLUMN active BOOLEAN NOT NULL DEFAULT true;
+
+UPDATE products p
+SET name_ru = v.name_ru, name_en = v.name_en
+FROM (VALUES
+  ('t-shirt', 'Футболка', 'T-shirt'),
+  ('cup', 'Кружка', 'Cup'),
+  ('book', 'Книга', 'Book'),
+  ('pen', 'Ручка', 'Pen'),
+  ('powerbank', 'Пауэрбанк', 'Power bank'),
+  ('hoody', 'Худи', 'Hoodie'),
+  ('umbrella', 'Зонт', 'Umbrella'),
+  ('socks', 'Носки', 'Socks'),
+  ('wallet', 'Кошелёк', 'Wallet'),
+  ('pink-hoody', 'Розовое худи', 'Pink hoodie')
+) AS v (item, name_ru, name_en)
+WHERE p.item = v.item;
+
+CREATE TABLE product_images (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX product_images_item_idx ON product_images (item, position);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS product_variants_wishlist_restock ON product_variants;
+DROP FUNCTION IF EXISTS notify_wishlist_restock();
+DROP TRIGGER IF EXISTS products_wishlist_price_drop ON products;
+DROP FUNCTION IF EXISTS notify_wishlist_price_drop();
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS wishlist_items;
@@ -0,0 +1,54 @@
+CREATE TABLE wishlist_items (
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (username, item)
+);
+
+CREATE INDEX wishlist_items_item_idx ON wishlist_items (item);
+
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notifications_username_idx ON notifications (username, id DESC);
+
+-- Price and stock are changed directly in the database, so wishlist events are
+-- raised by triggers rather than by the application.
+CREATE FUNCTION notify_wishlist_price_drop() RETURNS trigger AS $$
+BEGIN
+    IF NEW.price < OLD.price THEN
+        INSERT INTO notifications (username, kind, payload)
+        SELECT w.username, 'wishlist_price_drop',
+               jsonb_build_object('item', NEW.item, 'oldPrice', OLD.price, 'newPrice', NEW.price)
+        FROM wishlist_items w
+        WHERE w.item = NEW.item;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_wishlist_price_drop
+    AFTER UPDATE OF price ON products
+    FOR EACH ROW EXECUTE FUNCTION notify_wishlist_price_drop();
+
+CREATE FUNCTION notify_wishlist_restock() RETURNS trigger AS $$
+BEGIN
+    IF OLD.stock = 0 AND (NEW.stock IS NULL OR NEW.stock > 0) THEN
+        INSERT INTO notifications (username, kind, payload)
+        SELECT w.username, 'wishlist_restock',
+               jsonb_build_object('item', NEW.item, 'variant', NEW.sku, 'stock', NEW.stock)
+        FROM wishlist_items w
+        WHERE w.item = NEW.item;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER product_variants_wishlist_restock
+    AFTER UPDATE OF stock ON product_variants
+    FOR EACH ROW EXECUTE FUNCTION notify_wishlist_restock();
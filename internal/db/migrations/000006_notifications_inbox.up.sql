@@ -0,0 +1,3 @@
+ALTER TABLE notifications ADD COLUMN read_at TIMESTAMPTZ;
+
+CREATE INDEX notifications_unread_idx ON notifications (username) WHERE read_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS outbox_events_unrelayed_idx;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS relayed_at;
@@ -0,0 +1,4 @@
+ALTER TABLE outbox_events
+    ADD COLUMN relayed_at TIMESTAMPTZ;
+
+CREATE INDEX outbox_events_unrelayed_idx ON outbox_events (id) WHERE relayed_at IS NULL;
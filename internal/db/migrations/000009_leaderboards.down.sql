@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS purchases_username_idx;
+DROP INDEX IF EXISTS purchases_created_at_idx;
+DROP INDEX IF EXISTS coin_transfers_from_username_idx;
+DROP INDEX IF EXISTS coin_transfers_to_username_idx;
+DROP INDEX IF EXISTS coin_transfers_created_at_idx;
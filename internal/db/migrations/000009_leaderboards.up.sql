@@ -0,0 +1,5 @@
+CREATE INDEX coin_transfers_created_at_idx ON coin_transfers (created_at);
+CREATE INDEX coin_transfers_to_username_idx ON coin_transfers (to_username, created_at);
+CREATE INDEX coin_transfers_from_username_idx ON coin_transfers (from_username, created_at);
+CREATE INDEX purchases_created_at_idx ON purchases (created_at);
+CREATE INDEX purchases_username_idx ON purchases (username, created_at);
@@ -0,0 +1,6 @@
+-- Failed logins are counted per key: "user:<username>" or "ip:<address>".
+CREATE TABLE login_attempts (
+    key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMPTZ NOT NULL
+);
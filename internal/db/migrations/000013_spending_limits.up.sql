@@ -0,0 +1,11 @@
+-- Limits apply per user or per role; a user's own limit takes precedence over
+-- the one of their role. NULL means no limit.
+CREATE TABLE spending_limits (
+    scope TEXT NOT NULL CHECK (scope IN ('user', 'role')),
+    subject TEXT NOT NULL,
+    daily_transfer INTEGER CHECK (daily_transfer >= 0),
+    max_transfer INTEGER CHECK (max_transfer >= 0),
+    monthly_purchase INTEGER CHECK (monthly_purchase >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (scope, subject)
+);
@@ -0,0 +1,34 @@
+CREATE TABLE service_accounts (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Keys are looked up by the SHA-256 hash of the whole key; the prefix is
+-- kept in clear to tell keys apart.
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    service_account TEXT NOT NULL REFERENCES service_accounts(name) ON DELETE CASCADE,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX api_keys_service_account_idx ON api_keys (service_account, id);
+
+CREATE TABLE coin_grants (
+    id BIGSERIAL PRIMARY KEY,
+    to_username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL DEFAULT '',
+    granted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX coin_grants_to_username_idx ON coin_grants (to_username, created_at);
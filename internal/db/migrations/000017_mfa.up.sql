@@ -0,0 +1,16 @@
+-- A TOTP enrollment is pending until the first code is confirmed.
+-- last_used_step keeps a code from being used twice.
+CREATE TABLE user_mfa (
+    username TEXT PRIMARY KEY REFERENCES users(username) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE mfa_recovery_codes (
+    username TEXT NOT NULL REFERENCES user_mfa(username) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    PRIMARY KEY (username, code_hash)
+);
@@ -0,0 +1,24 @@
+ALTER TABLE coin_grants
+    DROP CONSTRAINT coin_grants_to_username_fkey,
+    ADD CONSTRAINT coin_grants_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE purchases
+    DROP CONSTRAINT purchases_username_fkey,
+    ADD CONSTRAINT purchases_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE coin_transfers
+    DROP CONSTRAINT coin_transfers_from_username_fkey,
+    ADD CONSTRAINT coin_transfers_from_username_fkey
+        FOREIGN KEY (from_username) REFERENCES users(username) ON DELETE CASCADE,
+    DROP CONSTRAINT coin_transfers_to_username_fkey,
+    ADD CONSTRAINT coin_transfers_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE users
+    DROP COLUMN deactivated_at,
+    DROP COLUMN avatar_url,
+    DROP COLUMN department,
+    DROP COLUMN email,
+    DROP COLUMN display_name;
@@ -0,0 +1,26 @@
+ALTER TABLE users
+    ADD COLUMN display_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN email TEXT NOT NULL DEFAULT '',
+    ADD COLUMN department TEXT NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN deactivated_at TIMESTAMPTZ;
+
+-- Users leaving are deactivated, not deleted. Deleting a user with coin
+-- history is refused so that the history of the other side survives.
+ALTER TABLE coin_transfers
+    DROP CONSTRAINT coin_transfers_from_username_fkey,
+    ADD CONSTRAINT coin_transfers_from_username_fkey
+        FOREIGN KEY (from_username) REFERENCES users(username) ON DELETE RESTRICT,
+    DROP CONSTRAINT coin_transfers_to_username_fkey,
+    ADD CONSTRAINT coin_transfers_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE purchases
+    DROP CONSTRAINT purchases_username_fkey,
+    ADD CONSTRAINT purchases_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE coin_grants
+    DROP CONSTRAINT coin_grants_to_username_fkey,
+    ADD CONSTRAINT coin_grants_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE RESTRICT;
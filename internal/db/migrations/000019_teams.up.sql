@@ -0,0 +1,36 @@
+CREATE TABLE teams (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    coins INTEGER NOT NULL DEFAULT 0 CHECK (coins >= 0),
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE team_members (
+    team TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    role TEXT NOT NULL CHECK (role IN ('member', 'lead')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (team, username)
+);
+
+CREATE INDEX team_members_username_idx ON team_members (username);
+
+-- The team wallet ledger: deposits by admins and transfers by team leads to
+-- members. username is the recipient of a transfer and actor the user who
+-- made the entry.
+CREATE TABLE team_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    team TEXT NOT NULL REFERENCES teams(name) ON DELETE RESTRICT,
+    kind TEXT NOT NULL CHECK (kind IN ('deposit', 'transfer')),
+    username TEXT REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((kind = 'transfer') = (username IS NOT NULL))
+);
+
+CREATE INDEX team_transactions_team_idx ON team_transactions (team, id);
+CREATE INDEX team_transactions_username_idx ON team_transactions (username, created_at)
+    WHERE username IS NOT NULL;
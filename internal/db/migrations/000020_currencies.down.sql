@@ -0,0 +1,6 @@
+ALTER TABLE coin_grants DROP COLUMN IF EXISTS currency;
+ALTER TABLE coin_transfers DROP COLUMN IF EXISTS currency;
+ALTER TABLE purchases DROP COLUMN IF EXISTS currency;
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+DROP TABLE IF EXISTS balance_lots;
+DROP TABLE IF EXISTS currencies;
@@ -0,0 +1,30 @@
+-- Coins are the default currency and stay in users.coins. Other currencies,
+-- such as bonus points handed out by HR, may be non-transferable and may
+-- expire lifetime_days after they are credited.
+CREATE TABLE currencies (
+    code TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    transferable BOOLEAN NOT NULL,
+    lifetime_days INTEGER CHECK (lifetime_days > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO currencies (code, name, transferable) VALUES ('coins', 'Монеты', TRUE);
+
+-- Balances in currencies other than coins. Every credit is a lot of its own
+-- so that it expires on its own; debits take from the lots expiring first.
+CREATE TABLE balance_lots (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    currency TEXT NOT NULL REFERENCES currencies(code) CHECK (currency <> 'coins'),
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX balance_lots_username_idx ON balance_lots (username, currency) WHERE amount > 0;
+
+ALTER TABLE products ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE purchases ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE coin_transfers ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE coin_grants ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
@@ -0,0 +1,3 @@
+ALTER TABLE purchases DROP COLUMN IF EXISTS auction_id;
+DROP TABLE IF EXISTS auction_bids;
+DROP TABLE IF EXISTS auctions;
@@ -0,0 +1,35 @@
+-- Timed auctions of one-off items. winner is set when the auction is closed
+-- with a bid reaching the reserve price.
+CREATE TABLE auctions (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item),
+    reserve_price INTEGER NOT NULL CHECK (reserve_price > 0),
+    ends_at TIMESTAMPTZ NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    closed_at TIMESTAMPTZ,
+    winner TEXT REFERENCES users(username) ON DELETE RESTRICT
+);
+
+CREATE INDEX auctions_open_idx ON auctions (ends_at) WHERE closed_at IS NULL;
+
+-- Bids hold the bidder's coins: they are deducted when the bid is placed and
+-- given back, setting released_at, once the bid is outbid. The bid still
+-- holding coins when the auction closes is paid for the item.
+CREATE TABLE auction_bids (
+    id BIGSERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    released_at TIMESTAMPTZ
+);
+
+CREATE INDEX auction_bids_auction_idx ON auction_bids (auction_id, id);
+CREATE UNIQUE INDEX auction_bids_hold_idx ON auction_bids (auction_id) WHERE released_at IS NULL;
+CREATE INDEX auction_bids_username_idx ON auction_bids (username);
+
+-- The purchase of a won item is marked with the auction: it was paid with
+-- the winning bid's hold when the bid was placed, not at closing.
+ALTER TABLE purchases
+    ADD COLUMN auction_id INTEGER REFERENCES auctions(id);
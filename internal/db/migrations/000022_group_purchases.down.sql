@@ -0,0 +1,3 @@
+ALTER TABLE purchases DROP COLUMN IF EXISTS group_purchase_id;
+DROP TABLE IF EXISTS group_purchase_pledges;
+DROP TABLE IF EXISTS group_purchases;
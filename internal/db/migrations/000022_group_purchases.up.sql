@@ -0,0 +1,33 @@
+-- Pooled purchases of an item for a recipient. Pledges hold the pledgers'
+-- coins: the item is bought for the recipient as soon as the pledges add up
+-- to the price, and the pledges are refunded if they do not by the deadline.
+CREATE TABLE group_purchases (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item),
+    variant_id INTEGER REFERENCES product_variants(id),
+    recipient TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    price INTEGER NOT NULL CHECK (price > 0),
+    deadline TIMESTAMPTZ NOT NULL,
+    created_by TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'funded', 'refunded')),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX group_purchases_open_idx ON group_purchases (deadline) WHERE status = 'open';
+
+CREATE TABLE group_purchase_pledges (
+    id BIGSERIAL PRIMARY KEY,
+    group_purchase_id INTEGER NOT NULL REFERENCES group_purchases(id),
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX group_purchase_pledges_group_idx ON group_purchase_pledges (group_purchase_id, id);
+CREATE INDEX group_purchase_pledges_username_idx ON group_purchase_pledges (username);
+
+-- The purchase made for the recipient is marked with the group purchase: it
+-- was paid with the pledges' holds, not by the recipient.
+ALTER TABLE purchases
+    ADD COLUMN group_purchase_id INTEGER REFERENCES group_purchases(id);
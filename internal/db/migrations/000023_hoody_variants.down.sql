@@ -0,0 +1 @@
+DELETE FROM product_variants WHERE sku IN ('hoody-classic', 'hoody-pink');
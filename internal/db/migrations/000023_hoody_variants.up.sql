@@ -0,0 +1,7 @@
+-- The hoody comes in the classic and the pink colour. The classic one is the
+-- default, so the hoody can still be bought without a variant; the
+-- pink-hoody product is kept for clients that buy it by name. Stock is not
+-- limited, as before.
+INSERT INTO product_variants (item, sku, size, color, stock, price, is_default) VALUES
+  ('hoody', 'hoody-classic', NULL, NULL, NULL, NULL, true),
+  ('hoody', 'hoody-pink', NULL, 'pink', NULL, 500, false);
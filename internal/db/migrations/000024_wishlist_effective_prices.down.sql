@@ -0,0 +1,20 @@
+ALTER TABLE wishlist_items DROP COLUMN IF EXISTS notified_price;
+
+CREATE FUNCTION notify_wishlist_price_drop() RETURNS trigger AS $$
+BEGIN
+    IF NEW.price < OLD.price THEN
+        INSERT INTO notifications (username, kind, payload)
+        SELECT w.username, 'wishlist_price_drop',
+               jsonb_build_object('item', NEW.item, 'oldPrice', OLD.price, 'newPrice', NEW.price)
+        FROM wishlist_items w
+        WHERE w.item = NEW.item;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_wishlist_price_drop
+    AFTER UPDATE OF price ON products
+    FOR EACH ROW EXECUTE FUNCTION notify_wishlist_price_drop();
+
+DROP FUNCTION IF EXISTS product_effective_price(TEXT);
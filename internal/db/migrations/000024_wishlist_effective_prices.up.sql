@@ -0,0 +1,24 @@
+-- product_effective_price is the lowest price the item can be bought for now:
+-- the cheapest variant, or the product price without variants, after the best
+-- active sale.
+CREATE FUNCTION product_effective_price(target TEXT) RETURNS INTEGER AS $$
+    SELECT COALESCE(MIN(GREATEST(b.price - COALESCE(b.price * s.percent_off / 100, s.amount_off), 0)), b.price)
+    FROM (
+        SELECT COALESCE((SELECT MIN(COALESCE(v.price, p.price)) FROM product_variants v WHERE v.item = p.item), p.price) AS price,
+               p.category
+        FROM products p
+        WHERE p.item = target
+    ) b
+    LEFT JOIN sales s ON s.starts_at <= now() AND s.ends_at > now()
+        AND (s.item = target OR s.category = b.category)
+    GROUP BY b.price;
+$$ LANGUAGE sql STABLE;
+
+-- Sales start and end with time passing, so price drops are found by polling
+-- the effective prices against the one each user was last told about.
+DROP TRIGGER IF EXISTS products_wishlist_price_drop ON products;
+DROP FUNCTION IF EXISTS notify_wishlist_price_drop();
+
+ALTER TABLE wishlist_items ADD COLUMN notified_price INTEGER;
+UPDATE wishlist_items SET notified_price = product_effective_price(item);
+ALTER TABLE wishlist_items ALTER COLUMN notified_price SET NOT NULL;
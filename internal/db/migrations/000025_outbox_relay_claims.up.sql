@@ -0,0 +1,2 @@
+ALTER TABLE outbox_events
+    ADD COLUMN relay_claimed_until TIMESTAMPTZ;
@@ -0,0 +1,18 @@
+-- Users registered before the column existed get the time of their
+-- registration audit entry, if any; the rest stay NULL, meaning they existed
+-- before any report period.
+ALTER TABLE users
+    ADD COLUMN created_at TIMESTAMPTZ;
+
+UPDATE users u
+SET created_at = a.created_at
+FROM (
+    SELECT target, MIN(created_at) AS created_at
+    FROM audit_log
+    WHERE action = 'user.registered'
+    GROUP BY target
+) a
+WHERE a.target = u.username;
+
+ALTER TABLE users
+    ALTER COLUMN created_at SET DEFAULT now();
@@ -0,0 +1,20 @@
+-- The unkeyed chain cannot be restored; entries keep their keyed hashes and
+-- unchained ones are left with empty ones.
+DROP TRIGGER IF EXISTS audit_log_no_update ON audit_log;
+DROP FUNCTION IF EXISTS audit_log_chain_only();
+DROP INDEX IF EXISTS audit_log_unchained_idx;
+
+UPDATE audit_log SET prev_hash = COALESCE(prev_hash, ''), hash = COALESCE(hash, '');
+
+ALTER TABLE audit_log
+    DROP COLUMN IF EXISTS digest,
+    DROP COLUMN IF EXISTS chain_seq,
+    ALTER COLUMN hash SET NOT NULL,
+    ALTER COLUMN prev_hash SET NOT NULL,
+    ALTER COLUMN seq DROP DEFAULT;
+
+DROP SEQUENCE IF EXISTS audit_log_seq;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
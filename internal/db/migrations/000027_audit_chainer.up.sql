@@ -0,0 +1,41 @@
+-- Entries are inserted unchained and linked to the hash chain in the
+-- background, so that appending no longer serializes every audited write on
+-- one lock. seq identifies an entry and may have gaps left by rolled back
+-- transactions; chain_seq is its contiguous position in the chain. digest and
+-- hash are HMACs keyed with a secret kept outside the database, so that the
+-- chain cannot be rebuilt by someone who can only write to the table. The
+-- existing unkeyed chain is dropped and rebuilt by the chainer.
+DROP TRIGGER audit_log_no_update ON audit_log;
+
+CREATE SEQUENCE audit_log_seq OWNED BY audit_log.seq;
+SELECT setval('audit_log_seq', COALESCE(MAX(seq), 0) + 1, false) FROM audit_log;
+
+ALTER TABLE audit_log
+    ALTER COLUMN seq SET DEFAULT nextval('audit_log_seq'),
+    ALTER COLUMN prev_hash DROP NOT NULL,
+    ALTER COLUMN hash DROP NOT NULL,
+    ADD COLUMN chain_seq BIGINT UNIQUE,
+    ADD COLUMN digest TEXT;
+
+UPDATE audit_log SET prev_hash = NULL, hash = NULL;
+
+CREATE INDEX audit_log_unchained_idx ON audit_log (seq) WHERE hash IS NULL;
+
+-- Only the chainer may touch an entry, once, to fill in its chain columns.
+CREATE FUNCTION audit_log_chain_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash IS NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text) THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_chain_only();
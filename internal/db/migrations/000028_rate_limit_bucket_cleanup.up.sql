@@ -0,0 +1 @@
+CREATE INDEX rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
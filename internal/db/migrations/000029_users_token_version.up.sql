@@ -0,0 +1,3 @@
+-- Tokens carry the version they were issued at; bumping it revokes them.
+ALTER TABLE users
+    ADD COLUMN token_version INTEGER NOT NULL DEFAULT 0;
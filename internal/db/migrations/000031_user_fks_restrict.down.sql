@@ -0,0 +1,29 @@
+ALTER TABLE wishlist_items
+    DROP CONSTRAINT wishlist_items_username_fkey,
+    ADD CONSTRAINT wishlist_items_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE notifications
+    DROP CONSTRAINT notifications_username_fkey,
+    ADD CONSTRAINT notifications_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE password_reset_tokens
+    DROP CONSTRAINT password_reset_tokens_username_fkey,
+    ADD CONSTRAINT password_reset_tokens_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE user_identities
+    DROP CONSTRAINT user_identities_username_fkey,
+    ADD CONSTRAINT user_identities_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE user_mfa
+    DROP CONSTRAINT user_mfa_username_fkey,
+    ADD CONSTRAINT user_mfa_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
+
+ALTER TABLE balance_lots
+    DROP CONSTRAINT balance_lots_username_fkey,
+    ADD CONSTRAINT balance_lots_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE CASCADE;
@@ -0,0 +1,19 @@
+CREATE OR REPLACE FUNCTION audit_log_chain_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash IS NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text) THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS erased_usernames;
+
+ALTER TABLE audit_log
+    DROP COLUMN IF EXISTS redacted_digest,
+    DROP COLUMN IF EXISTS redacted_at;
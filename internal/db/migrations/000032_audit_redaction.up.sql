@@ -0,0 +1,74 @@
+-- Erasing a user pseudonymizes the audit entries mentioning them. Redacted
+-- entries keep their place in the hash chain, but their content no longer
+-- matches the digest taken when they were chained, so the redacted content
+-- gets a digest of its own, keyed like the chain, which is verified instead.
+ALTER TABLE audit_log
+    ADD COLUMN redacted_at TIMESTAMPTZ,
+    ADD COLUMN redacted_digest TEXT;
+
+-- Erased usernames are kept, as SHA-256 hashes, so that nobody registers
+-- under one again and is taken for the erased user.
+CREATE TABLE erased_usernames (
+    username_hash TEXT PRIMARY KEY,
+    erased_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The chainer may fill in the chain columns of an entry once. Erasure names
+-- the erased user and their pseudonym in the merch.erased_username and
+-- merch.erased_pseudonym settings of its transaction, and may then replace
+-- the username with the pseudonym in the actor, the target and the strings
+-- of the payloads, and clear the address and user agent of the entries the
+-- user made; nothing else about an entry changes. The digest of redacted
+-- content may be set on its own, as the key it is made with is not known to
+-- the database.
+CREATE OR REPLACE FUNCTION audit_log_chain_only() RETURNS trigger AS $$
+DECLARE
+    erased TEXT;
+    pseudonym TEXT;
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash IS NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text,
+             NEW.redacted_at, NEW.redacted_digest)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text,
+             OLD.redacted_at, OLD.redacted_digest) THEN
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'UPDATE' AND OLD.redacted_at IS NOT NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text,
+             NEW.redacted_at, NEW.chain_seq, NEW.digest, NEW.prev_hash, NEW.hash)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text,
+             OLD.redacted_at, OLD.chain_seq, OLD.digest, OLD.prev_hash, OLD.hash) THEN
+        RETURN NEW;
+    END IF;
+    erased := current_setting('merch.erased_username', true);
+    pseudonym := current_setting('merch.erased_pseudonym', true);
+    IF TG_OP = 'UPDATE' AND NEW.redacted_at IS NOT NULL
+        AND erased <> '' AND pseudonym ~ '^erased-[0-9a-f]{16}$'
+        AND (NEW.seq, NEW.created_at, NEW.action, NEW.request_id,
+             NEW.chain_seq, NEW.digest, NEW.prev_hash, NEW.hash)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.action, OLD.request_id,
+             OLD.chain_seq, OLD.digest, OLD.prev_hash, OLD.hash)
+        AND NEW.actor = CASE WHEN OLD.actor = erased THEN pseudonym ELSE OLD.actor END
+        AND NEW.target = CASE
+            WHEN OLD.target = erased THEN pseudonym
+            WHEN OLD.target = 'user:' || erased THEN 'user:' || pseudonym
+            ELSE OLD.target
+        END
+        AND NEW.ip = CASE WHEN OLD.actor = erased THEN '' ELSE OLD.ip END
+        AND NEW.user_agent = CASE WHEN OLD.actor = erased THEN '' ELSE OLD.user_agent END
+        AND NEW.before::text IS NOT DISTINCT FROM
+            replace(OLD.before::text, to_json(erased)::text, to_json(pseudonym)::text)
+        AND NEW.after::text IS NOT DISTINCT FROM
+            replace(OLD.after::text, to_json(erased)::text, to_json(pseudonym)::text) THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
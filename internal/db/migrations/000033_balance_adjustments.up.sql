@@ -0,0 +1,12 @@
+-- Corrections of user balances by admins. A negative amount took coins away.
+CREATE TABLE balance_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    currency TEXT NOT NULL REFERENCES currencies(code),
+    amount INTEGER NOT NULL CHECK (amount <> 0),
+    reason TEXT NOT NULL DEFAULT '',
+    adjusted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX balance_adjustments_username_idx ON balance_adjustments (username, created_at);
@@ -8,29 +8,348 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID             int32
+	ServiceAccount string
+	Prefix         string
+	KeyHash        string
+	Scopes         []string
+	CreatedBy      string
+	CreatedAt      pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+	LastUsedAt     pgtype.Timestamptz
+	RevokedAt      pgtype.Timestamptz
+}
+
+type Auction struct {
+	ID           int32
+	Item         string
+	ReservePrice int32
+	EndsAt       pgtype.Timestamptz
+	CreatedBy    string
+	CreatedAt    pgtype.Timestamptz
+	ClosedAt     pgtype.Timestamptz
+	Winner       pgtype.Text
+}
+
+type AuctionBid struct {
+	ID         int64
+	AuctionID  int32
+	Username   string
+	Amount     int32
+	CreatedAt  pgtype.Timestamptz
+	ReleasedAt pgtype.Timestamptz
+}
+
+type AuditLog struct {
+	Seq            int64
+	CreatedAt      pgtype.Timestamptz
+	Actor          string
+	Action         string
+	Target         string
+	Ip             string
+	UserAgent      string
+	RequestID      string
+	Before         []byte
+	After          []byte
+	PrevHash       pgtype.Text
+	Hash           pgtype.Text
+	ChainSeq       pgtype.Int8
+	Digest         pgtype.Text
+	RedactedAt     pgtype.Timestamptz
+	RedactedDigest pgtype.Text
+}
+
+type BalanceAdjustment struct {
+	ID         int64
+	Username   string
+	Currency   string
+	Amount     int32
+	Reason     string
+	AdjustedBy string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type BalanceLot struct {
+	ID        int64
+	Username  string
+	Currency  string
+	Amount    int32
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type Category struct {
+	Slug   string
+	NameRu string
+	NameEn string
+}
+
+type CoinGrant struct {
+	ID         int64
+	ToUsername string
+	Amount     int32
+	Reason     string
+	GrantedBy  string
+	CreatedAt  pgtype.Timestamptz
+	Currency   string
+}
+
 type CoinTransfer struct {
 	ID           int32
 	FromUsername string
 	ToUsername   string
 	Amount       int32
 	CreatedAt    pgtype.Timestamptz
+	Currency     string
 }
 
-type Product struct {
-	Item  string
-	Price int32
+type Currency struct {
+	Code         string
+	Name         string
+	Transferable bool
+	LifetimeDays pgtype.Int4
+	CreatedAt    pgtype.Timestamptz
 }
 
-type Purchase struct {
+type ErasedUsername struct {
+	UsernameHash string
+	ErasedAt     pgtype.Timestamptz
+}
+
+type GroupPurchase struct {
 	ID        int32
-	Username  string
 	Item      string
+	VariantID pgtype.Int4
+	Recipient string
 	Price     int32
+	Deadline  pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt pgtype.Timestamptz
+	Status    string
+	ClosedAt  pgtype.Timestamptz
+}
+
+type GroupPurchasePledge struct {
+	ID              int64
+	GroupPurchaseID int32
+	Username        string
+	Amount          int32
+	CreatedAt       pgtype.Timestamptz
+}
+
+type LoginAttempt struct {
+	Key           string
+	Failures      int32
+	LastFailureAt pgtype.Timestamptz
+}
+
+type MfaRecoveryCode struct {
+	Username string
+	CodeHash string
+	UsedAt   pgtype.Timestamptz
+}
+
+type Notification struct {
+	ID        int64
+	Username  string
+	Kind      string
+	Payload   []byte
+	CreatedAt pgtype.Timestamptz
+	ReadAt    pgtype.Timestamptz
+}
+
+type OutboxEvent struct {
+	ID                int64
+	EventType         string
+	Payload           []byte
+	CreatedAt         pgtype.Timestamptz
+	RelayedAt         pgtype.Timestamptz
+	RelayClaimedUntil pgtype.Timestamptz
+}
+
+type PasswordResetToken struct {
+	TokenHash string
+	Username  string
+	CreatedBy string
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type Product struct {
+	Item          string
+	Price         int32
+	Category      pgtype.Text
+	NameRu        string
+	NameEn        string
+	DescriptionRu string
+	DescriptionEn string
+	Active        bool
+	Currency      string
+}
+
+type ProductImage struct {
+	ID       int32
+	Item     string
+	Url      string
+	Position int32
+}
+
+type ProductVariant struct {
+	ID        int32
+	Item      string
+	Sku       string
+	Size      pgtype.Text
+	Color     pgtype.Text
+	Stock     pgtype.Int4
+	Price     pgtype.Int4
+	IsDefault bool
+}
+
+type PromoCode struct {
+	Code         string
+	Item         pgtype.Text
+	Category     pgtype.Text
+	PercentOff   pgtype.Int4
+	AmountOff    pgtype.Int4
+	MaxUses      pgtype.Int4
+	Uses         int32
+	PerUserLimit int32
+	StartsAt     pgtype.Timestamptz
+	EndsAt       pgtype.Timestamptz
+}
+
+type Purchase struct {
+	ID              int32
+	Username        string
+	Item            string
+	Price           int32
+	CreatedAt       pgtype.Timestamptz
+	VariantID       pgtype.Int4
+	SaleID          pgtype.Int4
+	PromoCode       pgtype.Text
+	Currency        string
+	AuctionID       pgtype.Int4
+	GroupPurchaseID pgtype.Int4
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+}
+
+type Sale struct {
+	ID         int32
+	Name       string
+	Item       pgtype.Text
+	Category   pgtype.Text
+	PercentOff pgtype.Int4
+	AmountOff  pgtype.Int4
+	StartsAt   pgtype.Timestamptz
+	EndsAt     pgtype.Timestamptz
+}
+
+type ServiceAccount struct {
+	Name        string
+	Description string
+	CreatedBy   string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type SpendingLimit struct {
+	Scope           string
+	Subject         string
+	DailyTransfer   pgtype.Int4
+	MaxTransfer     pgtype.Int4
+	MonthlyPurchase pgtype.Int4
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type Team struct {
+	Name        string
+	Description string
+	Coins       int32
+	CreatedBy   string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type TeamMember struct {
+	Team     string
+	Username string
+	Role     string
+	JoinedAt pgtype.Timestamptz
+}
+
+type TeamTransaction struct {
+	ID        int64
+	Team      string
+	Kind      string
+	Username  pgtype.Text
+	Amount    int32
+	Actor     string
+	Reason    string
 	CreatedAt pgtype.Timestamptz
 }
 
 type User struct {
+	Username      string
+	PasswordHash  string
+	Coins         int32
+	Role          string
+	DisplayName   string
+	Email         string
+	Department    string
+	AvatarUrl     string
+	DeactivatedAt pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	TokenVersion  int32
+}
+
+type UserIdentity struct {
+	Issuer      string
+	Subject     string
+	Username    string
+	Email       string
+	CreatedAt   pgtype.Timestamptz
+	LastLoginAt pgtype.Timestamptz
+}
+
+type UserMfa struct {
 	Username     string
-	PasswordHash string
-	Coins        int32
+	Secret       string
+	ConfirmedAt  pgtype.Timestamptz
+	LastUsedStep int64
+	CreatedAt    pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int32
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID         int32
+	Url        string
+	Secret     string
+	EventTypes []string
+	Active     bool
+	CreatedBy  string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type WishlistItem struct {
+	Username      string
+	Item          string
+	CreatedAt     pgtype.Timestamptz
+	NotifiedPrice int32
 }
@@ -30,88 +30,4058 @@ func (q *Queries) AddCoins(ctx context.Context, arg AddCoinsParams) (int64, erro
 	return result.RowsAffected(), nil
 }
 
+const addTeamCoins = `-- name: AddTeamCoins :execrows
+UPDATE teams
+SET coins = coins + $1
+WHERE name = $2
+`
+
+type AddTeamCoinsParams struct {
+	Coins int32
+	Name  string
+}
+
+func (q *Queries) AddTeamCoins(ctx context.Context, arg AddTeamCoinsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addTeamCoins, arg.Coins, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const addWishlistItem = `-- name: AddWishlistItem :exec
+INSERT INTO wishlist_items (username, item, notified_price)
+VALUES ($1, $2, COALESCE(product_effective_price($2), 0))
+ON CONFLICT DO NOTHING
+`
+
+type AddWishlistItemParams struct {
+	Username string
+	Item     string
+}
+
+func (q *Queries) AddWishlistItem(ctx context.Context, arg AddWishlistItemParams) error {
+	_, err := q.db.Exec(ctx, addWishlistItem, arg.Username, arg.Item)
+	return err
+}
+
+const chainAuditEntry = `-- name: ChainAuditEntry :exec
+UPDATE audit_log
+SET chain_seq = $2, digest = $3, prev_hash = $4, hash = $5
+WHERE seq = $1
+`
+
+type ChainAuditEntryParams struct {
+	Seq      int64
+	ChainSeq pgtype.Int8
+	Digest   pgtype.Text
+	PrevHash pgtype.Text
+	Hash     pgtype.Text
+}
+
+func (q *Queries) ChainAuditEntry(ctx context.Context, arg ChainAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, chainAuditEntry,
+		arg.Seq,
+		arg.ChainSeq,
+		arg.Digest,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET relay_claimed_until = now() + make_interval(secs => $1::int)
+WHERE id IN (
+    SELECT id
+    FROM outbox_events
+    WHERE relayed_at IS NULL
+      AND (relay_claimed_until IS NULL OR relay_claimed_until <= now())
+    ORDER BY id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, payload, created_at
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseSeconds int32
+	BatchSize    int32
+}
+
+type ClaimOutboxEventsRow struct {
+	ID        int64
+	EventType string
+	Payload   []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]ClaimOutboxEventsRow, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimOutboxEventsRow
+	for rows.Next() {
+		var i ClaimOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const clearDefaultProductVariant = `-- name: ClearDefaultProductVariant :exec
+UPDATE product_variants
+SET is_default = false
+WHERE item = $1 AND sku <> $2 AND is_default
+`
+
+type ClearDefaultProductVariantParams struct {
+	Item string
+	Sku  string
+}
+
+func (q *Queries) ClearDefaultProductVariant(ctx context.Context, arg ClearDefaultProductVariantParams) error {
+	_, err := q.db.Exec(ctx, clearDefaultProductVariant, arg.Item, arg.Sku)
+	return err
+}
+
+const closeAuction = `-- name: CloseAuction :execrows
+UPDATE auctions
+SET closed_at = now(), winner = $2
+WHERE id = $1 AND closed_at IS NULL
+`
+
+type CloseAuctionParams struct {
+	ID     int32
+	Winner pgtype.Text
+}
+
+func (q *Queries) CloseAuction(ctx context.Context, arg CloseAuctionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, closeAuction, arg.ID, arg.Winner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const closeGroupPurchase = `-- name: CloseGroupPurchase :execrows
+UPDATE group_purchases
+SET status = $2, closed_at = now()
+WHERE id = $1 AND status = 'open'
+`
+
+type CloseGroupPurchaseParams struct {
+	ID     int32
+	Status string
+}
+
+func (q *Queries) CloseGroupPurchase(ctx context.Context, arg CloseGroupPurchaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, closeGroupPurchase, arg.ID, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const confirmUserMFA = `-- name: ConfirmUserMFA :exec
+UPDATE user_mfa
+SET confirmed_at = now()
+WHERE username = $1
+`
+
+func (q *Queries) ConfirmUserMFA(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, confirmUserMFA, username)
+	return err
+}
+
+const consumePasswordResetToken = `-- name: ConsumePasswordResetToken :one
+UPDATE password_reset_tokens
+SET used_at = now()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > now()
+RETURNING username
+`
+
+func (q *Queries) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (string, error) {
+	row := q.db.QueryRow(ctx, consumePasswordResetToken, tokenHash)
+	var username string
+	err := row.Scan(&username)
+	return username, err
+}
+
+const countProductVariants = `-- name: CountProductVariants :one
+SELECT COUNT(*)
+FROM product_variants
+WHERE item = $1
+`
+
+func (q *Queries) CountProductVariants(ctx context.Context, item string) (int64, error) {
+	row := q.db.QueryRow(ctx, countProductVariants, item)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRecoveryCodes = `-- name: CountRecoveryCodes :one
+SELECT COUNT(*)
+FROM mfa_recovery_codes
+WHERE username = $1 AND used_at IS NULL
+`
+
+func (q *Queries) CountRecoveryCodes(ctx context.Context, username string) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecoveryCodes, username)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE username = $1 AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, username string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, username)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserPromoCodeUses = `-- name: CountUserPromoCodeUses :one
+SELECT COUNT(*)
+FROM purchases
+WHERE username = $1 AND promo_code = $2
+`
+
+type CountUserPromoCodeUsesParams struct {
+	Username  string
+	PromoCode pgtype.Text
+}
+
+func (q *Queries) CountUserPromoCodeUses(ctx context.Context, arg CountUserPromoCodeUsesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserPromoCodeUses, arg.Username, arg.PromoCode)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (service_account, prefix, key_hash, scopes, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at
+`
+
+type CreateAPIKeyParams struct {
+	ServiceAccount string
+	Prefix         string
+	KeyHash        string
+	Scopes         []string
+	CreatedBy      string
+	ExpiresAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.ServiceAccount,
+		arg.Prefix,
+		arg.KeyHash,
+		arg.Scopes,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceAccount,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const createAuction = `-- name: CreateAuction :one
+INSERT INTO auctions (item, reserve_price, ends_at, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, item, reserve_price, ends_at, created_by, created_at, closed_at, winner
+`
+
+type CreateAuctionParams struct {
+	Item         string
+	ReservePrice int32
+	EndsAt       pgtype.Timestamptz
+	CreatedBy    string
+}
+
+func (q *Queries) CreateAuction(ctx context.Context, arg CreateAuctionParams) (Auction, error) {
+	row := q.db.QueryRow(ctx, createAuction,
+		arg.Item,
+		arg.ReservePrice,
+		arg.EndsAt,
+		arg.CreatedBy,
+	)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.ReservePrice,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ClosedAt,
+		&i.Winner,
+	)
+	return i, err
+}
+
+const createAuditEntry = `-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (created_at, actor, action, target, ip, user_agent, request_id, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateAuditEntryParams struct {
+	CreatedAt pgtype.Timestamptz
+	Actor     string
+	Action    string
+	Target    string
+	Ip        string
+	UserAgent string
+	RequestID string
+	Before    []byte
+	After     []byte
+}
+
+func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, createAuditEntry,
+		arg.CreatedAt,
+		arg.Actor,
+		arg.Action,
+		arg.Target,
+		arg.Ip,
+		arg.UserAgent,
+		arg.RequestID,
+		arg.Before,
+		arg.After,
+	)
+	return err
+}
+
+const createCurrency = `-- name: CreateCurrency :one
+INSERT INTO currencies (code, name, transferable, lifetime_days)
+VALUES ($1, $2, $3, $4)
+RETURNING code, name, transferable, lifetime_days, created_at
+`
+
+type CreateCurrencyParams struct {
+	Code         string
+	Name         string
+	Transferable bool
+	LifetimeDays pgtype.Int4
+}
+
+func (q *Queries) CreateCurrency(ctx context.Context, arg CreateCurrencyParams) (Currency, error) {
+	row := q.db.QueryRow(ctx, createCurrency,
+		arg.Code,
+		arg.Name,
+		arg.Transferable,
+		arg.LifetimeDays,
+	)
+	var i Currency
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Transferable,
+		&i.LifetimeDays,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGroupPurchase = `-- name: CreateGroupPurchase :one
+INSERT INTO group_purchases (item, variant_id, recipient, price, deadline, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type CreateGroupPurchaseParams struct {
+	Item      string
+	VariantID pgtype.Int4
+	Recipient string
+	Price     int32
+	Deadline  pgtype.Timestamptz
+	CreatedBy string
+}
+
+func (q *Queries) CreateGroupPurchase(ctx context.Context, arg CreateGroupPurchaseParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createGroupPurchase,
+		arg.Item,
+		arg.VariantID,
+		arg.Recipient,
+		arg.Price,
+		arg.Deadline,
+		arg.CreatedBy,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notifications (username, kind, payload)
+VALUES ($1, $2, $3)
+`
+
+type CreateNotificationParams struct {
+	Username string
+	Kind     string
+	Payload  []byte
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	_, err := q.db.Exec(ctx, createNotification, arg.Username, arg.Kind, arg.Payload)
+	return err
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :one
+INSERT INTO outbox_events (event_type, payload)
+VALUES ($1, $2)
+RETURNING id
+`
+
+type CreateOutboxEventParams struct {
+	EventType string
+	Payload   []byte
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createOutboxEvent, arg.EventType, arg.Payload)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPasswordResetToken = `-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (token_hash, username, created_by, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreatePasswordResetTokenParams struct {
+	TokenHash string
+	Username  string
+	CreatedBy string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error {
+	_, err := q.db.Exec(ctx, createPasswordResetToken,
+		arg.TokenHash,
+		arg.Username,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createPromoCode = `-- name: CreatePromoCode :one
+INSERT INTO promo_codes (code, item, category, percent_off, amount_off, max_uses, per_user_limit, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at
+`
+
+type CreatePromoCodeParams struct {
+	Code         string
+	Item         pgtype.Text
+	Category     pgtype.Text
+	PercentOff   pgtype.Int4
+	AmountOff    pgtype.Int4
+	MaxUses      pgtype.Int4
+	PerUserLimit int32
+	StartsAt     pgtype.Timestamptz
+	EndsAt       pgtype.Timestamptz
+}
+
+func (q *Queries) CreatePromoCode(ctx context.Context, arg CreatePromoCodeParams) (PromoCode, error) {
+	row := q.db.QueryRow(ctx, createPromoCode,
+		arg.Code,
+		arg.Item,
+		arg.Category,
+		arg.PercentOff,
+		arg.AmountOff,
+		arg.MaxUses,
+		arg.PerUserLimit,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i PromoCode
+	err := row.Scan(
+		&i.Code,
+		&i.Item,
+		&i.Category,
+		&i.PercentOff,
+		&i.AmountOff,
+		&i.MaxUses,
+		&i.Uses,
+		&i.PerUserLimit,
+		&i.StartsAt,
+		&i.EndsAt,
+	)
+	return i, err
+}
+
 const createPurchase = `-- name: CreatePurchase :one
-INSERT INTO purchases (username, item, price)
+INSERT INTO purchases (username, item, price, variant_id, sale_id, promo_code, currency, auction_id, group_purchase_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, username, item, price, created_at, variant_id, sale_id, promo_code, currency
+`
+
+type CreatePurchaseParams struct {
+	Username        string
+	Item            string
+	Price           int32
+	VariantID       pgtype.Int4
+	SaleID          pgtype.Int4
+	PromoCode       pgtype.Text
+	Currency        string
+	AuctionID       pgtype.Int4
+	GroupPurchaseID pgtype.Int4
+}
+
+type CreatePurchaseRow struct {
+	ID        int32
+	Username  string
+	Item      string
+	Price     int32
+	CreatedAt pgtype.Timestamptz
+	VariantID pgtype.Int4
+	SaleID    pgtype.Int4
+	PromoCode pgtype.Text
+	Currency  string
+}
+
+func (q *Queries) CreatePurchase(ctx context.Context, arg CreatePurchaseParams) (CreatePurchaseRow, error) {
+	row := q.db.QueryRow(ctx, createPurchase,
+		arg.Username,
+		arg.Item,
+		arg.Price,
+		arg.VariantID,
+		arg.SaleID,
+		arg.PromoCode,
+		arg.Currency,
+		arg.AuctionID,
+		arg.GroupPurchaseID,
+	)
+	var i CreatePurchaseRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Item,
+		&i.Price,
+		&i.CreatedAt,
+		&i.VariantID,
+		&i.SaleID,
+		&i.PromoCode,
+		&i.Currency,
+	)
+	return i, err
+}
+
+const createSale = `-- name: CreateSale :one
+INSERT INTO sales (name, item, category, percent_off, amount_off, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, item, category, percent_off, amount_off, starts_at, ends_at
+`
+
+type CreateSaleParams struct {
+	Name       string
+	Item       pgtype.Text
+	Category   pgtype.Text
+	PercentOff pgtype.Int4
+	AmountOff  pgtype.Int4
+	StartsAt   pgtype.Timestamptz
+	EndsAt     pgtype.Timestamptz
+}
+
+func (q *Queries) CreateSale(ctx context.Context, arg CreateSaleParams) (Sale, error) {
+	row := q.db.QueryRow(ctx, createSale,
+		arg.Name,
+		arg.Item,
+		arg.Category,
+		arg.PercentOff,
+		arg.AmountOff,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i Sale
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Item,
+		&i.Category,
+		&i.PercentOff,
+		&i.AmountOff,
+		&i.StartsAt,
+		&i.EndsAt,
+	)
+	return i, err
+}
+
+const createServiceAccount = `-- name: CreateServiceAccount :one
+INSERT INTO service_accounts (name, description, created_by)
+VALUES ($1, $2, $3)
+RETURNING name, description, created_by, created_at
+`
+
+type CreateServiceAccountParams struct {
+	Name        string
+	Description string
+	CreatedBy   string
+}
+
+func (q *Queries) CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (ServiceAccount, error) {
+	row := q.db.QueryRow(ctx, createServiceAccount, arg.Name, arg.Description, arg.CreatedBy)
+	var i ServiceAccount
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createTeam = `-- name: CreateTeam :one
+INSERT INTO teams (name, description, created_by)
 VALUES ($1, $2, $3)
-RETURNING id, username, item, price, created_at
+RETURNING name, description, coins, created_by, created_at
+`
+
+type CreateTeamParams struct {
+	Name        string
+	Description string
+	CreatedBy   string
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.Name, arg.Description, arg.CreatedBy)
+	var i Team
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Coins,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :exec
+INSERT INTO users (username, password_hash)
+VALUES ($1, $2)
+`
+
+type CreateUserParams struct {
+	Username     string
+	PasswordHash string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
+	_, err := q.db.Exec(ctx, createUser, arg.Username, arg.PasswordHash)
+	return err
+}
+
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (issuer, subject, username, email)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateUserIdentityParams struct {
+	Issuer   string
+	Subject  string
+	Username string
+	Email    string
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, createUserIdentity,
+		arg.Issuer,
+		arg.Subject,
+		arg.Username,
+		arg.Email,
+	)
+	return err
+}
+
+const createWebhookDeliveries = `-- name: CreateWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id)
+SELECT s.id, $1::bigint
+FROM webhook_subscriptions s
+WHERE s.active
+  AND (cardinality(s.event_types) = 0 OR $2::text = ANY(s.event_types))
+`
+
+type CreateWebhookDeliveriesParams struct {
+	EventID   int64
+	EventType string
+}
+
+func (q *Queries) CreateWebhookDeliveries(ctx context.Context, arg CreateWebhookDeliveriesParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDeliveries, arg.EventID, arg.EventType)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, url, secret, event_types, active, created_by, created_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedBy  string
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedBy,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const decrementVariantStock = `-- name: DecrementVariantStock :execrows
+UPDATE product_variants
+SET stock = stock - 1
+WHERE id = $1 AND (stock IS NULL OR stock > 0)
+`
+
+func (q *Queries) DecrementVariantStock(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, decrementVariantStock, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deductCoins = `-- name: DeductCoins :execrows
+UPDATE users
+SET coins = coins - $1
+WHERE username = $2 AND coins >= $1
+`
+
+type DeductCoinsParams struct {
+	Coins    int32
+	Username string
+}
+
+func (q *Queries) DeductCoins(ctx context.Context, arg DeductCoinsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deductCoins, arg.Coins, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deductTeamCoins = `-- name: DeductTeamCoins :execrows
+UPDATE teams
+SET coins = coins - $1
+WHERE name = $2 AND coins >= $1
+`
+
+type DeductTeamCoinsParams struct {
+	Coins int32
+	Name  string
+}
+
+func (q *Queries) DeductTeamCoins(ctx context.Context, arg DeductTeamCoinsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deductTeamCoins, arg.Coins, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteIdleRateLimitBuckets = `-- name: DeleteIdleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE updated_at < $1
+`
+
+func (q *Queries) DeleteIdleRateLimitBuckets(ctx context.Context, idleSince pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteIdleRateLimitBuckets, idleSince)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePromoCode = `-- name: DeletePromoCode :execrows
+DELETE FROM promo_codes
+WHERE code = $1
+`
+
+func (q *Queries) DeletePromoCode(ctx context.Context, code string) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePromoCode, code)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE username = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, username)
+	return err
+}
+
+const deleteSale = `-- name: DeleteSale :execrows
+DELETE FROM sales
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSale(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSale, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSpendingLimits = `-- name: DeleteSpendingLimits :execrows
+DELETE FROM spending_limits
+WHERE scope = $1 AND subject = $2
+`
+
+type DeleteSpendingLimitsParams struct {
+	Scope   string
+	Subject string
+}
+
+func (q *Queries) DeleteSpendingLimits(ctx context.Context, arg DeleteSpendingLimitsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSpendingLimits, arg.Scope, arg.Subject)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamMember = `-- name: DeleteTeamMember :execrows
+DELETE FROM team_members
+WHERE team = $1 AND username = $2
+`
+
+type DeleteTeamMemberParams struct {
+	Team     string
+	Username string
+}
+
+func (q *Queries) DeleteTeamMember(ctx context.Context, arg DeleteTeamMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamMember, arg.Team, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUnusedPasswordResetTokens = `-- name: DeleteUnusedPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE username = $1 AND used_at IS NULL
+`
+
+func (q *Queries) DeleteUnusedPasswordResetTokens(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUnusedPasswordResetTokens, username)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserBalanceLots = `-- name: DeleteUserBalanceLots :exec
+DELETE FROM balance_lots
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserBalanceLots(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserBalanceLots, username)
+	return err
+}
+
+const deleteUserIdentities = `-- name: DeleteUserIdentities :exec
+DELETE FROM user_identities
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserIdentities(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserIdentities, username)
+	return err
+}
+
+const deleteUserMFA = `-- name: DeleteUserMFA :execrows
+DELETE FROM user_mfa
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserMFA(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserMFA, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserNotifications = `-- name: DeleteUserNotifications :exec
+DELETE FROM notifications
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserNotifications(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserNotifications, username)
+	return err
+}
+
+const deleteUserPasswordResetTokens = `-- name: DeleteUserPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserPasswordResetTokens(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserPasswordResetTokens, username)
+	return err
+}
+
+const deleteUserTeamMemberships = `-- name: DeleteUserTeamMemberships :exec
+DELETE FROM team_members
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserTeamMemberships(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserTeamMemberships, username)
+	return err
+}
+
+const deleteUserWishlist = `-- name: DeleteUserWishlist :exec
+DELETE FROM wishlist_items
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserWishlist(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserWishlist, username)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exportCoinTransfers = `-- name: ExportCoinTransfers :many
+SELECT from_username, to_username, amount, currency, created_at, ''::text AS team
+FROM coin_transfers
+WHERE from_username = $1 OR to_username = $1
+UNION ALL
+SELECT actor, username, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND (username = $1 OR actor = $1)
+ORDER BY created_at
+`
+
+type ExportCoinTransfersRow struct {
+	FromUsername string
+	ToUsername   string
+	Amount       int32
+	Currency     string
+	CreatedAt    pgtype.Timestamptz
+	Team         string
+}
+
+func (q *Queries) ExportCoinTransfers(ctx context.Context, username string) ([]ExportCoinTransfersRow, error) {
+	rows, err := q.db.Query(ctx, exportCoinTransfers, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportCoinTransfersRow
+	for rows.Next() {
+		var i ExportCoinTransfersRow
+		if err := rows.Scan(
+			&i.FromUsername,
+			&i.ToUsername,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Team,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveAPIKey = `-- name: GetActiveAPIKey :one
+SELECT id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at
+FROM api_keys
+WHERE key_hash = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) GetActiveAPIKey(ctx context.Context, keyHash string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, getActiveAPIKey, keyHash)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceAccount,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getAuction = `-- name: GetAuction :one
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.id = $1
+`
+
+type GetAuctionRow struct {
+	ID           int32
+	Item         string
+	ReservePrice int32
+	EndsAt       pgtype.Timestamptz
+	CreatedBy    string
+	CreatedAt    pgtype.Timestamptz
+	ClosedAt     pgtype.Timestamptz
+	Winner       pgtype.Text
+	Bidder       pgtype.Text
+	Bid          pgtype.Int4
+}
+
+func (q *Queries) GetAuction(ctx context.Context, id int32) (GetAuctionRow, error) {
+	row := q.db.QueryRow(ctx, getAuction, id)
+	var i GetAuctionRow
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.ReservePrice,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ClosedAt,
+		&i.Winner,
+		&i.Bidder,
+		&i.Bid,
+	)
+	return i, err
+}
+
+const getAuditChainHead = `-- name: GetAuditChainHead :one
+SELECT chain_seq, hash
+FROM audit_log
+WHERE chain_seq IS NOT NULL
+ORDER BY chain_seq DESC
+LIMIT 1
+`
+
+type GetAuditChainHeadRow struct {
+	ChainSeq pgtype.Int8
+	Hash     pgtype.Text
+}
+
+func (q *Queries) GetAuditChainHead(ctx context.Context) (GetAuditChainHeadRow, error) {
+	row := q.db.QueryRow(ctx, getAuditChainHead)
+	var i GetAuditChainHeadRow
+	err := row.Scan(&i.ChainSeq, &i.Hash)
+	return i, err
+}
+
+const getCoinHistoryReceived = `-- name: GetCoinHistoryReceived :many
+SELECT from_username, amount, currency, created_at, ''::text AS team
+FROM coin_transfers
+WHERE to_username = $1
+UNION ALL
+SELECT actor, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND username = $1
+ORDER BY created_at
+`
+
+type GetCoinHistoryReceivedRow struct {
+	FromUsername string
+	Amount       int32
+	Currency     string
+	CreatedAt    pgtype.Timestamptz
+	Team         string
+}
+
+func (q *Queries) GetCoinHistoryReceived(ctx context.Context, toUsername string) ([]GetCoinHistoryReceivedRow, error) {
+	rows, err := q.db.Query(ctx, getCoinHistoryReceived, toUsername)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCoinHistoryReceivedRow
+	for rows.Next() {
+		var i GetCoinHistoryReceivedRow
+		if err := rows.Scan(
+			&i.FromUsername,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Team,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCoinHistorySent = `-- name: GetCoinHistorySent :many
+SELECT to_username, amount, currency, created_at, ''::text AS team
+FROM coin_transfers
+WHERE from_username = $1
+UNION ALL
+SELECT username, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND actor = $1
+ORDER BY created_at
+`
+
+type GetCoinHistorySentRow struct {
+	ToUsername string
+	Amount     int32
+	Currency   string
+	CreatedAt  pgtype.Timestamptz
+	Team       string
+}
+
+func (q *Queries) GetCoinHistorySent(ctx context.Context, fromUsername string) ([]GetCoinHistorySentRow, error) {
+	rows, err := q.db.Query(ctx, getCoinHistorySent, fromUsername)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCoinHistorySentRow
+	for rows.Next() {
+		var i GetCoinHistorySentRow
+		if err := rows.Scan(
+			&i.ToUsername,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Team,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCoinsSentSince = `-- name: GetCoinsSentSince :one
+SELECT (
+    (SELECT COALESCE(SUM(c.amount), 0)
+     FROM coin_transfers c
+     WHERE c.from_username = $1 AND c.currency = 'coins' AND c.created_at >= $2)
+  + (SELECT COALESCE(SUM(t.amount), 0)
+     FROM team_transactions t
+     WHERE t.kind = 'transfer' AND t.actor = $1 AND t.created_at >= $2)
+  + (SELECT COALESCE(SUM(gp.amount), 0)
+     FROM group_purchase_pledges gp
+     JOIN group_purchases g ON g.id = gp.group_purchase_id
+     WHERE gp.username = $1 AND g.status <> 'refunded' AND gp.created_at >= $2)
+)::bigint AS total
+`
+
+type GetCoinsSentSinceParams struct {
+	Username string
+	Since    pgtype.Timestamptz
+}
+
+func (q *Queries) GetCoinsSentSince(ctx context.Context, arg GetCoinsSentSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getCoinsSentSince, arg.Username, arg.Since)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getCurrency = `-- name: GetCurrency :one
+SELECT code, name, transferable, lifetime_days, created_at
+FROM currencies
+WHERE code = $1
+`
+
+func (q *Queries) GetCurrency(ctx context.Context, code string) (Currency, error) {
+	row := q.db.QueryRow(ctx, getCurrency, code)
+	var i Currency
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Transferable,
+		&i.LifetimeDays,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDefaultProductVariant = `-- name: GetDefaultProductVariant :one
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = $1 AND is_default
+`
+
+func (q *Queries) GetDefaultProductVariant(ctx context.Context, item string) (ProductVariant, error) {
+	row := q.db.QueryRow(ctx, getDefaultProductVariant, item)
+	var i ProductVariant
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.Sku,
+		&i.Size,
+		&i.Color,
+		&i.Stock,
+		&i.Price,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getGroupPurchase = `-- name: GetGroupPurchase :one
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.id = $1
+`
+
+type GetGroupPurchaseRow struct {
+	ID        int32
+	Item      string
+	VariantID pgtype.Int4
+	Sku       pgtype.Text
+	Recipient string
+	Price     int32
+	Deadline  pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt pgtype.Timestamptz
+	Status    string
+	ClosedAt  pgtype.Timestamptz
+	Pledged   int64
+}
+
+func (q *Queries) GetGroupPurchase(ctx context.Context, id int32) (GetGroupPurchaseRow, error) {
+	row := q.db.QueryRow(ctx, getGroupPurchase, id)
+	var i GetGroupPurchaseRow
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.VariantID,
+		&i.Sku,
+		&i.Recipient,
+		&i.Price,
+		&i.Deadline,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.Pledged,
+	)
+	return i, err
+}
+
+const getLoginAttempts = `-- name: GetLoginAttempts :one
+SELECT failures, last_failure_at
+FROM login_attempts
+WHERE key = $1
+  AND last_failure_at > now() - make_interval(secs => $2::int)
+`
+
+type GetLoginAttemptsParams struct {
+	Key           string
+	WindowSeconds int32
+}
+
+type GetLoginAttemptsRow struct {
+	Failures      int32
+	LastFailureAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetLoginAttempts(ctx context.Context, arg GetLoginAttemptsParams) (GetLoginAttemptsRow, error) {
+	row := q.db.QueryRow(ctx, getLoginAttempts, arg.Key, arg.WindowSeconds)
+	var i GetLoginAttemptsRow
+	err := row.Scan(&i.Failures, &i.LastFailureAt)
+	return i, err
+}
+
+const getProduct = `-- name: GetProduct :one
+SELECT item, price, currency, category, active
+FROM products
+WHERE item = $1
+`
+
+type GetProductRow struct {
+	Item     string
+	Price    int32
+	Currency string
+	Category pgtype.Text
+	Active   bool
+}
+
+func (q *Queries) GetProduct(ctx context.Context, item string) (GetProductRow, error) {
+	row := q.db.QueryRow(ctx, getProduct, item)
+	var i GetProductRow
+	err := row.Scan(
+		&i.Item,
+		&i.Price,
+		&i.Currency,
+		&i.Category,
+		&i.Active,
+	)
+	return i, err
+}
+
+const getProductPrice = `-- name: GetProductPrice :one
+SELECT price
+FROM products
+WHERE item = $1
+`
+
+func (q *Queries) GetProductPrice(ctx context.Context, item string) (int32, error) {
+	row := q.db.QueryRow(ctx, getProductPrice, item)
+	var price int32
+	err := row.Scan(&price)
+	return price, err
+}
+
+const getProductVariant = `-- name: GetProductVariant :one
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = $1 AND sku = $2
+`
+
+type GetProductVariantParams struct {
+	Item string
+	Sku  string
+}
+
+func (q *Queries) GetProductVariant(ctx context.Context, arg GetProductVariantParams) (ProductVariant, error) {
+	row := q.db.QueryRow(ctx, getProductVariant, arg.Item, arg.Sku)
+	var i ProductVariant
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.Sku,
+		&i.Size,
+		&i.Color,
+		&i.Stock,
+		&i.Price,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getPromoCodeForUpdate = `-- name: GetPromoCodeForUpdate :one
+SELECT code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at
+FROM promo_codes
+WHERE code = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetPromoCodeForUpdate(ctx context.Context, code string) (PromoCode, error) {
+	row := q.db.QueryRow(ctx, getPromoCodeForUpdate, code)
+	var i PromoCode
+	err := row.Scan(
+		&i.Code,
+		&i.Item,
+		&i.Category,
+		&i.PercentOff,
+		&i.AmountOff,
+		&i.MaxUses,
+		&i.Uses,
+		&i.PerUserLimit,
+		&i.StartsAt,
+		&i.EndsAt,
+	)
+	return i, err
+}
+
+const getPurchaseSpendSince = `-- name: GetPurchaseSpendSince :one
+SELECT (
+    (SELECT COALESCE(SUM(p.price), 0)
+     FROM purchases p
+     WHERE p.username = $1 AND p.currency = 'coins'
+       AND p.auction_id IS NULL AND p.group_purchase_id IS NULL
+       AND p.created_at >= $2)
+    + (SELECT COALESCE(SUM(b.amount), 0)
+       FROM auction_bids b
+       WHERE b.username = $1 AND b.released_at IS NULL AND b.created_at >= $2)
+)::bigint AS total
+`
+
+type GetPurchaseSpendSinceParams struct {
+	Username string
+	Since    pgtype.Timestamptz
+}
+
+func (q *Queries) GetPurchaseSpendSince(ctx context.Context, arg GetPurchaseSpendSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getPurchaseSpendSince, arg.Username, arg.Since)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getRateLimitBucket = `-- name: GetRateLimitBucket :one
+SELECT tokens, updated_at
+FROM rate_limit_buckets
+WHERE key = $1
+`
+
+type GetRateLimitBucketRow struct {
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetRateLimitBucket(ctx context.Context, key string) (GetRateLimitBucketRow, error) {
+	row := q.db.QueryRow(ctx, getRateLimitBucket, key)
+	var i GetRateLimitBucketRow
+	err := row.Scan(&i.Tokens, &i.UpdatedAt)
+	return i, err
+}
+
+const getTeam = `-- name: GetTeam :one
+SELECT name, description, coins, created_by, created_at
+FROM teams
+WHERE name = $1
+`
+
+func (q *Queries) GetTeam(ctx context.Context, name string) (Team, error) {
+	row := q.db.QueryRow(ctx, getTeam, name)
+	var i Team
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Coins,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTeamMember = `-- name: GetTeamMember :one
+SELECT team, username, role, joined_at
+FROM team_members
+WHERE team = $1 AND username = $2
+`
+
+type GetTeamMemberParams struct {
+	Team     string
+	Username string
+}
+
+func (q *Queries) GetTeamMember(ctx context.Context, arg GetTeamMemberParams) (TeamMember, error) {
+	row := q.db.QueryRow(ctx, getTeamMember, arg.Team, arg.Username)
+	var i TeamMember
+	err := row.Scan(
+		&i.Team,
+		&i.Username,
+		&i.Role,
+		&i.JoinedAt,
+	)
+	return i, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT username, password_hash, coins, role, display_name, email, department, avatar_url, deactivated_at, token_version
+FROM users
+WHERE username = $1
+`
+
+type GetUserRow struct {
+	Username      string
+	PasswordHash  string
+	Coins         int32
+	Role          string
+	DisplayName   string
+	Email         string
+	Department    string
+	AvatarUrl     string
+	DeactivatedAt pgtype.Timestamptz
+	TokenVersion  int32
+}
+
+func (q *Queries) GetUser(ctx context.Context, username string) (GetUserRow, error) {
+	row := q.db.QueryRow(ctx, getUser, username)
+	var i GetUserRow
+	err := row.Scan(
+		&i.Username,
+		&i.PasswordHash,
+		&i.Coins,
+		&i.Role,
+		&i.DisplayName,
+		&i.Email,
+		&i.Department,
+		&i.AvatarUrl,
+		&i.DeactivatedAt,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserByUsername = `-- name: GetUserByUsername :one
+SELECT username, password_hash, coins
+FROM users
+WHERE username = $1
+`
+
+type GetUserByUsernameRow struct {
+	Username     string
+	PasswordHash string
+	Coins        int32
+}
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
+	row := q.db.QueryRow(ctx, getUserByUsername, username)
+	var i GetUserByUsernameRow
+	err := row.Scan(&i.Username, &i.PasswordHash, &i.Coins)
+	return i, err
+}
+
+const getUserMFA = `-- name: GetUserMFA :one
+SELECT username, secret, confirmed_at, last_used_step, created_at
+FROM user_mfa
+WHERE username = $1
+`
+
+func (q *Queries) GetUserMFA(ctx context.Context, username string) (UserMfa, error) {
+	row := q.db.QueryRow(ctx, getUserMFA, username)
+	var i UserMfa
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserSpendingLimits = `-- name: GetUserSpendingLimits :many
+SELECT l.scope, l.daily_transfer, l.max_transfer, l.monthly_purchase
+FROM spending_limits l
+JOIN users u
+  ON (l.scope = 'user' AND l.subject = u.username)
+  OR (l.scope = 'role' AND l.subject = u.role)
+WHERE u.username = $1
+`
+
+type GetUserSpendingLimitsRow struct {
+	Scope           string
+	DailyTransfer   pgtype.Int4
+	MaxTransfer     pgtype.Int4
+	MonthlyPurchase pgtype.Int4
+}
+
+func (q *Queries) GetUserSpendingLimits(ctx context.Context, username string) ([]GetUserSpendingLimitsRow, error) {
+	rows, err := q.db.Query(ctx, getUserSpendingLimits, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserSpendingLimitsRow
+	for rows.Next() {
+		var i GetUserSpendingLimitsRow
+		if err := rows.Scan(
+			&i.Scope,
+			&i.DailyTransfer,
+			&i.MaxTransfer,
+			&i.MonthlyPurchase,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserStats = `-- name: GetUserStats :one
+SELECT
+    (SELECT COALESCE(SUM(amount), 0)
+     FROM coin_transfers t
+     WHERE t.to_username = $1 AND t.currency = 'coins'
+       AND ($2::timestamptz IS NULL OR t.created_at >= $2::timestamptz)
+       AND ($3::timestamptz IS NULL OR t.created_at < $3::timestamptz))::bigint AS total_received,
+    (SELECT COALESCE(SUM(amount), 0)
+     FROM coin_transfers t
+     WHERE t.from_username = $1 AND t.currency = 'coins'
+       AND ($2::timestamptz IS NULL OR t.created_at >= $2::timestamptz)
+       AND ($3::timestamptz IS NULL OR t.created_at < $3::timestamptz))::bigint AS total_sent,
+    (SELECT COALESCE(SUM(price), 0)
+     FROM purchases p
+     WHERE p.username = $1 AND p.currency = 'coins' AND p.group_purchase_id IS NULL
+       AND ($2::timestamptz IS NULL OR p.created_at >= $2::timestamptz)
+       AND ($3::timestamptz IS NULL OR p.created_at < $3::timestamptz))::bigint AS total_spent,
+    (SELECT COUNT(DISTINCT to_username)
+     FROM coin_transfers t
+     WHERE t.from_username = $1
+       AND ($2::timestamptz IS NULL OR t.created_at >= $2::timestamptz)
+       AND ($3::timestamptz IS NULL OR t.created_at < $3::timestamptz)) AS colleagues_thanked
+`
+
+type GetUserStatsParams struct {
+	Username string
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+}
+
+type GetUserStatsRow struct {
+	TotalReceived     int64
+	TotalSent         int64
+	TotalSpent        int64
+	ColleaguesThanked int64
+}
+
+func (q *Queries) GetUserStats(ctx context.Context, arg GetUserStatsParams) (GetUserStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserStats, arg.Username, arg.Since, arg.Until)
+	var i GetUserStatsRow
+	err := row.Scan(
+		&i.TotalReceived,
+		&i.TotalSent,
+		&i.TotalSpent,
+		&i.ColleaguesThanked,
+	)
+	return i, err
+}
+
+const incrementPromoCodeUses = `-- name: IncrementPromoCodeUses :execrows
+UPDATE promo_codes
+SET uses = uses + 1
+WHERE code = $1 AND (max_uses IS NULL OR uses < max_uses)
+`
+
+func (q *Queries) IncrementPromoCodeUses(ctx context.Context, code string) (int64, error) {
+	result, err := q.db.Exec(ctx, incrementPromoCodeUses, code)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertAuctionBid = `-- name: InsertAuctionBid :exec
+INSERT INTO auction_bids (auction_id, username, amount)
+VALUES ($1, $2, $3)
+`
+
+type InsertAuctionBidParams struct {
+	AuctionID int32
+	Username  string
+	Amount    int32
+}
+
+func (q *Queries) InsertAuctionBid(ctx context.Context, arg InsertAuctionBidParams) error {
+	_, err := q.db.Exec(ctx, insertAuctionBid, arg.AuctionID, arg.Username, arg.Amount)
+	return err
+}
+
+const insertBalanceAdjustment = `-- name: InsertBalanceAdjustment :exec
+INSERT INTO balance_adjustments (username, currency, amount, reason, adjusted_by)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertBalanceAdjustmentParams struct {
+	Username   string
+	Currency   string
+	Amount     int32
+	Reason     string
+	AdjustedBy string
+}
+
+func (q *Queries) InsertBalanceAdjustment(ctx context.Context, arg InsertBalanceAdjustmentParams) error {
+	_, err := q.db.Exec(ctx, insertBalanceAdjustment,
+		arg.Username,
+		arg.Currency,
+		arg.Amount,
+		arg.Reason,
+		arg.AdjustedBy,
+	)
+	return err
+}
+
+const insertBalanceLot = `-- name: InsertBalanceLot :exec
+INSERT INTO balance_lots (username, currency, amount, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertBalanceLotParams struct {
+	Username  string
+	Currency  string
+	Amount    int32
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertBalanceLot(ctx context.Context, arg InsertBalanceLotParams) error {
+	_, err := q.db.Exec(ctx, insertBalanceLot,
+		arg.Username,
+		arg.Currency,
+		arg.Amount,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertCoinGrant = `-- name: InsertCoinGrant :exec
+INSERT INTO coin_grants (to_username, amount, reason, granted_by, currency)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertCoinGrantParams struct {
+	ToUsername string
+	Amount     int32
+	Reason     string
+	GrantedBy  string
+	Currency   string
+}
+
+func (q *Queries) InsertCoinGrant(ctx context.Context, arg InsertCoinGrantParams) error {
+	_, err := q.db.Exec(ctx, insertCoinGrant,
+		arg.ToUsername,
+		arg.Amount,
+		arg.Reason,
+		arg.GrantedBy,
+		arg.Currency,
+	)
+	return err
+}
+
+const insertCoinTransfer = `-- name: InsertCoinTransfer :exec
+INSERT INTO coin_transfers (from_username, to_username, amount, currency)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertCoinTransferParams struct {
+	FromUsername string
+	ToUsername   string
+	Amount       int32
+	Currency     string
+}
+
+func (q *Queries) InsertCoinTransfer(ctx context.Context, arg InsertCoinTransferParams) error {
+	_, err := q.db.Exec(ctx, insertCoinTransfer,
+		arg.FromUsername,
+		arg.ToUsername,
+		arg.Amount,
+		arg.Currency,
+	)
+	return err
+}
+
+const insertErasedUser = `-- name: InsertErasedUser :exec
+INSERT INTO users (username, password_hash, coins, deactivated_at)
+VALUES ($1, '', 0, now())
+`
+
+func (q *Queries) InsertErasedUser(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, insertErasedUser, username)
+	return err
+}
+
+const insertErasedUsername = `-- name: InsertErasedUsername :exec
+INSERT INTO erased_usernames (username_hash)
+VALUES ($1)
+ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) InsertErasedUsername(ctx context.Context, usernameHash string) error {
+	_, err := q.db.Exec(ctx, insertErasedUsername, usernameHash)
+	return err
+}
+
+const insertGroupPurchasePledge = `-- name: InsertGroupPurchasePledge :exec
+INSERT INTO group_purchase_pledges (group_purchase_id, username, amount)
+VALUES ($1, $2, $3)
+`
+
+type InsertGroupPurchasePledgeParams struct {
+	GroupPurchaseID int32
+	Username        string
+	Amount          int32
+}
+
+func (q *Queries) InsertGroupPurchasePledge(ctx context.Context, arg InsertGroupPurchasePledgeParams) error {
+	_, err := q.db.Exec(ctx, insertGroupPurchasePledge, arg.GroupPurchaseID, arg.Username, arg.Amount)
+	return err
+}
+
+const insertRecoveryCodes = `-- name: InsertRecoveryCodes :exec
+INSERT INTO mfa_recovery_codes (username, code_hash)
+SELECT $1::text, unnest($2::text[])
+`
+
+type InsertRecoveryCodesParams struct {
+	Username   string
+	CodeHashes []string
+}
+
+func (q *Queries) InsertRecoveryCodes(ctx context.Context, arg InsertRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, insertRecoveryCodes, arg.Username, arg.CodeHashes)
+	return err
+}
+
+const insertTeamTransaction = `-- name: InsertTeamTransaction :exec
+INSERT INTO team_transactions (team, kind, username, amount, actor, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertTeamTransactionParams struct {
+	Team     string
+	Kind     string
+	Username pgtype.Text
+	Amount   int32
+	Actor    string
+	Reason   string
+}
+
+func (q *Queries) InsertTeamTransaction(ctx context.Context, arg InsertTeamTransactionParams) error {
+	_, err := q.db.Exec(ctx, insertTeamTransaction,
+		arg.Team,
+		arg.Kind,
+		arg.Username,
+		arg.Amount,
+		arg.Actor,
+		arg.Reason,
+	)
+	return err
+}
+
+const isUsernameErased = `-- name: IsUsernameErased :one
+SELECT EXISTS (SELECT 1 FROM erased_usernames WHERE username_hash = $1)
+`
+
+func (q *Queries) IsUsernameErased(ctx context.Context, usernameHash string) (bool, error) {
+	row := q.db.QueryRow(ctx, isUsernameErased, usernameHash)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const leaseWebhookDeliveries = `-- name: LeaseWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => $1::int)
+FROM webhook_subscriptions s, outbox_events e
+WHERE s.id = d.subscription_id
+  AND e.id = d.event_id
+  AND d.id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.attempts, s.url, s.secret, e.id AS event_id, e.event_type, e.payload, e.created_at
+`
+
+type LeaseWebhookDeliveriesParams struct {
+	LeaseSeconds int32
+	BatchSize    int32
+}
+
+type LeaseWebhookDeliveriesRow struct {
+	ID        int64
+	Attempts  int32
+	Url       string
+	Secret    string
+	EventID   int64
+	EventType string
+	Payload   []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) LeaseWebhookDeliveries(ctx context.Context, arg LeaseWebhookDeliveriesParams) ([]LeaseWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, leaseWebhookDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LeaseWebhookDeliveriesRow
+	for rows.Next() {
+		var i LeaseWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAPIKeys = `-- name: ListAPIKeys :many
+SELECT id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at
+FROM api_keys
+WHERE service_account = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAPIKeys(ctx context.Context, serviceAccount string) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listAPIKeys, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServiceAccount,
+			&i.Prefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveSales = `-- name: ListActiveSales :many
+SELECT s.id, s.name, s.item, s.category, s.percent_off, s.amount_off, s.starts_at, s.ends_at
+FROM sales s
+WHERE s.starts_at <= now() AND s.ends_at > now()
+  AND (s.item = $1 OR s.category = (SELECT p.category FROM products p WHERE p.item = $1))
+`
+
+func (q *Queries) ListActiveSales(ctx context.Context, item pgtype.Text) ([]Sale, error) {
+	rows, err := q.db.Query(ctx, listActiveSales, item)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Sale
+	for rows.Next() {
+		var i Sale
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Item,
+			&i.Category,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.StartsAt,
+			&i.EndsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuctionBids = `-- name: ListAuctionBids :many
+SELECT id, auction_id, username, amount, created_at, released_at
+FROM auction_bids
+WHERE auction_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAuctionBids(ctx context.Context, auctionID int32) ([]AuctionBid, error) {
+	rows, err := q.db.Query(ctx, listAuctionBids, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuctionBid
+	for rows.Next() {
+		var i AuctionBid
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuctionID,
+			&i.Username,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ReleasedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditLog = `-- name: ListAuditLog :many
+SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after, prev_hash, hash
+FROM audit_log
+WHERE ($1::text IS NULL OR actor = $1::text)
+  AND ($2::text IS NULL OR action = $2::text)
+  AND ($3::text IS NULL OR target = $3::text)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::bigint IS NULL OR seq < $6::bigint)
+ORDER BY seq DESC
+LIMIT $7
+`
+
+type ListAuditLogParams struct {
+	Actor     pgtype.Text
+	Action    pgtype.Text
+	Target    pgtype.Text
+	Since     pgtype.Timestamptz
+	Until     pgtype.Timestamptz
+	BeforeSeq pgtype.Int8
+	RowLimit  int32
+}
+
+type ListAuditLogRow struct {
+	Seq       int64
+	CreatedAt pgtype.Timestamptz
+	Actor     string
+	Action    string
+	Target    string
+	Ip        string
+	UserAgent string
+	RequestID string
+	Before    []byte
+	After     []byte
+	PrevHash  pgtype.Text
+	Hash      pgtype.Text
+}
+
+func (q *Queries) ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]ListAuditLogRow, error) {
+	rows, err := q.db.Query(ctx, listAuditLog,
+		arg.Actor,
+		arg.Action,
+		arg.Target,
+		arg.Since,
+		arg.Until,
+		arg.BeforeSeq,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditLogRow
+	for rows.Next() {
+		var i ListAuditLogRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.Target,
+			&i.Ip,
+			&i.UserAgent,
+			&i.RequestID,
+			&i.Before,
+			&i.After,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogProducts = `-- name: ListCatalogProducts :many
+SELECT item, price, category, name_ru, name_en, description_ru, description_en, active, currency
+FROM products
+WHERE active
+  AND ($1::text IS NULL OR category = $1::text)
+  AND ($2::int IS NULL OR price >= $2::int)
+  AND ($3::int IS NULL OR price <= $3::int)
+ORDER BY
+  CASE WHEN $4::text = 'price' THEN price END ASC,
+  CASE WHEN $4::text = '-price' THEN price END DESC,
+  CASE WHEN $4::text = 'name' AND $5::text = 'en' THEN name_en END ASC,
+  CASE WHEN $4::text = 'name' AND $5::text <> 'en' THEN name_ru END ASC,
+  item
+`
+
+type ListCatalogProductsParams struct {
+	Category pgtype.Text
+	MinPrice pgtype.Int4
+	MaxPrice pgtype.Int4
+	Sort     string
+	Lang     string
+}
+
+func (q *Queries) ListCatalogProducts(ctx context.Context, arg ListCatalogProductsParams) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listCatalogProducts,
+		arg.Category,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Sort,
+		arg.Lang,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Product
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.Item,
+			&i.Price,
+			&i.Category,
+			&i.NameRu,
+			&i.NameEn,
+			&i.DescriptionRu,
+			&i.DescriptionEn,
+			&i.Active,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategories = `-- name: ListCategories :many
+SELECT slug, name_ru, name_en
+FROM categories
+ORDER BY slug
+`
+
+func (q *Queries) ListCategories(ctx context.Context) ([]Category, error) {
+	rows, err := q.db.Query(ctx, listCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(&i.Slug, &i.NameRu, &i.NameEn); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrencies = `-- name: ListCurrencies :many
+SELECT code, name, transferable, lifetime_days, created_at
+FROM currencies
+ORDER BY code
+`
+
+func (q *Queries) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	rows, err := q.db.Query(ctx, listCurrencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Currency
+	for rows.Next() {
+		var i Currency
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.Transferable,
+			&i.LifetimeDays,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueAuctions = `-- name: ListDueAuctions :many
+SELECT id
+FROM auctions
+WHERE closed_at IS NULL AND ends_at <= now()
+ORDER BY ends_at, id
+LIMIT $1
+`
+
+func (q *Queries) ListDueAuctions(ctx context.Context, limit int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listDueAuctions, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredGroupPurchases = `-- name: ListExpiredGroupPurchases :many
+SELECT id
+FROM group_purchases
+WHERE status = 'open' AND deadline <= now()
+ORDER BY deadline, id
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredGroupPurchases(ctx context.Context, limit int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listExpiredGroupPurchases, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGroupPurchasePledges = `-- name: ListGroupPurchasePledges :many
+SELECT id, group_purchase_id, username, amount, created_at
+FROM group_purchase_pledges
+WHERE group_purchase_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListGroupPurchasePledges(ctx context.Context, groupPurchaseID int32) ([]GroupPurchasePledge, error) {
+	rows, err := q.db.Query(ctx, listGroupPurchasePledges, groupPurchaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GroupPurchasePledge
+	for rows.Next() {
+		var i GroupPurchasePledge
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupPurchaseID,
+			&i.Username,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInventory = `-- name: ListInventory :many
+SELECT p.item, v.sku, COUNT(*) AS quantity
+FROM purchases p
+LEFT JOIN product_variants v ON v.id = p.variant_id
+WHERE p.username = $1
+GROUP BY p.item, v.sku
+`
+
+type ListInventoryRow struct {
+	Item     string
+	Sku      pgtype.Text
+	Quantity int64
+}
+
+func (q *Queries) ListInventory(ctx context.Context, username string) ([]ListInventoryRow, error) {
+	rows, err := q.db.Query(ctx, listInventory, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInventoryRow
+	for rows.Next() {
+		var i ListInventoryRow
+		if err := rows.Scan(&i.Item, &i.Sku, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, username, kind, payload, created_at, read_at
+FROM notifications
+WHERE username = $1
+  AND (NOT $2::boolean OR read_at IS NULL)
+  AND ($3::bigint IS NULL OR id < $3::bigint)
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListNotificationsParams struct {
+	Username   string
+	UnreadOnly bool
+	BeforeID   pgtype.Int8
+	RowLimit   int32
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
+		arg.Username,
+		arg.UnreadOnly,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Kind,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenAuctions = `-- name: ListOpenAuctions :many
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.closed_at IS NULL
+ORDER BY a.ends_at, a.id
+`
+
+type ListOpenAuctionsRow struct {
+	ID           int32
+	Item         string
+	ReservePrice int32
+	EndsAt       pgtype.Timestamptz
+	CreatedBy    string
+	CreatedAt    pgtype.Timestamptz
+	ClosedAt     pgtype.Timestamptz
+	Winner       pgtype.Text
+	Bidder       pgtype.Text
+	Bid          pgtype.Int4
+}
+
+func (q *Queries) ListOpenAuctions(ctx context.Context) ([]ListOpenAuctionsRow, error) {
+	rows, err := q.db.Query(ctx, listOpenAuctions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenAuctionsRow
+	for rows.Next() {
+		var i ListOpenAuctionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Item,
+			&i.ReservePrice,
+			&i.EndsAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ClosedAt,
+			&i.Winner,
+			&i.Bidder,
+			&i.Bid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenGroupPurchases = `-- name: ListOpenGroupPurchases :many
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.status = 'open'
+ORDER BY g.deadline, g.id
+`
+
+type ListOpenGroupPurchasesRow struct {
+	ID        int32
+	Item      string
+	VariantID pgtype.Int4
+	Sku       pgtype.Text
+	Recipient string
+	Price     int32
+	Deadline  pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt pgtype.Timestamptz
+	Status    string
+	ClosedAt  pgtype.Timestamptz
+	Pledged   int64
+}
+
+func (q *Queries) ListOpenGroupPurchases(ctx context.Context) ([]ListOpenGroupPurchasesRow, error) {
+	rows, err := q.db.Query(ctx, listOpenGroupPurchases)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenGroupPurchasesRow
+	for rows.Next() {
+		var i ListOpenGroupPurchasesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Item,
+			&i.VariantID,
+			&i.Sku,
+			&i.Recipient,
+			&i.Price,
+			&i.Deadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.Pledged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductImagesByItems = `-- name: ListProductImagesByItems :many
+SELECT item, url
+FROM product_images
+WHERE item = ANY($1::text[])
+ORDER BY item, position, id
+`
+
+type ListProductImagesByItemsRow struct {
+	Item string
+	Url  string
+}
+
+func (q *Queries) ListProductImagesByItems(ctx context.Context, itemNames []string) ([]ListProductImagesByItemsRow, error) {
+	rows, err := q.db.Query(ctx, listProductImagesByItems, itemNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductImagesByItemsRow
+	for rows.Next() {
+		var i ListProductImagesByItemsRow
+		if err := rows.Scan(&i.Item, &i.Url); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductVariantsByItems = `-- name: ListProductVariantsByItems :many
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = ANY($1::text[])
+ORDER BY item, sku
+`
+
+func (q *Queries) ListProductVariantsByItems(ctx context.Context, itemNames []string) ([]ProductVariant, error) {
+	rows, err := q.db.Query(ctx, listProductVariantsByItems, itemNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProductVariant
+	for rows.Next() {
+		var i ProductVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Item,
+			&i.Sku,
+			&i.Size,
+			&i.Color,
+			&i.Stock,
+			&i.Price,
+			&i.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromoCodes = `-- name: ListPromoCodes :many
+SELECT code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at
+FROM promo_codes
+ORDER BY code
+`
+
+func (q *Queries) ListPromoCodes(ctx context.Context) ([]PromoCode, error) {
+	rows, err := q.db.Query(ctx, listPromoCodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PromoCode
+	for rows.Next() {
+		var i PromoCode
+		if err := rows.Scan(
+			&i.Code,
+			&i.Item,
+			&i.Category,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.MaxUses,
+			&i.Uses,
+			&i.PerUserLimit,
+			&i.StartsAt,
+			&i.EndsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPurchases = `-- name: ListPurchases :many
+SELECT p.item, v.sku, p.price, p.currency, p.created_at, p.promo_code, s.name AS sale
+FROM purchases p
+LEFT JOIN product_variants v ON v.id = p.variant_id
+LEFT JOIN sales s ON s.id = p.sale_id
+WHERE p.username = $1
+ORDER BY p.created_at
+`
+
+type ListPurchasesRow struct {
+	Item      string
+	Sku       pgtype.Text
+	Price     int32
+	Currency  string
+	CreatedAt pgtype.Timestamptz
+	PromoCode pgtype.Text
+	Sale      pgtype.Text
+}
+
+func (q *Queries) ListPurchases(ctx context.Context, username string) ([]ListPurchasesRow, error) {
+	rows, err := q.db.Query(ctx, listPurchases, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPurchasesRow
+	for rows.Next() {
+		var i ListPurchasesRow
+		if err := rows.Scan(
+			&i.Item,
+			&i.Sku,
+			&i.Price,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.PromoCode,
+			&i.Sale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSales = `-- name: ListSales :many
+SELECT id, name, item, category, percent_off, amount_off, starts_at, ends_at
+FROM sales
+ORDER BY starts_at DESC, id DESC
+`
+
+func (q *Queries) ListSales(ctx context.Context) ([]Sale, error) {
+	rows, err := q.db.Query(ctx, listSales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Sale
+	for rows.Next() {
+		var i Sale
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Item,
+			&i.Category,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.StartsAt,
+			&i.EndsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServiceAccounts = `-- name: ListServiceAccounts :many
+SELECT name, description, created_by, created_at
+FROM service_accounts
+ORDER BY name
+`
+
+func (q *Queries) ListServiceAccounts(ctx context.Context) ([]ServiceAccount, error) {
+	rows, err := q.db.Query(ctx, listServiceAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceAccount
+	for rows.Next() {
+		var i ServiceAccount
+		if err := rows.Scan(
+			&i.Name,
+			&i.Description,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSpendingLimits = `-- name: ListSpendingLimits :many
+SELECT scope, subject, daily_transfer, max_transfer, monthly_purchase, updated_at
+FROM spending_limits
+ORDER BY scope, subject
+`
+
+func (q *Queries) ListSpendingLimits(ctx context.Context) ([]SpendingLimit, error) {
+	rows, err := q.db.Query(ctx, listSpendingLimits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingLimit
+	for rows.Next() {
+		var i SpendingLimit
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Subject,
+			&i.DailyTransfer,
+			&i.MaxTransfer,
+			&i.MonthlyPurchase,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMembers = `-- name: ListTeamMembers :many
+SELECT team, username, role, joined_at
+FROM team_members
+WHERE team = $1
+ORDER BY username
+`
+
+func (q *Queries) ListTeamMembers(ctx context.Context, team string) ([]TeamMember, error) {
+	rows, err := q.db.Query(ctx, listTeamMembers, team)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamMember
+	for rows.Next() {
+		var i TeamMember
+		if err := rows.Scan(
+			&i.Team,
+			&i.Username,
+			&i.Role,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamTransactions = `-- name: ListTeamTransactions :many
+SELECT id, team, kind, username, amount, actor, reason, created_at
+FROM team_transactions
+WHERE team = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListTeamTransactionsParams struct {
+	Team  string
+	Limit int32
+}
+
+func (q *Queries) ListTeamTransactions(ctx context.Context, arg ListTeamTransactionsParams) ([]TeamTransaction, error) {
+	rows, err := q.db.Query(ctx, listTeamTransactions, arg.Team, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamTransaction
+	for rows.Next() {
+		var i TeamTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Kind,
+			&i.Username,
+			&i.Amount,
+			&i.Actor,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeams = `-- name: ListTeams :many
+SELECT name, description, coins, created_by, created_at
+FROM teams
+ORDER BY name
+`
+
+func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
+	rows, err := q.db.Query(ctx, listTeams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.Name,
+			&i.Description,
+			&i.Coins,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnchainedAuditEntries = `-- name: ListUnchainedAuditEntries :many
+SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after
+FROM audit_log
+WHERE hash IS NULL
+ORDER BY seq
+LIMIT $1
+`
+
+type ListUnchainedAuditEntriesRow struct {
+	Seq       int64
+	CreatedAt pgtype.Timestamptz
+	Actor     string
+	Action    string
+	Target    string
+	Ip        string
+	UserAgent string
+	RequestID string
+	Before    []byte
+	After     []byte
+}
+
+func (q *Queries) ListUnchainedAuditEntries(ctx context.Context, rowLimit int32) ([]ListUnchainedAuditEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listUnchainedAuditEntries, rowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnchainedAuditEntriesRow
+	for rows.Next() {
+		var i ListUnchainedAuditEntriesRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.Target,
+			&i.Ip,
+			&i.UserAgent,
+			&i.RequestID,
+			&i.Before,
+			&i.After,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserBalances = `-- name: ListUserBalances :many
+SELECT currency, SUM(amount)::bigint AS amount, MIN(expires_at)::timestamptz AS next_expires_at
+FROM balance_lots
+WHERE username = $1 AND amount > 0
+  AND (expires_at IS NULL OR expires_at > now())
+GROUP BY currency
+ORDER BY currency
+`
+
+type ListUserBalancesRow struct {
+	Currency      string
+	Amount        int64
+	NextExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListUserBalances(ctx context.Context, username string) ([]ListUserBalancesRow, error) {
+	rows, err := q.db.Query(ctx, listUserBalances, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserBalancesRow
+	for rows.Next() {
+		var i ListUserBalancesRow
+		if err := rows.Scan(&i.Currency, &i.Amount, &i.NextExpiresAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.event_id, e.event_type, d.status, d.attempts, d.next_attempt_at,
+       d.last_status_code, d.last_error, d.delivered_at, d.created_at
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = $1
+  AND ($2::text IS NULL OR d.status = $2::text)
+ORDER BY d.id DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID int32
+	Status         pgtype.Text
+	RowLimit       int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID             int64
+	EventID        int64
+	EventType      string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.SubscriptionID, arg.Status, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.EventType,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, active, created_by, created_at
+FROM webhook_subscriptions
+ORDER BY id
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Active,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWishlist = `-- name: ListWishlist :many
+SELECT w.item, product_effective_price(w.item)::integer AS price, p.currency, w.created_at
+FROM wishlist_items w
+JOIN products p ON p.item = w.item
+WHERE w.username = $1
+ORDER BY w.created_at
+`
+
+type ListWishlistRow struct {
+	Item      string
+	Price     int32
+	Currency  string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListWishlist(ctx context.Context, username string) ([]ListWishlistRow, error) {
+	rows, err := q.db.Query(ctx, listWishlist, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWishlistRow
+	for rows.Next() {
+		var i ListWishlistRow
+		if err := rows.Scan(
+			&i.Item,
+			&i.Price,
+			&i.Currency,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAuction = `-- name: LockAuction :one
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.id = $1
+FOR UPDATE OF a
+`
+
+type LockAuctionRow struct {
+	ID           int32
+	Item         string
+	ReservePrice int32
+	EndsAt       pgtype.Timestamptz
+	CreatedBy    string
+	CreatedAt    pgtype.Timestamptz
+	ClosedAt     pgtype.Timestamptz
+	Winner       pgtype.Text
+	Bidder       pgtype.Text
+	Bid          pgtype.Int4
+}
+
+func (q *Queries) LockAuction(ctx context.Context, id int32) (LockAuctionRow, error) {
+	row := q.db.QueryRow(ctx, lockAuction, id)
+	var i LockAuctionRow
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.ReservePrice,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ClosedAt,
+		&i.Winner,
+		&i.Bidder,
+		&i.Bid,
+	)
+	return i, err
+}
+
+const lockBalanceLots = `-- name: LockBalanceLots :many
+SELECT id, amount, expires_at
+FROM balance_lots
+WHERE username = $1 AND currency = $2 AND amount > 0
+  AND (expires_at IS NULL OR expires_at > now())
+ORDER BY expires_at NULLS LAST, id
+FOR UPDATE
+`
+
+type LockBalanceLotsParams struct {
+	Username string
+	Currency string
+}
+
+type LockBalanceLotsRow struct {
+	ID        int64
+	Amount    int32
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) LockBalanceLots(ctx context.Context, arg LockBalanceLotsParams) ([]LockBalanceLotsRow, error) {
+	rows, err := q.db.Query(ctx, lockBalanceLots, arg.Username, arg.Currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LockBalanceLotsRow
+	for rows.Next() {
+		var i LockBalanceLotsRow
+		if err := rows.Scan(&i.ID, &i.Amount, &i.ExpiresAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockGroupPurchase = `-- name: LockGroupPurchase :one
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.id = $1
+FOR UPDATE OF g
+`
+
+type LockGroupPurchaseRow struct {
+	ID        int32
+	Item      string
+	VariantID pgtype.Int4
+	Sku       pgtype.Text
+	Recipient string
+	Price     int32
+	Deadline  pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt pgtype.Timestamptz
+	Status    string
+	ClosedAt  pgtype.Timestamptz
+	Pledged   int64
+}
+
+func (q *Queries) LockGroupPurchase(ctx context.Context, id int32) (LockGroupPurchaseRow, error) {
+	row := q.db.QueryRow(ctx, lockGroupPurchase, id)
+	var i LockGroupPurchaseRow
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.VariantID,
+		&i.Sku,
+		&i.Recipient,
+		&i.Price,
+		&i.Deadline,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.Pledged,
+	)
+	return i, err
+}
+
+const lockLoginAttempts = `-- name: LockLoginAttempts :exec
+SELECT pg_advisory_xact_lock(hashtext('login_attempts:' || $1::text))
+`
+
+func (q *Queries) LockLoginAttempts(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, lockLoginAttempts, key)
+	return err
+}
+
+const lockRateLimitBucket = `-- name: LockRateLimitBucket :exec
+SELECT pg_advisory_xact_lock(hashtext('rate_limit:' || $1::text))
+`
+
+func (q *Queries) LockRateLimitBucket(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, lockRateLimitBucket, key)
+	return err
+}
+
+const lockUsers = `-- name: LockUsers :execrows
+SELECT username
+FROM users
+WHERE username = ANY($1::text[])
+ORDER BY username
+FOR UPDATE
+`
+
+func (q *Queries) LockUsers(ctx context.Context, usernames []string) (int64, error) {
+	result, err := q.db.Exec(ctx, lockUsers, usernames)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE username = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, markAllNotificationsRead, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE username = $1 AND id = ANY($2::bigint[])
+`
+
+type MarkNotificationsReadParams struct {
+	Username string
+	Ids      []int64
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationsRead, arg.Username, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markOutboxEventsRelayed = `-- name: MarkOutboxEventsRelayed :exec
+UPDATE outbox_events
+SET relayed_at = now()
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) MarkOutboxEventsRelayed(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, markOutboxEventsRelayed, ids)
+	return err
+}
+
+const notifyBalance = `-- name: NotifyBalance :exec
+SELECT pg_notify('merch_events', json_build_object(
+    'username', username,
+    'type', 'balance',
+    'data', json_build_object('coins', coins)
+)::text)
+FROM users
+WHERE username = $1
+`
+
+func (q *Queries) NotifyBalance(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, notifyBalance, username)
+	return err
+}
+
+const notifyEvent = `-- name: NotifyEvent :exec
+SELECT pg_notify('merch_events', $1::text)
+`
+
+func (q *Queries) NotifyEvent(ctx context.Context, payload string) error {
+	_, err := q.db.Exec(ctx, notifyEvent, payload)
+	return err
+}
+
+const notifyWishlistPriceDrops = `-- name: NotifyWishlistPriceDrops :execrows
+WITH prices AS (
+    SELECT item, product_effective_price(item) AS price
+    FROM (SELECT DISTINCT item FROM wishlist_items) i
+), stale AS (
+    SELECT w.username, w.item, w.notified_price AS old_price, p.price AS new_price
+    FROM wishlist_items w
+    JOIN prices p ON p.item = w.item
+    WHERE w.notified_price <> p.price
+), changed AS (
+    UPDATE wishlist_items w
+    SET notified_price = s.new_price
+    FROM stale s
+    WHERE w.username = s.username AND w.item = s.item AND w.notified_price = s.old_price
+    RETURNING s.username, s.item, s.old_price, s.new_price
+)
+INSERT INTO notifications (username, kind, payload)
+SELECT username, 'wishlist_price_drop', jsonb_build_object('item', item, 'oldPrice', old_price, 'newPrice', new_price)
+FROM changed
+WHERE new_price < old_price
+`
+
+func (q *Queries) NotifyWishlistPriceDrops(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, notifyWishlistPriceDrops)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const pruneOutboxEvents = `-- name: PruneOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.relayed_at IS NULL
+      AND e.created_at < $1
+      AND NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.event_id = e.id)
+    ORDER BY e.id
+    LIMIT $2
+)
+`
+
+type PruneOutboxEventsParams struct {
+	Before    pgtype.Timestamptz
+	BatchSize int32
+}
+
+func (q *Queries) PruneOutboxEvents(ctx context.Context, arg PruneOutboxEventsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, pruneOutboxEvents, arg.Before, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const pseudonymizeAuctionBids = `-- name: PseudonymizeAuctionBids :exec
+UPDATE auction_bids
+SET username = $1::text
+WHERE username = $2::text
+`
+
+type PseudonymizeAuctionBidsParams struct {
+	Pseudonym string
+	Username  string
+}
+
+func (q *Queries) PseudonymizeAuctionBids(ctx context.Context, arg PseudonymizeAuctionBidsParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeAuctionBids, arg.Pseudonym, arg.Username)
+	return err
+}
+
+const pseudonymizeAuctionWinners = `-- name: PseudonymizeAuctionWinners :exec
+UPDATE auctions
+SET winner = $1::text
+WHERE winner = $2::text
+`
+
+type PseudonymizeAuctionWinnersParams struct {
+	Pseudonym string
+	Username  string
+}
+
+func (q *Queries) PseudonymizeAuctionWinners(ctx context.Context, arg PseudonymizeAuctionWinnersParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeAuctionWinners, arg.Pseudonym, arg.Username)
+	return err
+}
+
+const pseudonymizeAuditLog = `-- name: PseudonymizeAuditLog :many
+UPDATE audit_log
+SET actor = CASE WHEN actor = $1::text THEN $2::text ELSE actor END,
+    target = CASE
+        WHEN target = $1::text THEN $2::text
+        WHEN target = 'user:' || $1::text THEN 'user:' || $2::text
+        ELSE target
+    END,
+    ip = CASE WHEN actor = $1::text THEN '' ELSE ip END,
+    user_agent = CASE WHEN actor = $1::text THEN '' ELSE user_agent END,
+    before = replace(before::text, to_json($1::text)::text, to_json($2::text)::text)::json,
+    after = replace(after::text, to_json($1::text)::text, to_json($2::text)::text)::json,
+    redacted_at = now()
+WHERE actor = $1::text
+   OR target = $1::text
+   OR target = 'user:' || $1::text
+   OR strpos(before::text, to_json($1::text)::text) > 0
+   OR strpos(after::text, to_json($1::text)::text) > 0
+RETURNING seq, created_at, actor, action, target, ip, user_agent, request_id, before, after
+`
+
+type PseudonymizeAuditLogParams struct {
+	Username  string
+	Pseudonym string
+}
+
+type PseudonymizeAuditLogRow struct {
+	Seq       int64
+	CreatedAt pgtype.Timestamptz
+	Actor     string
+	Action    string
+	Target    string
+	Ip        string
+	UserAgent string
+	RequestID string
+	Before    []byte
+	After     []byte
+}
+
+func (q *Queries) PseudonymizeAuditLog(ctx context.Context, arg PseudonymizeAuditLogParams) ([]PseudonymizeAuditLogRow, error) {
+	rows, err := q.db.Query(ctx, pseudonymizeAuditLog, arg.Username, arg.Pseudonym)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PseudonymizeAuditLogRow
+	for rows.Next() {
+		var i PseudonymizeAuditLogRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.CreatedAt,
+			&i.Actor,
+			&i.Action,
+			&i.Target,
+			&i.Ip,
+			&i.UserAgent,
+			&i.RequestID,
+			&i.Before,
+			&i.After,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pseudonymizeBalanceAdjustments = `-- name: PseudonymizeBalanceAdjustments :exec
+UPDATE balance_adjustments
+SET username = CASE WHEN username = $1::text THEN $2::text ELSE username END,
+    adjusted_by = CASE WHEN adjusted_by = $1::text THEN $2::text ELSE adjusted_by END
+WHERE username = $1::text OR adjusted_by = $1::text
+`
+
+type PseudonymizeBalanceAdjustmentsParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeBalanceAdjustments(ctx context.Context, arg PseudonymizeBalanceAdjustmentsParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeBalanceAdjustments, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const pseudonymizeCoinGrants = `-- name: PseudonymizeCoinGrants :exec
+UPDATE coin_grants
+SET to_username = CASE WHEN to_username = $1::text THEN $2::text ELSE to_username END,
+    granted_by = CASE WHEN granted_by = $1::text THEN $2::text ELSE granted_by END
+WHERE to_username = $1::text OR granted_by = $1::text
+`
+
+type PseudonymizeCoinGrantsParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeCoinGrants(ctx context.Context, arg PseudonymizeCoinGrantsParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeCoinGrants, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const pseudonymizeCoinTransfers = `-- name: PseudonymizeCoinTransfers :exec
+UPDATE coin_transfers
+SET from_username = CASE WHEN from_username = $1::text THEN $2::text ELSE from_username END,
+    to_username = CASE WHEN to_username = $1::text THEN $2::text ELSE to_username END
+WHERE from_username = $1::text OR to_username = $1::text
+`
+
+type PseudonymizeCoinTransfersParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeCoinTransfers(ctx context.Context, arg PseudonymizeCoinTransfersParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeCoinTransfers, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const pseudonymizeGroupPurchasePledges = `-- name: PseudonymizeGroupPurchasePledges :exec
+UPDATE group_purchase_pledges
+SET username = $1::text
+WHERE username = $2::text
+`
+
+type PseudonymizeGroupPurchasePledgesParams struct {
+	Pseudonym string
+	Username  string
+}
+
+func (q *Queries) PseudonymizeGroupPurchasePledges(ctx context.Context, arg PseudonymizeGroupPurchasePledgesParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeGroupPurchasePledges, arg.Pseudonym, arg.Username)
+	return err
+}
+
+const pseudonymizeGroupPurchases = `-- name: PseudonymizeGroupPurchases :exec
+UPDATE group_purchases
+SET recipient = CASE WHEN recipient = $1::text THEN $2::text ELSE recipient END,
+    created_by = CASE WHEN created_by = $1::text THEN $2::text ELSE created_by END
+WHERE recipient = $1::text OR created_by = $1::text
+`
+
+type PseudonymizeGroupPurchasesParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeGroupPurchases(ctx context.Context, arg PseudonymizeGroupPurchasesParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeGroupPurchases, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const pseudonymizeNotificationSenders = `-- name: PseudonymizeNotificationSenders :exec
+UPDATE notifications
+SET payload = jsonb_set(payload, '{fromUser}', to_jsonb($1::text))
+WHERE payload->>'fromUser' = $2::text
+`
+
+type PseudonymizeNotificationSendersParams struct {
+	Pseudonym string
+	Username  string
+}
+
+func (q *Queries) PseudonymizeNotificationSenders(ctx context.Context, arg PseudonymizeNotificationSendersParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeNotificationSenders, arg.Pseudonym, arg.Username)
+	return err
+}
+
+const pseudonymizeOutboxEvents = `-- name: PseudonymizeOutboxEvents :exec
+UPDATE outbox_events
+SET payload = replace(payload::text, to_jsonb($1::text)::text, to_jsonb($2::text)::text)::jsonb
+WHERE strpos(payload::text, to_jsonb($1::text)::text) > 0
+`
+
+type PseudonymizeOutboxEventsParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeOutboxEvents(ctx context.Context, arg PseudonymizeOutboxEventsParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeOutboxEvents, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const pseudonymizePurchases = `-- name: PseudonymizePurchases :exec
+UPDATE purchases
+SET username = $1
+WHERE username = $2
+`
+
+type PseudonymizePurchasesParams struct {
+	Pseudonym string
+	Username  string
+}
+
+func (q *Queries) PseudonymizePurchases(ctx context.Context, arg PseudonymizePurchasesParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizePurchases, arg.Pseudonym, arg.Username)
+	return err
+}
+
+const pseudonymizeTeamTransactions = `-- name: PseudonymizeTeamTransactions :exec
+UPDATE team_transactions
+SET username = CASE WHEN username = $1::text THEN $2::text ELSE username END,
+    actor = CASE WHEN actor = $1::text THEN $2::text ELSE actor END
+WHERE username = $1::text OR actor = $1::text
+`
+
+type PseudonymizeTeamTransactionsParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) PseudonymizeTeamTransactions(ctx context.Context, arg PseudonymizeTeamTransactionsParams) error {
+	_, err := q.db.Exec(ctx, pseudonymizeTeamTransactions, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const releaseAuctionHold = `-- name: ReleaseAuctionHold :execrows
+UPDATE auction_bids
+SET released_at = now()
+WHERE auction_id = $1 AND released_at IS NULL
+`
+
+func (q *Queries) ReleaseAuctionHold(ctx context.Context, auctionID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseAuctionHold, auctionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const releaseOutboxEvents = `-- name: ReleaseOutboxEvents :exec
+UPDATE outbox_events
+SET relay_claimed_until = NULL
+WHERE id = ANY($1::bigint[]) AND relayed_at IS NULL
+`
+
+func (q *Queries) ReleaseOutboxEvents(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, releaseOutboxEvents, ids)
+	return err
+}
+
+const removeWishlistItem = `-- name: RemoveWishlistItem :execrows
+DELETE FROM wishlist_items
+WHERE username = $1 AND item = $2
+`
+
+type RemoveWishlistItemParams struct {
+	Username string
+	Item     string
+}
+
+func (q *Queries) RemoveWishlistItem(ctx context.Context, arg RemoveWishlistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeWishlistItem, arg.Username, arg.Item)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resetLoginAttempts = `-- name: ResetLoginAttempts :execrows
+DELETE FROM login_attempts
+WHERE key = $1
+`
+
+func (q *Queries) ResetLoginAttempts(ctx context.Context, key string) (int64, error) {
+	result, err := q.db.Exec(ctx, resetLoginAttempts, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retryWebhookDelivery = `-- name: RetryWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now()
+WHERE id = $1 AND status = 'dead'
+`
+
+func (q *Queries) RetryWebhookDelivery(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, retryWebhookDelivery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPIKey, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeUserTokens = `-- name: RevokeUserTokens :execrows
+UPDATE users
+SET token_version = token_version + 1
+WHERE username = $1
+`
+
+func (q *Queries) RevokeUserTokens(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserTokens, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const saveLoginAttempts = `-- name: SaveLoginAttempts :exec
+INSERT INTO login_attempts (key, failures, last_failure_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET failures = excluded.failures,
+    last_failure_at = excluded.last_failure_at
+`
+
+type SaveLoginAttemptsParams struct {
+	Key           string
+	Failures      int32
+	LastFailureAt pgtype.Timestamptz
+}
+
+func (q *Queries) SaveLoginAttempts(ctx context.Context, arg SaveLoginAttemptsParams) error {
+	_, err := q.db.Exec(ctx, saveLoginAttempts, arg.Key, arg.Failures, arg.LastFailureAt)
+	return err
+}
+
+const saveRateLimitBucket = `-- name: SaveRateLimitBucket :exec
+INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET tokens = EXCLUDED.tokens, updated_at = EXCLUDED.updated_at
+`
+
+type SaveRateLimitBucketParams struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) SaveRateLimitBucket(ctx context.Context, arg SaveRateLimitBucketParams) error {
+	_, err := q.db.Exec(ctx, saveRateLimitBucket, arg.Key, arg.Tokens, arg.UpdatedAt)
+	return err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT username, display_name, department, avatar_url
+FROM users
+WHERE deactivated_at IS NULL
+  AND (username ILIKE $1 OR display_name ILIKE $1 OR department ILIKE $1)
+ORDER BY username
+LIMIT $2
+`
+
+type SearchUsersParams struct {
+	Pattern  string
+	RowLimit int32
+}
+
+type SearchUsersRow struct {
+	Username    string
+	DisplayName string
+	Department  string
+	AvatarUrl   string
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error) {
+	rows, err := q.db.Query(ctx, searchUsers, arg.Pattern, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUsersRow
+	for rows.Next() {
+		var i SearchUsersRow
+		if err := rows.Scan(
+			&i.Username,
+			&i.DisplayName,
+			&i.Department,
+			&i.AvatarUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAuditRedactedDigests = `-- name: SetAuditRedactedDigests :exec
+UPDATE audit_log a
+SET redacted_digest = d.digest
+FROM (SELECT unnest($1::bigint[]) AS seq, unnest($2::text[]) AS digest) d
+WHERE a.seq = d.seq
 `
 
-type CreatePurchaseParams struct {
+type SetAuditRedactedDigestsParams struct {
+	Seqs    []int64
+	Digests []string
+}
+
+func (q *Queries) SetAuditRedactedDigests(ctx context.Context, arg SetAuditRedactedDigestsParams) error {
+	_, err := q.db.Exec(ctx, setAuditRedactedDigests, arg.Seqs, arg.Digests)
+	return err
+}
+
+const setBalanceLotAmount = `-- name: SetBalanceLotAmount :exec
+UPDATE balance_lots
+SET amount = $1
+WHERE id = $2
+`
+
+type SetBalanceLotAmountParams struct {
+	Amount int32
+	ID     int64
+}
+
+func (q *Queries) SetBalanceLotAmount(ctx context.Context, arg SetBalanceLotAmountParams) error {
+	_, err := q.db.Exec(ctx, setBalanceLotAmount, arg.Amount, arg.ID)
+	return err
+}
+
+const setErasureContext = `-- name: SetErasureContext :exec
+SELECT set_config('merch.erased_username', $1::text, true),
+       set_config('merch.erased_pseudonym', $2::text, true)
+`
+
+type SetErasureContextParams struct {
+	Username  string
+	Pseudonym string
+}
+
+func (q *Queries) SetErasureContext(ctx context.Context, arg SetErasureContextParams) error {
+	_, err := q.db.Exec(ctx, setErasureContext, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const setPendingMFASecret = `-- name: SetPendingMFASecret :execrows
+INSERT INTO user_mfa (username, secret)
+VALUES ($1, $2)
+ON CONFLICT (username) DO UPDATE
+SET secret = EXCLUDED.secret, last_used_step = 0, created_at = now()
+WHERE user_mfa.confirmed_at IS NULL
+`
+
+type SetPendingMFASecretParams struct {
 	Username string
+	Secret   string
+}
+
+func (q *Queries) SetPendingMFASecret(ctx context.Context, arg SetPendingMFASecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPendingMFASecret, arg.Username, arg.Secret)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setProductCurrency = `-- name: SetProductCurrency :execrows
+UPDATE products
+SET currency = $2
+WHERE item = $1
+`
+
+type SetProductCurrencyParams struct {
 	Item     string
-	Price    int32
+	Currency string
 }
 
-func (q *Queries) CreatePurchase(ctx context.Context, arg CreatePurchaseParams) (Purchase, error) {
-	row := q.db.QueryRow(ctx, createPurchase, arg.Username, arg.Item, arg.Price)
-	var i Purchase
-	err := row.Scan(
-		&i.ID,
-		&i.Username,
-		&i.Item,
-		&i.Price,
-		&i.CreatedAt,
-	)
-	return i, err
+func (q *Queries) SetProductCurrency(ctx context.Context, arg SetProductCurrencyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setProductCurrency, arg.Item, arg.Currency)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const createUser = `-- name: CreateUser :exec
-INSERT INTO users (username, password_hash)
-VALUES ($1, $2)
+const setUserDeactivated = `-- name: SetUserDeactivated :execrows
+UPDATE users
+SET deactivated_at = CASE WHEN $1::boolean THEN COALESCE(deactivated_at, now()) END
+WHERE username = $2
 `
 
-type CreateUserParams struct {
-	Username     string
-	PasswordHash string
+type SetUserDeactivatedParams struct {
+	Deactivated bool
+	Username    string
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.Exec(ctx, createUser, arg.Username, arg.PasswordHash)
-	return err
+func (q *Queries) SetUserDeactivated(ctx context.Context, arg SetUserDeactivatedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserDeactivated, arg.Deactivated, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const deductCoins = `-- name: DeductCoins :execrows
+const setUserRole = `-- name: SetUserRole :execrows
 UPDATE users
-SET coins = coins - $1
-WHERE username = $2 AND coins >= $1
+SET role = $1
+WHERE username = $2
 `
 
-type DeductCoinsParams struct {
-	Coins    int32
+type SetUserRoleParams struct {
+	Role     string
 	Username string
 }
 
-func (q *Queries) DeductCoins(ctx context.Context, arg DeductCoinsParams) (int64, error) {
-	result, err := q.db.Exec(ctx, deductCoins, arg.Coins, arg.Username)
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserRole, arg.Role, arg.Username)
 	if err != nil {
 		return 0, err
 	}
 	return result.RowsAffected(), nil
 }
 
-const getCoinHistoryReceived = `-- name: GetCoinHistoryReceived :many
-SELECT from_username, amount, created_at
+const topItems = `-- name: TopItems :many
+SELECT item, COUNT(*) AS purchases, SUM(price)::bigint AS coins
+FROM purchases
+WHERE currency = 'coins'
+  AND ($1::timestamptz IS NULL OR created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR created_at < $2::timestamptz)
+GROUP BY item
+ORDER BY purchases DESC, item
+LIMIT $3
+`
+
+type TopItemsParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	RowLimit int32
+}
+
+type TopItemsRow struct {
+	Item      string
+	Purchases int64
+	Coins     int64
+}
+
+func (q *Queries) TopItems(ctx context.Context, arg TopItemsParams) ([]TopItemsRow, error) {
+	rows, err := q.db.Query(ctx, topItems, arg.Since, arg.Until, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopItemsRow
+	for rows.Next() {
+		var i TopItemsRow
+		if err := rows.Scan(&i.Item, &i.Purchases, &i.Coins); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const topReceivers = `-- name: TopReceivers :many
+SELECT to_username AS username, SUM(amount)::bigint AS coins, COUNT(*) AS transfers
 FROM coin_transfers
-WHERE to_username = $1
-ORDER BY created_at
+WHERE currency = 'coins'
+  AND ($1::timestamptz IS NULL OR created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR created_at < $2::timestamptz)
+GROUP BY to_username
+ORDER BY coins DESC, username
+LIMIT $3
 `
 
-type GetCoinHistoryReceivedRow struct {
-	FromUsername string
-	Amount       int32
-	CreatedAt    pgtype.Timestamptz
+type TopReceiversParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	RowLimit int32
 }
 
-func (q *Queries) GetCoinHistoryReceived(ctx context.Context, toUsername string) ([]GetCoinHistoryReceivedRow, error) {
-	rows, err := q.db.Query(ctx, getCoinHistoryReceived, toUsername)
+type TopReceiversRow struct {
+	Username  string
+	Coins     int64
+	Transfers int64
+}
+
+func (q *Queries) TopReceivers(ctx context.Context, arg TopReceiversParams) ([]TopReceiversRow, error) {
+	rows, err := q.db.Query(ctx, topReceivers, arg.Since, arg.Until, arg.RowLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetCoinHistoryReceivedRow
+	var items []TopReceiversRow
 	for rows.Next() {
-		var i GetCoinHistoryReceivedRow
-		if err := rows.Scan(&i.FromUsername, &i.Amount, &i.CreatedAt); err != nil {
+		var i TopReceiversRow
+		if err := rows.Scan(&i.Username, &i.Coins, &i.Transfers); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -122,27 +4092,39 @@ func (q *Queries) GetCoinHistoryReceived(ctx context.Context, toUsername string)
 	return items, nil
 }
 
-const getCoinHistorySent = `-- name: GetCoinHistorySent :many
-SELECT to_username, amount
+const topSenders = `-- name: TopSenders :many
+SELECT from_username AS username, SUM(amount)::bigint AS coins, COUNT(*) AS transfers
 FROM coin_transfers
-WHERE from_username = $1
+WHERE currency = 'coins'
+  AND ($1::timestamptz IS NULL OR created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR created_at < $2::timestamptz)
+GROUP BY from_username
+ORDER BY coins DESC, username
+LIMIT $3
 `
 
-type GetCoinHistorySentRow struct {
-	ToUsername string
-	Amount     int32
+type TopSendersParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	RowLimit int32
 }
 
-func (q *Queries) GetCoinHistorySent(ctx context.Context, fromUsername string) ([]GetCoinHistorySentRow, error) {
-	rows, err := q.db.Query(ctx, getCoinHistorySent, fromUsername)
+type TopSendersRow struct {
+	Username  string
+	Coins     int64
+	Transfers int64
+}
+
+func (q *Queries) TopSenders(ctx context.Context, arg TopSendersParams) ([]TopSendersRow, error) {
+	rows, err := q.db.Query(ctx, topSenders, arg.Since, arg.Until, arg.RowLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetCoinHistorySentRow
+	var items []TopSendersRow
 	for rows.Next() {
-		var i GetCoinHistorySentRow
-		if err := rows.Scan(&i.ToUsername, &i.Amount); err != nil {
+		var i TopSendersRow
+		if err := rows.Scan(&i.Username, &i.Coins, &i.Transfers); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -153,89 +4135,255 @@ func (q *Queries) GetCoinHistorySent(ctx context.Context, fromUsername string) (
 	return items, nil
 }
 
-const getProductPrice = `-- name: GetProductPrice :one
-SELECT price
-FROM products
-WHERE item = $1
+const touchAPIKeys = `-- name: TouchAPIKeys :exec
+UPDATE api_keys k
+SET last_used_at = GREATEST(k.last_used_at, u.used_at)
+FROM (SELECT unnest($1::int[]) AS id, unnest($2::timestamptz[]) AS used_at) u
+WHERE k.id = u.id
 `
 
-func (q *Queries) GetProductPrice(ctx context.Context, item string) (int32, error) {
-	row := q.db.QueryRow(ctx, getProductPrice, item)
-	var price int32
-	err := row.Scan(&price)
-	return price, err
+type TouchAPIKeysParams struct {
+	Ids    []int32
+	UsedAt []pgtype.Timestamptz
 }
 
-const getUser = `-- name: GetUser :one
-SELECT username, password_hash, coins
-FROM users
-WHERE username = $1
+func (q *Queries) TouchAPIKeys(ctx context.Context, arg TouchAPIKeysParams) error {
+	_, err := q.db.Exec(ctx, touchAPIKeys, arg.Ids, arg.UsedAt)
+	return err
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :one
+UPDATE user_identities
+SET last_login_at = now(), email = $3
+WHERE issuer = $1 AND subject = $2
+RETURNING username
 `
 
-func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRow(ctx, getUser, username)
-	var i User
-	err := row.Scan(&i.Username, &i.PasswordHash, &i.Coins)
-	return i, err
+type TouchUserIdentityParams struct {
+	Issuer  string
+	Subject string
+	Email   string
 }
 
-const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT username, password_hash, coins
-FROM users
+func (q *Queries) TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) (string, error) {
+	row := q.db.QueryRow(ctx, touchUserIdentity, arg.Issuer, arg.Subject, arg.Email)
+	var username string
+	err := row.Scan(&username)
+	return username, err
+}
+
+const tryLockAuditChain = `-- name: TryLockAuditChain :one
+SELECT pg_try_advisory_xact_lock(hashtext('audit_log'))
+`
+
+func (q *Queries) TryLockAuditChain(ctx context.Context) (bool, error) {
+	row := q.db.QueryRow(ctx, tryLockAuditChain)
+	var pg_try_advisory_xact_lock bool
+	err := row.Scan(&pg_try_advisory_xact_lock)
+	return pg_try_advisory_xact_lock, err
+}
+
+const updatePasswordHash = `-- name: UpdatePasswordHash :execrows
+UPDATE users
+SET password_hash = $1
+WHERE username = $2
+`
+
+type UpdatePasswordHashParams struct {
+	PasswordHash string
+	Username     string
+}
+
+func (q *Queries) UpdatePasswordHash(ctx context.Context, arg UpdatePasswordHashParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePasswordHash, arg.PasswordHash, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :execrows
+UPDATE users
+SET display_name = $2, email = $3, department = $4, avatar_url = $5
 WHERE username = $1
 `
 
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByUsername, username)
-	var i User
-	err := row.Scan(&i.Username, &i.PasswordHash, &i.Coins)
+type UpdateUserProfileParams struct {
+	Username    string
+	DisplayName string
+	Email       string
+	Department  string
+	AvatarUrl   string
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserProfile,
+		arg.Username,
+		arg.DisplayName,
+		arg.Email,
+		arg.Department,
+		arg.AvatarUrl,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateWebhookDelivery = `-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = $1,
+    attempts = attempts + 1,
+    last_status_code = $2,
+    last_error = $3,
+    next_attempt_at = $4,
+    delivered_at = CASE WHEN $1 = 'delivered' THEN now() END
+WHERE id = $5
+`
+
+type UpdateWebhookDeliveryParams struct {
+	Status         string
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	NextAttemptAt  pgtype.Timestamptz
+	ID             int64
+}
+
+func (q *Queries) UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookDelivery,
+		arg.Status,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const upsertProductVariant = `-- name: UpsertProductVariant :one
+INSERT INTO product_variants (item, sku, size, color, stock, price, is_default)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (sku) DO UPDATE
+SET size = excluded.size, color = excluded.color, stock = excluded.stock, price = excluded.price,
+    is_default = excluded.is_default
+WHERE product_variants.item = excluded.item
+RETURNING id, item, sku, size, color, stock, price, is_default
+`
+
+type UpsertProductVariantParams struct {
+	Item      string
+	Sku       string
+	Size      pgtype.Text
+	Color     pgtype.Text
+	Stock     pgtype.Int4
+	Price     pgtype.Int4
+	IsDefault bool
+}
+
+func (q *Queries) UpsertProductVariant(ctx context.Context, arg UpsertProductVariantParams) (ProductVariant, error) {
+	row := q.db.QueryRow(ctx, upsertProductVariant,
+		arg.Item,
+		arg.Sku,
+		arg.Size,
+		arg.Color,
+		arg.Stock,
+		arg.Price,
+		arg.IsDefault,
+	)
+	var i ProductVariant
+	err := row.Scan(
+		&i.ID,
+		&i.Item,
+		&i.Sku,
+		&i.Size,
+		&i.Color,
+		&i.Stock,
+		&i.Price,
+		&i.IsDefault,
+	)
 	return i, err
 }
 
-const insertCoinTransfer = `-- name: InsertCoinTransfer :exec
-INSERT INTO coin_transfers (from_username, to_username, amount)
+const upsertSpendingLimits = `-- name: UpsertSpendingLimits :exec
+INSERT INTO spending_limits (scope, subject, daily_transfer, max_transfer, monthly_purchase)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (scope, subject) DO UPDATE
+SET daily_transfer = EXCLUDED.daily_transfer,
+    max_transfer = EXCLUDED.max_transfer,
+    monthly_purchase = EXCLUDED.monthly_purchase,
+    updated_at = now()
+`
+
+type UpsertSpendingLimitsParams struct {
+	Scope           string
+	Subject         string
+	DailyTransfer   pgtype.Int4
+	MaxTransfer     pgtype.Int4
+	MonthlyPurchase pgtype.Int4
+}
+
+func (q *Queries) UpsertSpendingLimits(ctx context.Context, arg UpsertSpendingLimitsParams) error {
+	_, err := q.db.Exec(ctx, upsertSpendingLimits,
+		arg.Scope,
+		arg.Subject,
+		arg.DailyTransfer,
+		arg.MaxTransfer,
+		arg.MonthlyPurchase,
+	)
+	return err
+}
+
+const upsertTeamMember = `-- name: UpsertTeamMember :exec
+INSERT INTO team_members (team, username, role)
 VALUES ($1, $2, $3)
+ON CONFLICT (team, username) DO UPDATE SET role = EXCLUDED.role
 `
 
-type InsertCoinTransferParams struct {
-	FromUsername string
-	ToUsername   string
-	Amount       int32
+type UpsertTeamMemberParams struct {
+	Team     string
+	Username string
+	Role     string
 }
 
-func (q *Queries) InsertCoinTransfer(ctx context.Context, arg InsertCoinTransferParams) error {
-	_, err := q.db.Exec(ctx, insertCoinTransfer, arg.FromUsername, arg.ToUsername, arg.Amount)
+func (q *Queries) UpsertTeamMember(ctx context.Context, arg UpsertTeamMemberParams) error {
+	_, err := q.db.Exec(ctx, upsertTeamMember, arg.Team, arg.Username, arg.Role)
 	return err
 }
 
-const listInventory = `-- name: ListInventory :many
-SELECT item, COUNT(*) AS quantity
-FROM purchases
-WHERE username = $1
-GROUP BY item
+const useMFAStep = `-- name: UseMFAStep :execrows
+UPDATE user_mfa
+SET last_used_step = $2
+WHERE username = $1 AND last_used_step < $2
 `
 
-type ListInventoryRow struct {
-	Item     string
-	Quantity int64
+type UseMFAStepParams struct {
+	Username     string
+	LastUsedStep int64
 }
 
-func (q *Queries) ListInventory(ctx context.Context, username string) ([]ListInventoryRow, error) {
-	rows, err := q.db.Query(ctx, listInventory, username)
+func (q *Queries) UseMFAStep(ctx context.Context, arg UseMFAStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useMFAStep, arg.Username, arg.LastUsedStep)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []ListInventoryRow
-	for rows.Next() {
-		var i ListInventoryRow
-		if err := rows.Scan(&i.Item, &i.Quantity); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE username = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	Username string
+	CodeHash string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.Username, arg.CodeHash)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
@@ -18,19 +18,28 @@ SET coins = coins + $1
 WHERE username = $2;
 
 -- name: InsertCoinTransfer :exec
-INSERT INTO coin_transfers (from_username, to_username, amount)
-VALUES ($1, $2, $3);
+INSERT INTO coin_transfers (from_username, to_username, amount, currency)
+VALUES ($1, $2, $3, $4);
 
 -- name: GetCoinHistoryReceived :many
-SELECT from_username, amount, created_at
+SELECT from_username, amount, currency, created_at, ''::text AS team
 FROM coin_transfers
 WHERE to_username = $1
+UNION ALL
+SELECT actor, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND username = $1
 ORDER BY created_at;
 
 -- name: GetCoinHistorySent :many
-SELECT to_username, amount
+SELECT to_username, amount, currency, created_at, ''::text AS team
 FROM coin_transfers
-WHERE from_username = $1;
+WHERE from_username = $1
+UNION ALL
+SELECT username, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND actor = $1
+ORDER BY created_at;
 
 -- name: GetProductPrice :one
 SELECT price
@@ -38,17 +47,1011 @@ FROM products
 WHERE item = $1;
 
 -- name: CreatePurchase :one
-INSERT INTO purchases (username, item, price)
-VALUES ($1, $2, $3)
-RETURNING id, username, item, price, created_at;
+INSERT INTO purchases (username, item, price, variant_id, sale_id, promo_code, currency, auction_id, group_purchase_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, username, item, price, created_at, variant_id, sale_id, promo_code, currency;
 
 -- name: ListInventory :many
-SELECT item, COUNT(*) AS quantity
-FROM purchases
-WHERE username = $1
-GROUP BY item;
+SELECT p.item, v.sku, COUNT(*) AS quantity
+FROM purchases p
+LEFT JOIN product_variants v ON v.id = p.variant_id
+WHERE p.username = $1
+GROUP BY p.item, v.sku;
+
+-- name: ListPurchases :many
+SELECT p.item, v.sku, p.price, p.currency, p.created_at, p.promo_code, s.name AS sale
+FROM purchases p
+LEFT JOIN product_variants v ON v.id = p.variant_id
+LEFT JOIN sales s ON s.id = p.sale_id
+WHERE p.username = $1
+ORDER BY p.created_at;
+
+-- name: SetProductCurrency :execrows
+UPDATE products
+SET currency = $2
+WHERE item = $1;
+
+-- name: GetProduct :one
+SELECT item, price, currency, category, active
+FROM products
+WHERE item = $1;
+
+-- name: GetProductVariant :one
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = $1 AND sku = $2;
+
+-- name: GetDefaultProductVariant :one
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = $1 AND is_default;
+
+-- name: CountProductVariants :one
+SELECT COUNT(*)
+FROM product_variants
+WHERE item = $1;
+
+-- name: DecrementVariantStock :execrows
+UPDATE product_variants
+SET stock = stock - 1
+WHERE id = $1 AND (stock IS NULL OR stock > 0);
+
+-- name: UpsertProductVariant :one
+INSERT INTO product_variants (item, sku, size, color, stock, price, is_default)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (sku) DO UPDATE
+SET size = excluded.size, color = excluded.color, stock = excluded.stock, price = excluded.price,
+    is_default = excluded.is_default
+WHERE product_variants.item = excluded.item
+RETURNING id, item, sku, size, color, stock, price, is_default;
+
+-- name: ClearDefaultProductVariant :exec
+UPDATE product_variants
+SET is_default = false
+WHERE item = $1 AND sku <> $2 AND is_default;
 
 -- name: GetUser :one
-SELECT username, password_hash, coins
+SELECT username, password_hash, coins, role, display_name, email, department, avatar_url, deactivated_at, token_version
+FROM users
+WHERE username = $1;
+
+-- name: SetUserRole :execrows
+UPDATE users
+SET role = $1
+WHERE username = $2;
+
+-- name: ListActiveSales :many
+SELECT s.id, s.name, s.item, s.category, s.percent_off, s.amount_off, s.starts_at, s.ends_at
+FROM sales s
+WHERE s.starts_at <= now() AND s.ends_at > now()
+  AND (s.item = $1 OR s.category = (SELECT p.category FROM products p WHERE p.item = $1));
+
+-- name: LockUsers :execrows
+SELECT username
+FROM users
+WHERE username = ANY(sqlc.arg(usernames)::text[])
+ORDER BY username
+FOR UPDATE;
+
+-- name: GetPromoCodeForUpdate :one
+SELECT code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at
+FROM promo_codes
+WHERE code = $1
+FOR UPDATE;
+
+-- name: CountUserPromoCodeUses :one
+SELECT COUNT(*)
+FROM purchases
+WHERE username = $1 AND promo_code = $2;
+
+-- name: IncrementPromoCodeUses :execrows
+UPDATE promo_codes
+SET uses = uses + 1
+WHERE code = $1 AND (max_uses IS NULL OR uses < max_uses);
+
+-- name: CreateSale :one
+INSERT INTO sales (name, item, category, percent_off, amount_off, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, item, category, percent_off, amount_off, starts_at, ends_at;
+
+-- name: ListSales :many
+SELECT id, name, item, category, percent_off, amount_off, starts_at, ends_at
+FROM sales
+ORDER BY starts_at DESC, id DESC;
+
+-- name: DeleteSale :execrows
+DELETE FROM sales
+WHERE id = $1;
+
+-- name: CreatePromoCode :one
+INSERT INTO promo_codes (code, item, category, percent_off, amount_off, max_uses, per_user_limit, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at;
+
+-- name: ListPromoCodes :many
+SELECT code, item, category, percent_off, amount_off, max_uses, uses, per_user_limit, starts_at, ends_at
+FROM promo_codes
+ORDER BY code;
+
+-- name: DeletePromoCode :execrows
+DELETE FROM promo_codes
+WHERE code = $1;
+
+-- name: ListCatalogProducts :many
+SELECT item, price, category, name_ru, name_en, description_ru, description_en, active, currency
+FROM products
+WHERE active
+  AND (sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category)::text)
+  AND (sqlc.narg(min_price)::int IS NULL OR price >= sqlc.narg(min_price)::int)
+  AND (sqlc.narg(max_price)::int IS NULL OR price <= sqlc.narg(max_price)::int)
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'price' THEN price END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-price' THEN price END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'name' AND sqlc.arg(lang)::text = 'en' THEN name_en END ASC,
+  CASE WHEN sqlc.arg(sort)::text = 'name' AND sqlc.arg(lang)::text <> 'en' THEN name_ru END ASC,
+  item;
+
+-- name: ListProductVariantsByItems :many
+SELECT id, item, sku, size, color, stock, price, is_default
+FROM product_variants
+WHERE item = ANY(sqlc.arg(item_names)::text[])
+ORDER BY item, sku;
+
+-- name: ListProductImagesByItems :many
+SELECT item, url
+FROM product_images
+WHERE item = ANY(sqlc.arg(item_names)::text[])
+ORDER BY item, position, id;
+
+-- name: ListCategories :many
+SELECT slug, name_ru, name_en
+FROM categories
+ORDER BY slug;
+
+-- name: AddWishlistItem :exec
+INSERT INTO wishlist_items (username, item, notified_price)
+VALUES ($1, $2, COALESCE(product_effective_price($2), 0))
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveWishlistItem :execrows
+DELETE FROM wishlist_items
+WHERE username = $1 AND item = $2;
+
+-- name: ListWishlist :many
+SELECT w.item, product_effective_price(w.item)::integer AS price, p.currency, w.created_at
+FROM wishlist_items w
+JOIN products p ON p.item = w.item
+WHERE w.username = $1
+ORDER BY w.created_at;
+
+-- name: NotifyWishlistPriceDrops :execrows
+WITH prices AS (
+    SELECT item, product_effective_price(item) AS price
+    FROM (SELECT DISTINCT item FROM wishlist_items) i
+), stale AS (
+    SELECT w.username, w.item, w.notified_price AS old_price, p.price AS new_price
+    FROM wishlist_items w
+    JOIN prices p ON p.item = w.item
+    WHERE w.notified_price <> p.price
+), changed AS (
+    UPDATE wishlist_items w
+    SET notified_price = s.new_price
+    FROM stale s
+    WHERE w.username = s.username AND w.item = s.item AND w.notified_price = s.old_price
+    RETURNING s.username, s.item, s.old_price, s.new_price
+)
+INSERT INTO notifications (username, kind, payload)
+SELECT username, 'wishlist_price_drop', jsonb_build_object('item', item, 'oldPrice', old_price, 'newPrice', new_price)
+FROM changed
+WHERE new_price < old_price;
+
+-- name: ListNotifications :many
+SELECT id, username, kind, payload, created_at, read_at
+FROM notifications
+WHERE username = sqlc.arg(username)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id)::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: CreateNotification :exec
+INSERT INTO notifications (username, kind, payload)
+VALUES ($1, $2, $3);
+
+-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE username = $1 AND id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE username = $1 AND read_at IS NULL;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE username = $1 AND read_at IS NULL;
+
+-- name: NotifyEvent :exec
+SELECT pg_notify('merch_events', sqlc.arg(payload)::text);
+
+-- name: NotifyBalance :exec
+SELECT pg_notify('merch_events', json_build_object(
+    'username', username,
+    'type', 'balance',
+    'data', json_build_object('coins', coins)
+)::text)
+FROM users
+WHERE username = $1;
+
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox_events (event_type, payload)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: CreateWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id)
+SELECT s.id, sqlc.arg(event_id)::bigint
+FROM webhook_subscriptions s
+WHERE s.active
+  AND (cardinality(s.event_types) = 0 OR sqlc.arg(event_type)::text = ANY(s.event_types));
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, url, secret, event_types, active, created_by, created_at;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, active, created_by, created_at
+FROM webhook_subscriptions
+ORDER BY id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: LeaseWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = now() + make_interval(secs => sqlc.arg(lease_seconds)::int)
+FROM webhook_subscriptions s, outbox_events e
+WHERE s.id = d.subscription_id
+  AND e.id = d.event_id
+  AND d.id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.attempts, s.url, s.secret, e.id AS event_id, e.event_type, e.payload, e.created_at;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    attempts = attempts + 1,
+    last_status_code = sqlc.narg(last_status_code),
+    last_error = sqlc.narg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    delivered_at = CASE WHEN sqlc.arg(status) = 'delivered' THEN now() END
+WHERE id = sqlc.arg(id);
+
+-- name: ListWebhookDeliveries :many
+SELECT d.id, d.event_id, e.event_type, d.status, d.attempts, d.next_attempt_at,
+       d.last_status_code, d.last_error, d.delivered_at, d.created_at
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = sqlc.arg(subscription_id)
+  AND (sqlc.narg(status)::text IS NULL OR d.status = sqlc.narg(status)::text)
+ORDER BY d.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: RetryWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now()
+WHERE id = $1 AND status = 'dead';
+
+-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET relay_claimed_until = now() + make_interval(secs => sqlc.arg(lease_seconds)::int)
+WHERE id IN (
+    SELECT id
+    FROM outbox_events
+    WHERE relayed_at IS NULL
+      AND (relay_claimed_until IS NULL OR relay_claimed_until <= now())
+    ORDER BY id
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, payload, created_at;
+
+-- name: ReleaseOutboxEvents :exec
+UPDATE outbox_events
+SET relay_claimed_until = NULL
+WHERE id = ANY(sqlc.arg(ids)::bigint[]) AND relayed_at IS NULL;
+
+-- name: PruneOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.relayed_at IS NULL
+      AND e.created_at < sqlc.arg(before)
+      AND NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.event_id = e.id)
+    ORDER BY e.id
+    LIMIT sqlc.arg(batch_size)
+);
+
+-- name: MarkOutboxEventsRelayed :exec
+UPDATE outbox_events
+SET relayed_at = now()
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: TopReceivers :many
+SELECT to_username AS username, SUM(amount)::bigint AS coins, COUNT(*) AS transfers
+FROM coin_transfers
+WHERE currency = 'coins'
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+GROUP BY to_username
+ORDER BY coins DESC, username
+LIMIT sqlc.arg(row_limit);
+
+-- name: TopSenders :many
+SELECT from_username AS username, SUM(amount)::bigint AS coins, COUNT(*) AS transfers
+FROM coin_transfers
+WHERE currency = 'coins'
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+GROUP BY from_username
+ORDER BY coins DESC, username
+LIMIT sqlc.arg(row_limit);
+
+-- name: TopItems :many
+SELECT item, COUNT(*) AS purchases, SUM(price)::bigint AS coins
+FROM purchases
+WHERE currency = 'coins'
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+GROUP BY item
+ORDER BY purchases DESC, item
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetUserStats :one
+SELECT
+    (SELECT COALESCE(SUM(amount), 0)
+     FROM coin_transfers t
+     WHERE t.to_username = sqlc.arg(username) AND t.currency = 'coins'
+       AND (sqlc.narg(since)::timestamptz IS NULL OR t.created_at >= sqlc.narg(since)::timestamptz)
+       AND (sqlc.narg(until)::timestamptz IS NULL OR t.created_at < sqlc.narg(until)::timestamptz))::bigint AS total_received,
+    (SELECT COALESCE(SUM(amount), 0)
+     FROM coin_transfers t
+     WHERE t.from_username = sqlc.arg(username) AND t.currency = 'coins'
+       AND (sqlc.narg(since)::timestamptz IS NULL OR t.created_at >= sqlc.narg(since)::timestamptz)
+       AND (sqlc.narg(until)::timestamptz IS NULL OR t.created_at < sqlc.narg(until)::timestamptz))::bigint AS total_sent,
+    (SELECT COALESCE(SUM(price), 0)
+     FROM purchases p
+     WHERE p.username = sqlc.arg(username) AND p.currency = 'coins' AND p.group_purchase_id IS NULL
+       AND (sqlc.narg(since)::timestamptz IS NULL OR p.created_at >= sqlc.narg(since)::timestamptz)
+       AND (sqlc.narg(until)::timestamptz IS NULL OR p.created_at < sqlc.narg(until)::timestamptz))::bigint AS total_spent,
+    (SELECT COUNT(DISTINCT to_username)
+     FROM coin_transfers t
+     WHERE t.from_username = sqlc.arg(username)
+       AND (sqlc.narg(since)::timestamptz IS NULL OR t.created_at >= sqlc.narg(since)::timestamptz)
+       AND (sqlc.narg(until)::timestamptz IS NULL OR t.created_at < sqlc.narg(until)::timestamptz)) AS colleagues_thanked;
+
+-- name: TryLockAuditChain :one
+SELECT pg_try_advisory_xact_lock(hashtext('audit_log'));
+
+-- name: GetAuditChainHead :one
+SELECT chain_seq, hash
+FROM audit_log
+WHERE chain_seq IS NOT NULL
+ORDER BY chain_seq DESC
+LIMIT 1;
+
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (created_at, actor, action, target, ip, user_agent, request_id, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ListUnchainedAuditEntries :many
+SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after
+FROM audit_log
+WHERE hash IS NULL
+ORDER BY seq
+LIMIT sqlc.arg(row_limit);
+
+-- name: ChainAuditEntry :exec
+UPDATE audit_log
+SET chain_seq = $2, digest = $3, prev_hash = $4, hash = $5
+WHERE seq = $1;
+
+-- name: ListAuditLog :many
+SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after, prev_hash, hash
+FROM audit_log
+WHERE (sqlc.narg(actor)::text IS NULL OR actor = sqlc.narg(actor)::text)
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action)::text)
+  AND (sqlc.narg(target)::text IS NULL OR target = sqlc.narg(target)::text)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+  AND (sqlc.narg(before_seq)::bigint IS NULL OR seq < sqlc.narg(before_seq)::bigint)
+ORDER BY seq DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetLoginAttempts :one
+SELECT failures, last_failure_at
+FROM login_attempts
+WHERE key = sqlc.arg(key)
+  AND last_failure_at > now() - make_interval(secs => sqlc.arg(window_seconds)::int);
+
+-- name: LockLoginAttempts :exec
+SELECT pg_advisory_xact_lock(hashtext('login_attempts:' || sqlc.arg(key)::text));
+
+-- name: SaveLoginAttempts :exec
+INSERT INTO login_attempts (key, failures, last_failure_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET failures = excluded.failures,
+    last_failure_at = excluded.last_failure_at;
+
+-- name: ResetLoginAttempts :execrows
+DELETE FROM login_attempts
+WHERE key = $1;
+
+-- name: LockRateLimitBucket :exec
+SELECT pg_advisory_xact_lock(hashtext('rate_limit:' || sqlc.arg(key)::text));
+
+-- name: GetRateLimitBucket :one
+SELECT tokens, updated_at
+FROM rate_limit_buckets
+WHERE key = $1;
+
+-- name: SaveRateLimitBucket :exec
+INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET tokens = EXCLUDED.tokens, updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteIdleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE updated_at < sqlc.arg(idle_since);
+
+-- name: GetUserSpendingLimits :many
+SELECT l.scope, l.daily_transfer, l.max_transfer, l.monthly_purchase
+FROM spending_limits l
+JOIN users u
+  ON (l.scope = 'user' AND l.subject = u.username)
+  OR (l.scope = 'role' AND l.subject = u.role)
+WHERE u.username = $1;
+
+-- name: ListSpendingLimits :many
+SELECT scope, subject, daily_transfer, max_transfer, monthly_purchase, updated_at
+FROM spending_limits
+ORDER BY scope, subject;
+
+-- name: UpsertSpendingLimits :exec
+INSERT INTO spending_limits (scope, subject, daily_transfer, max_transfer, monthly_purchase)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (scope, subject) DO UPDATE
+SET daily_transfer = EXCLUDED.daily_transfer,
+    max_transfer = EXCLUDED.max_transfer,
+    monthly_purchase = EXCLUDED.monthly_purchase,
+    updated_at = now();
+
+-- name: DeleteSpendingLimits :execrows
+DELETE FROM spending_limits
+WHERE scope = $1 AND subject = $2;
+
+-- name: GetCoinsSentSince :one
+SELECT (
+    (SELECT COALESCE(SUM(c.amount), 0)
+     FROM coin_transfers c
+     WHERE c.from_username = sqlc.arg(username) AND c.currency = 'coins' AND c.created_at >= sqlc.arg(since))
+  + (SELECT COALESCE(SUM(t.amount), 0)
+     FROM team_transactions t
+     WHERE t.kind = 'transfer' AND t.actor = sqlc.arg(username) AND t.created_at >= sqlc.arg(since))
+  + (SELECT COALESCE(SUM(gp.amount), 0)
+     FROM group_purchase_pledges gp
+     JOIN group_purchases g ON g.id = gp.group_purchase_id
+     WHERE gp.username = sqlc.arg(username) AND g.status <> 'refunded' AND gp.created_at >= sqlc.arg(since))
+)::bigint AS total;
+
+-- name: GetPurchaseSpendSince :one
+SELECT (
+    (SELECT COALESCE(SUM(p.price), 0)
+     FROM purchases p
+     WHERE p.username = sqlc.arg(username) AND p.currency = 'coins'
+       AND p.auction_id IS NULL AND p.group_purchase_id IS NULL
+       AND p.created_at >= sqlc.arg(since))
+    + (SELECT COALESCE(SUM(b.amount), 0)
+       FROM auction_bids b
+       WHERE b.username = sqlc.arg(username) AND b.released_at IS NULL AND b.created_at >= sqlc.arg(since))
+)::bigint AS total;
+
+-- name: UpdatePasswordHash :execrows
+UPDATE users
+SET password_hash = $1
+WHERE username = $2;
+
+-- name: RevokeUserTokens :execrows
+UPDATE users
+SET token_version = token_version + 1
+WHERE username = $1;
+
+-- name: DeleteUnusedPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE username = $1 AND used_at IS NULL;
+
+-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (token_hash, username, created_by, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ConsumePasswordResetToken :one
+UPDATE password_reset_tokens
+SET used_at = now()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > now()
+RETURNING username;
+
+-- name: TouchUserIdentity :one
+UPDATE user_identities
+SET last_login_at = now(), email = $3
+WHERE issuer = $1 AND subject = $2
+RETURNING username;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (issuer, subject, username, email)
+VALUES ($1, $2, $3, $4);
+
+-- name: CreateServiceAccount :one
+INSERT INTO service_accounts (name, description, created_by)
+VALUES ($1, $2, $3)
+RETURNING name, description, created_by, created_at;
+
+-- name: ListServiceAccounts :many
+SELECT name, description, created_by, created_at
+FROM service_accounts
+ORDER BY name;
+
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (service_account, prefix, key_hash, scopes, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at;
+
+-- name: ListAPIKeys :many
+SELECT id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at
+FROM api_keys
+WHERE service_account = $1
+ORDER BY id;
+
+-- name: GetActiveAPIKey :one
+SELECT id, service_account, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at
+FROM api_keys
+WHERE key_hash = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now());
+
+-- name: TouchAPIKeys :exec
+UPDATE api_keys k
+SET last_used_at = GREATEST(k.last_used_at, u.used_at)
+FROM (SELECT unnest(@ids::int[]) AS id, unnest(@used_at::timestamptz[]) AS used_at) u
+WHERE k.id = u.id;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: InsertCoinGrant :exec
+INSERT INTO coin_grants (to_username, amount, reason, granted_by, currency)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: InsertBalanceAdjustment :exec
+INSERT INTO balance_adjustments (username, currency, amount, reason, adjusted_by)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetUserMFA :one
+SELECT username, secret, confirmed_at, last_used_step, created_at
+FROM user_mfa
+WHERE username = $1;
+
+-- name: SetPendingMFASecret :execrows
+INSERT INTO user_mfa (username, secret)
+VALUES ($1, $2)
+ON CONFLICT (username) DO UPDATE
+SET secret = EXCLUDED.secret, last_used_step = 0, created_at = now()
+WHERE user_mfa.confirmed_at IS NULL;
+
+-- name: ConfirmUserMFA :exec
+UPDATE user_mfa
+SET confirmed_at = now()
+WHERE username = $1;
+
+-- name: UseMFAStep :execrows
+UPDATE user_mfa
+SET last_used_step = $2
+WHERE username = $1 AND last_used_step < $2;
+
+-- name: DeleteUserMFA :execrows
+DELETE FROM user_mfa
+WHERE username = $1;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE username = $1;
+
+-- name: InsertRecoveryCodes :exec
+INSERT INTO mfa_recovery_codes (username, code_hash)
+SELECT sqlc.arg(username)::text, unnest(sqlc.arg(code_hashes)::text[]);
+
+-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE username = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountRecoveryCodes :one
+SELECT COUNT(*)
+FROM mfa_recovery_codes
+WHERE username = $1 AND used_at IS NULL;
+
+-- name: UpdateUserProfile :execrows
+UPDATE users
+SET display_name = $2, email = $3, department = $4, avatar_url = $5
+WHERE username = $1;
+
+-- name: SearchUsers :many
+SELECT username, display_name, department, avatar_url
 FROM users
-WHERE username = $1;
\ No newline at end of file
+WHERE deactivated_at IS NULL
+  AND (username ILIKE sqlc.arg(pattern) OR display_name ILIKE sqlc.arg(pattern) OR department ILIKE sqlc.arg(pattern))
+ORDER BY username
+LIMIT sqlc.arg(row_limit);
+
+-- name: SetUserDeactivated :execrows
+UPDATE users
+SET deactivated_at = CASE WHEN sqlc.arg(deactivated)::boolean THEN COALESCE(deactivated_at, now()) END
+WHERE username = sqlc.arg(username);
+
+-- name: CreateTeam :one
+INSERT INTO teams (name, description, created_by)
+VALUES ($1, $2, $3)
+RETURNING name, description, coins, created_by, created_at;
+
+-- name: GetTeam :one
+SELECT name, description, coins, created_by, created_at
+FROM teams
+WHERE name = $1;
+
+-- name: ListTeams :many
+SELECT name, description, coins, created_by, created_at
+FROM teams
+ORDER BY name;
+
+-- name: UpsertTeamMember :exec
+INSERT INTO team_members (team, username, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (team, username) DO UPDATE SET role = EXCLUDED.role;
+
+-- name: DeleteTeamMember :execrows
+DELETE FROM team_members
+WHERE team = $1 AND username = $2;
+
+-- name: GetTeamMember :one
+SELECT team, username, role, joined_at
+FROM team_members
+WHERE team = $1 AND username = $2;
+
+-- name: ListTeamMembers :many
+SELECT team, username, role, joined_at
+FROM team_members
+WHERE team = $1
+ORDER BY username;
+
+-- name: AddTeamCoins :execrows
+UPDATE teams
+SET coins = coins + $1
+WHERE name = $2;
+
+-- name: DeductTeamCoins :execrows
+UPDATE teams
+SET coins = coins - $1
+WHERE name = $2 AND coins >= $1;
+
+-- name: InsertTeamTransaction :exec
+INSERT INTO team_transactions (team, kind, username, amount, actor, reason)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListTeamTransactions :many
+SELECT id, team, kind, username, amount, actor, reason, created_at
+FROM team_transactions
+WHERE team = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: ExportCoinTransfers :many
+SELECT from_username, to_username, amount, currency, created_at, ''::text AS team
+FROM coin_transfers
+WHERE from_username = sqlc.arg(username) OR to_username = sqlc.arg(username)
+UNION ALL
+SELECT actor, username, amount, 'coins', created_at, team
+FROM team_transactions
+WHERE kind = 'transfer' AND (username = sqlc.arg(username) OR actor = sqlc.arg(username))
+ORDER BY created_at;
+
+-- name: InsertErasedUser :exec
+INSERT INTO users (username, password_hash, coins, deactivated_at)
+VALUES ($1, '', 0, now());
+
+-- name: PseudonymizeCoinTransfers :exec
+UPDATE coin_transfers
+SET from_username = CASE WHEN from_username = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE from_username END,
+    to_username = CASE WHEN to_username = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE to_username END
+WHERE from_username = sqlc.arg(username)::text OR to_username = sqlc.arg(username)::text;
+
+-- name: PseudonymizePurchases :exec
+UPDATE purchases
+SET username = sqlc.arg(pseudonym)
+WHERE username = sqlc.arg(username);
+
+-- name: PseudonymizeCoinGrants :exec
+UPDATE coin_grants
+SET to_username = CASE WHEN to_username = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE to_username END,
+    granted_by = CASE WHEN granted_by = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE granted_by END
+WHERE to_username = sqlc.arg(username)::text OR granted_by = sqlc.arg(username)::text;
+
+-- name: PseudonymizeBalanceAdjustments :exec
+UPDATE balance_adjustments
+SET username = CASE WHEN username = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE username END,
+    adjusted_by = CASE WHEN adjusted_by = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE adjusted_by END
+WHERE username = sqlc.arg(username)::text OR adjusted_by = sqlc.arg(username)::text;
+
+-- name: PseudonymizeTeamTransactions :exec
+UPDATE team_transactions
+SET username = CASE WHEN username = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE username END,
+    actor = CASE WHEN actor = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE actor END
+WHERE username = sqlc.arg(username)::text OR actor = sqlc.arg(username)::text;
+
+-- name: PseudonymizeNotificationSenders :exec
+UPDATE notifications
+SET payload = jsonb_set(payload, '{fromUser}', to_jsonb(sqlc.arg(pseudonym)::text))
+WHERE payload->>'fromUser' = sqlc.arg(username)::text;
+
+-- name: DeleteUserTeamMemberships :exec
+DELETE FROM team_members
+WHERE username = $1;
+
+-- name: SetErasureContext :exec
+SELECT set_config('merch.erased_username', sqlc.arg(username)::text, true),
+       set_config('merch.erased_pseudonym', sqlc.arg(pseudonym)::text, true);
+
+-- name: PseudonymizeAuditLog :many
+UPDATE audit_log
+SET actor = CASE WHEN actor = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE actor END,
+    target = CASE
+        WHEN target = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text
+        WHEN target = 'user:' || sqlc.arg(username)::text THEN 'user:' || sqlc.arg(pseudonym)::text
+        ELSE target
+    END,
+    ip = CASE WHEN actor = sqlc.arg(username)::text THEN '' ELSE ip END,
+    user_agent = CASE WHEN actor = sqlc.arg(username)::text THEN '' ELSE user_agent END,
+    before = replace(before::text, to_json(sqlc.arg(username)::text)::text, to_json(sqlc.arg(pseudonym)::text)::text)::json,
+    after = replace(after::text, to_json(sqlc.arg(username)::text)::text, to_json(sqlc.arg(pseudonym)::text)::text)::json,
+    redacted_at = now()
+WHERE actor = sqlc.arg(username)::text
+   OR target = sqlc.arg(username)::text
+   OR target = 'user:' || sqlc.arg(username)::text
+   OR strpos(before::text, to_json(sqlc.arg(username)::text)::text) > 0
+   OR strpos(after::text, to_json(sqlc.arg(username)::text)::text) > 0
+RETURNING seq, created_at, actor, action, target, ip, user_agent, request_id, before, after;
+
+-- name: SetAuditRedactedDigests :exec
+UPDATE audit_log a
+SET redacted_digest = d.digest
+FROM (SELECT unnest(sqlc.arg(seqs)::bigint[]) AS seq, unnest(sqlc.arg(digests)::text[]) AS digest) d
+WHERE a.seq = d.seq;
+
+-- name: PseudonymizeOutboxEvents :exec
+UPDATE outbox_events
+SET payload = replace(payload::text, to_jsonb(sqlc.arg(username)::text)::text, to_jsonb(sqlc.arg(pseudonym)::text)::text)::jsonb
+WHERE strpos(payload::text, to_jsonb(sqlc.arg(username)::text)::text) > 0;
+
+-- name: InsertErasedUsername :exec
+INSERT INTO erased_usernames (username_hash)
+VALUES ($1)
+ON CONFLICT DO NOTHING;
+
+-- name: IsUsernameErased :one
+SELECT EXISTS (SELECT 1 FROM erased_usernames WHERE username_hash = $1);
+
+-- name: DeleteUserWishlist :exec
+DELETE FROM wishlist_items
+WHERE username = $1;
+
+-- name: DeleteUserNotifications :exec
+DELETE FROM notifications
+WHERE username = $1;
+
+-- name: DeleteUserPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE username = $1;
+
+-- name: DeleteUserIdentities :exec
+DELETE FROM user_identities
+WHERE username = $1;
+
+-- name: DeleteUserBalanceLots :exec
+DELETE FROM balance_lots
+WHERE username = $1;
+
+-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE username = $1;
+
+-- name: CreateCurrency :one
+INSERT INTO currencies (code, name, transferable, lifetime_days)
+VALUES ($1, $2, $3, $4)
+RETURNING code, name, transferable, lifetime_days, created_at;
+
+-- name: GetCurrency :one
+SELECT code, name, transferable, lifetime_days, created_at
+FROM currencies
+WHERE code = $1;
+
+-- name: ListCurrencies :many
+SELECT code, name, transferable, lifetime_days, created_at
+FROM currencies
+ORDER BY code;
+
+-- name: InsertBalanceLot :exec
+INSERT INTO balance_lots (username, currency, amount, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: LockBalanceLots :many
+SELECT id, amount, expires_at
+FROM balance_lots
+WHERE username = $1 AND currency = $2 AND amount > 0
+  AND (expires_at IS NULL OR expires_at > now())
+ORDER BY expires_at NULLS LAST, id
+FOR UPDATE;
+
+-- name: SetBalanceLotAmount :exec
+UPDATE balance_lots
+SET amount = $1
+WHERE id = $2;
+
+-- name: ListUserBalances :many
+SELECT currency, SUM(amount)::bigint AS amount, MIN(expires_at)::timestamptz AS next_expires_at
+FROM balance_lots
+WHERE username = $1 AND amount > 0
+  AND (expires_at IS NULL OR expires_at > now())
+GROUP BY currency
+ORDER BY currency;
+
+-- name: CreateAuction :one
+INSERT INTO auctions (item, reserve_price, ends_at, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, item, reserve_price, ends_at, created_by, created_at, closed_at, winner;
+
+-- name: GetAuction :one
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.id = $1;
+
+-- name: LockAuction :one
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.id = $1
+FOR UPDATE OF a;
+
+-- name: ListOpenAuctions :many
+SELECT a.id, a.item, a.reserve_price, a.ends_at, a.created_by, a.created_at, a.closed_at, a.winner,
+       b.username AS bidder, b.amount AS bid
+FROM auctions a
+LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.released_at IS NULL
+WHERE a.closed_at IS NULL
+ORDER BY a.ends_at, a.id;
+
+-- name: ListDueAuctions :many
+SELECT id
+FROM auctions
+WHERE closed_at IS NULL AND ends_at <= now()
+ORDER BY ends_at, id
+LIMIT $1;
+
+-- name: ListAuctionBids :many
+SELECT id, auction_id, username, amount, created_at, released_at
+FROM auction_bids
+WHERE auction_id = $1
+ORDER BY id;
+
+-- name: InsertAuctionBid :exec
+INSERT INTO auction_bids (auction_id, username, amount)
+VALUES ($1, $2, $3);
+
+-- name: ReleaseAuctionHold :execrows
+UPDATE auction_bids
+SET released_at = now()
+WHERE auction_id = $1 AND released_at IS NULL;
+
+-- name: CloseAuction :execrows
+UPDATE auctions
+SET closed_at = now(), winner = $2
+WHERE id = $1 AND closed_at IS NULL;
+
+-- name: PseudonymizeAuctionBids :exec
+UPDATE auction_bids
+SET username = sqlc.arg(pseudonym)::text
+WHERE username = sqlc.arg(username)::text;
+
+-- name: PseudonymizeAuctionWinners :exec
+UPDATE auctions
+SET winner = sqlc.arg(pseudonym)::text
+WHERE winner = sqlc.arg(username)::text;
+
+-- name: CreateGroupPurchase :one
+INSERT INTO group_purchases (item, variant_id, recipient, price, deadline, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: GetGroupPurchase :one
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.id = $1;
+
+-- name: LockGroupPurchase :one
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.id = $1
+FOR UPDATE OF g;
+
+-- name: ListOpenGroupPurchases :many
+SELECT g.id, g.item, g.variant_id, v.sku, g.recipient, g.price, g.deadline, g.created_by, g.created_at,
+       g.status, g.closed_at,
+       (SELECT COALESCE(SUM(p.amount), 0) FROM group_purchase_pledges p WHERE p.group_purchase_id = g.id)::bigint AS pledged
+FROM group_purchases g
+LEFT JOIN product_variants v ON v.id = g.variant_id
+WHERE g.status = 'open'
+ORDER BY g.deadline, g.id;
+
+-- name: ListExpiredGroupPurchases :many
+SELECT id
+FROM group_purchases
+WHERE status = 'open' AND deadline <= now()
+ORDER BY deadline, id
+LIMIT $1;
+
+-- name: ListGroupPurchasePledges :many
+SELECT id, group_purchase_id, username, amount, created_at
+FROM group_purchase_pledges
+WHERE group_purchase_id = $1
+ORDER BY id;
+
+-- name: InsertGroupPurchasePledge :exec
+INSERT INTO group_purchase_pledges (group_purchase_id, username, amount)
+VALUES ($1, $2, $3);
+
+-- name: CloseGroupPurchase :execrows
+UPDATE group_purchases
+SET status = $2, closed_at = now()
+WHERE id = $1 AND status = 'open';
+
+-- name: PseudonymizeGroupPurchases :exec
+UPDATE group_purchases
+SET recipient = CASE WHEN recipient = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE recipient END,
+    created_by = CASE WHEN created_by = sqlc.arg(username)::text THEN sqlc.arg(pseudonym)::text ELSE created_by END
+WHERE recipient = sqlc.arg(username)::text OR created_by = sqlc.arg(username)::text;
+
+-- name: PseudonymizeGroupPurchasePledges :exec
+UPDATE group_purchase_pledges
+SET username = sqlc.arg(pseudonym)::text
+WHERE username = sqlc.arg(username)::text;
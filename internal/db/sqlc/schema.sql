@@ -36,3 +36,703 @@ CREATE TABLE purchases (
     price INTEGER NOT NULL,
     created_at TIMESTAMPTZ DEFAULT now()
 );
+
+CREATE TABLE product_variants (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    sku TEXT NOT NULL UNIQUE,
+    size TEXT,
+    color TEXT,
+    -- NULL stock is unlimited.
+    stock INTEGER CHECK (stock >= 0),
+    price INTEGER CHECK (price > 0),
+    is_default BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX product_variants_item_idx ON product_variants (item);
+
+-- The default variant is bought when no SKU is given.
+CREATE UNIQUE INDEX product_variants_default_idx ON product_variants (item) WHERE is_default;
+
+ALTER TABLE purchases
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE SET NULL;
+
+CREATE TABLE sales (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    item TEXT REFERENCES products(item) ON DELETE CASCADE,
+    category TEXT,
+    percent_off INTEGER CHECK (percent_off BETWEEN 1 AND 100),
+    amount_off INTEGER CHECK (amount_off > 0),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    CHECK ((item IS NULL) <> (category IS NULL)),
+    CHECK ((percent_off IS NULL) <> (amount_off IS NULL)),
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX sales_period_idx ON sales (starts_at, ends_at);
+
+CREATE TABLE promo_codes (
+    code TEXT PRIMARY KEY,
+    item TEXT REFERENCES products(item) ON DELETE CASCADE,
+    category TEXT,
+    percent_off INTEGER CHECK (percent_off BETWEEN 1 AND 100),
+    amount_off INTEGER CHECK (amount_off > 0),
+    max_uses INTEGER CHECK (max_uses > 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    per_user_limit INTEGER NOT NULL DEFAULT 1 CHECK (per_user_limit > 0),
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    CHECK ((percent_off IS NULL) <> (amount_off IS NULL))
+);
+
+ALTER TABLE purchases
+    ADD COLUMN sale_id INTEGER REFERENCES sales(id) ON DELETE RESTRICT,
+    ADD COLUMN promo_code TEXT REFERENCES promo_codes(code) ON DELETE RESTRICT;
+
+CREATE INDEX purchases_promo_code_idx ON purchases (promo_code, username);
+
+CREATE TABLE categories (
+    slug TEXT PRIMARY KEY,
+    name_ru TEXT NOT NULL DEFAULT '',
+    name_en TEXT NOT NULL DEFAULT ''
+);
+
+ALTER TABLE products
+    ADD COLUMN category TEXT REFERENCES categories(slug) ON DELETE SET NULL;
+
+ALTER TABLE sales
+    ADD CONSTRAINT sales_category_fkey FOREIGN KEY (category) REFERENCES categories(slug) ON DELETE CASCADE;
+ALTER TABLE promo_codes
+    ADD CONSTRAINT promo_codes_category_fkey FOREIGN KEY (category) REFERENCES categories(slug) ON DELETE CASCADE;
+
+ALTER TABLE products
+    ADD COLUMN name_ru TEXT NOT NULL DEFAULT '',
+    ADD COLUMN name_en TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_ru TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_en TEXT NOT NULL DEFAULT '',
+    ADD COLUMN active BOOLEAN NOT NULL DEFAULT true;
+
+CREATE TABLE product_images (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX product_images_item_idx ON product_images (item, position);
+
+CREATE TABLE wishlist_items (
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    item TEXT NOT NULL REFERENCES products(item) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (username, item)
+);
+
+CREATE INDEX wishlist_items_item_idx ON wishlist_items (item);
+
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notifications_username_idx ON notifications (username, id DESC);
+
+ALTER TABLE notifications ADD COLUMN read_at TIMESTAMPTZ;
+
+CREATE INDEX notifications_unread_idx ON notifications (username) WHERE read_at IS NULL;
+
+ALTER TABLE users
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin'));
+
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_status_code INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_idx ON webhook_deliveries (subscription_id, id DESC);
+
+ALTER TABLE outbox_events
+    ADD COLUMN relayed_at TIMESTAMPTZ;
+
+CREATE INDEX outbox_events_unrelayed_idx ON outbox_events (id) WHERE relayed_at IS NULL;
+
+CREATE INDEX coin_transfers_created_at_idx ON coin_transfers (created_at);
+CREATE INDEX coin_transfers_to_username_idx ON coin_transfers (to_username, created_at);
+CREATE INDEX coin_transfers_from_username_idx ON coin_transfers (from_username, created_at);
+CREATE INDEX purchases_created_at_idx ON purchases (created_at);
+CREATE INDEX purchases_username_idx ON purchases (username, created_at);
+
+ALTER TABLE users
+    DROP CONSTRAINT users_role_check,
+    ADD CONSTRAINT users_role_check CHECK (role IN ('user', 'admin', 'auditor'));
+
+-- Entries form a hash chain: each hash covers the entry and the previous
+-- entry's hash. before and after are JSON rather than JSONB so that the
+-- hashed text is stored verbatim.
+CREATE TABLE audit_log (
+    seq BIGINT PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    before JSON,
+    after JSON,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, seq DESC);
+CREATE INDEX audit_log_target_idx ON audit_log (target, seq DESC);
+CREATE INDEX audit_log_action_idx ON audit_log (action, seq DESC);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
+-- Failed logins are counted per key: "user:<username>" or "ip:<address>".
+CREATE TABLE login_attempts (
+    key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Limits apply per user or per role; a user's own limit takes precedence over
+-- the one of their role. NULL means no limit.
+CREATE TABLE spending_limits (
+    scope TEXT NOT NULL CHECK (scope IN ('user', 'role')),
+    subject TEXT NOT NULL,
+    daily_transfer INTEGER CHECK (daily_transfer >= 0),
+    max_transfer INTEGER CHECK (max_transfer >= 0),
+    monthly_purchase INTEGER CHECK (monthly_purchase >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (scope, subject)
+);
+
+-- Only a SHA-256 hash of each reset token is stored.
+CREATE TABLE password_reset_tokens (
+    token_hash TEXT PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    created_by TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX password_reset_tokens_username_idx ON password_reset_tokens (username);
+
+-- Links accounts at external identity providers to merch users.
+CREATE TABLE user_identities (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    email TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_login_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE INDEX user_identities_username_idx ON user_identities (username);
+
+CREATE TABLE service_accounts (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Keys are looked up by the SHA-256 hash of the whole key; the prefix is
+-- kept in clear to tell keys apart.
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    service_account TEXT NOT NULL REFERENCES service_accounts(name) ON DELETE CASCADE,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX api_keys_service_account_idx ON api_keys (service_account, id);
+
+CREATE TABLE coin_grants (
+    id BIGSERIAL PRIMARY KEY,
+    to_username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL DEFAULT '',
+    granted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX coin_grants_to_username_idx ON coin_grants (to_username, created_at);
+
+-- A TOTP enrollment is pending until the first code is confirmed.
+-- last_used_step keeps a code from being used twice.
+CREATE TABLE user_mfa (
+    username TEXT PRIMARY KEY REFERENCES users(username) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE mfa_recovery_codes (
+    username TEXT NOT NULL REFERENCES user_mfa(username) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    PRIMARY KEY (username, code_hash)
+);
+
+ALTER TABLE users
+    ADD COLUMN display_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN email TEXT NOT NULL DEFAULT '',
+    ADD COLUMN department TEXT NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN deactivated_at TIMESTAMPTZ;
+
+-- Users leaving are deactivated, not deleted. Deleting a user with coin
+-- history is refused so that the history of the other side survives.
+ALTER TABLE coin_transfers
+    DROP CONSTRAINT coin_transfers_from_username_fkey,
+    ADD CONSTRAINT coin_transfers_from_username_fkey
+        FOREIGN KEY (from_username) REFERENCES users(username) ON DELETE RESTRICT,
+    DROP CONSTRAINT coin_transfers_to_username_fkey,
+    ADD CONSTRAINT coin_transfers_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE purchases
+    DROP CONSTRAINT purchases_username_fkey,
+    ADD CONSTRAINT purchases_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE coin_grants
+    DROP CONSTRAINT coin_grants_to_username_fkey,
+    ADD CONSTRAINT coin_grants_to_username_fkey
+        FOREIGN KEY (to_username) REFERENCES users(username) ON DELETE RESTRICT;
+
+CREATE TABLE teams (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    coins INTEGER NOT NULL DEFAULT 0 CHECK (coins >= 0),
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE team_members (
+    team TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    role TEXT NOT NULL CHECK (role IN ('member', 'lead')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (team, username)
+);
+
+CREATE INDEX team_members_username_idx ON team_members (username);
+
+-- The team wallet ledger: deposits by admins and transfers by team leads to
+-- members. username is the recipient of a transfer and actor the user who
+-- made the entry.
+CREATE TABLE team_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    team TEXT NOT NULL REFERENCES teams(name) ON DELETE RESTRICT,
+    kind TEXT NOT NULL CHECK (kind IN ('deposit', 'transfer')),
+    username TEXT REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((kind = 'transfer') = (username IS NOT NULL))
+);
+
+CREATE INDEX team_transactions_team_idx ON team_transactions (team, id);
+CREATE INDEX team_transactions_username_idx ON team_transactions (username, created_at)
+    WHERE username IS NOT NULL;
+
+-- Coins are the default currency and stay in users.coins. Other currencies,
+-- such as bonus points handed out by HR, may be non-transferable and may
+-- expire lifetime_days after they are credited.
+CREATE TABLE currencies (
+    code TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    transferable BOOLEAN NOT NULL,
+    lifetime_days INTEGER CHECK (lifetime_days > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO currencies (code, name, transferable) VALUES ('coins', 'Монеты', TRUE);
+
+-- Balances in currencies other than coins. Every credit is a lot of its own
+-- so that it expires on its own; debits take from the lots expiring first.
+CREATE TABLE balance_lots (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    currency TEXT NOT NULL REFERENCES currencies(code) CHECK (currency <> 'coins'),
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX balance_lots_username_idx ON balance_lots (username, currency) WHERE amount > 0;
+
+ALTER TABLE products ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE purchases ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE coin_transfers ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+ALTER TABLE coin_grants ADD COLUMN currency TEXT NOT NULL DEFAULT 'coins' REFERENCES currencies(code);
+
+-- Timed auctions of one-off items. winner is set when the auction is closed
+-- with a bid reaching the reserve price.
+CREATE TABLE auctions (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item),
+    reserve_price INTEGER NOT NULL CHECK (reserve_price > 0),
+    ends_at TIMESTAMPTZ NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    closed_at TIMESTAMPTZ,
+    winner TEXT REFERENCES users(username) ON DELETE RESTRICT
+);
+
+CREATE INDEX auctions_open_idx ON auctions (ends_at) WHERE closed_at IS NULL;
+
+-- Bids hold the bidder's coins: they are deducted when the bid is placed and
+-- given back, setting released_at, once the bid is outbid. The bid still
+-- holding coins when the auction closes is paid for the item.
+CREATE TABLE auction_bids (
+    id BIGSERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    released_at TIMESTAMPTZ
+);
+
+CREATE INDEX auction_bids_auction_idx ON auction_bids (auction_id, id);
+CREATE UNIQUE INDEX auction_bids_hold_idx ON auction_bids (auction_id) WHERE released_at IS NULL;
+CREATE INDEX auction_bids_username_idx ON auction_bids (username);
+
+-- The purchase of a won item is marked with the auction: it was paid with
+-- the winning bid's hold when the bid was placed, not at closing.
+ALTER TABLE purchases
+    ADD COLUMN auction_id INTEGER REFERENCES auctions(id);
+
+-- Pooled purchases of an item for a recipient. Pledges hold the pledgers'
+-- coins: the item is bought for the recipient as soon as the pledges add up
+-- to the price, and the pledges are refunded if they do not by the deadline.
+CREATE TABLE group_purchases (
+    id SERIAL PRIMARY KEY,
+    item TEXT NOT NULL REFERENCES products(item),
+    variant_id INTEGER REFERENCES product_variants(id),
+    recipient TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    price INTEGER NOT NULL CHECK (price > 0),
+    deadline TIMESTAMPTZ NOT NULL,
+    created_by TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'funded', 'refunded')),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX group_purchases_open_idx ON group_purchases (deadline) WHERE status = 'open';
+
+CREATE TABLE group_purchase_pledges (
+    id BIGSERIAL PRIMARY KEY,
+    group_purchase_id INTEGER NOT NULL REFERENCES group_purchases(id),
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX group_purchase_pledges_group_idx ON group_purchase_pledges (group_purchase_id, id);
+CREATE INDEX group_purchase_pledges_username_idx ON group_purchase_pledges (username);
+
+-- The purchase made for the recipient is marked with the group purchase: it
+-- was paid with the pledges' holds, not by the recipient.
+ALTER TABLE purchases
+    ADD COLUMN group_purchase_id INTEGER REFERENCES group_purchases(id);
+
+-- The pink hoody was a product of its own; it becomes the pink variant of the
+-- hoody, keeping its price.
+INSERT INTO product_variants (item, sku, size, color, stock, price) VALUES
+  ('hoody', 'hoody-classic', NULL, NULL, 1000, NULL),
+  ('hoody', 'hoody-pink', NULL, 'pink', 1000, 500);
+
+UPDATE purchases
+SET item = 'hoody', variant_id = (SELECT id FROM product_variants WHERE sku = 'hoody-pink')
+WHERE item = 'pink-hoody';
+
+INSERT INTO wishlist_items (username, item, created_at)
+SELECT username, 'hoody', created_at
+FROM wishlist_items
+WHERE item = 'pink-hoody'
+ON CONFLICT DO NOTHING;
+
+DELETE FROM wishlist_items WHERE item = 'pink-hoody';
+
+UPDATE products SET active = false WHERE item = 'pink-hoody';
+
+-- product_effective_price is the lowest price the item can be bought for now:
+-- the cheapest variant, or the product price without variants, after the best
+-- active sale.
+CREATE FUNCTION product_effective_price(target TEXT) RETURNS INTEGER AS $$
+    SELECT COALESCE(MIN(GREATEST(b.price - COALESCE(b.price * s.percent_off / 100, s.amount_off), 0)), b.price)
+    FROM (
+        SELECT COALESCE((SELECT MIN(COALESCE(v.price, p.price)) FROM product_variants v WHERE v.item = p.item), p.price) AS price,
+               p.category
+        FROM products p
+        WHERE p.item = target
+    ) b
+    LEFT JOIN sales s ON s.starts_at <= now() AND s.ends_at > now()
+        AND (s.item = target OR s.category = b.category)
+    GROUP BY b.price;
+$$ LANGUAGE sql STABLE;
+
+-- Sales start and end with time passing, so price drops are found by polling
+-- the effective prices against the one each user was last told about.
+DROP TRIGGER IF EXISTS products_wishlist_price_drop ON products;
+DROP FUNCTION IF EXISTS notify_wishlist_price_drop();
+
+ALTER TABLE wishlist_items ADD COLUMN notified_price INTEGER;
+UPDATE wishlist_items SET notified_price = product_effective_price(item);
+ALTER TABLE wishlist_items ALTER COLUMN notified_price SET NOT NULL;
+
+ALTER TABLE outbox_events
+    ADD COLUMN relay_claimed_until TIMESTAMPTZ;
+
+-- Users registered before the column existed get the time of their
+-- registration audit entry, if any; the rest stay NULL, meaning they existed
+-- before any report period.
+ALTER TABLE users
+    ADD COLUMN created_at TIMESTAMPTZ;
+
+UPDATE users u
+SET created_at = a.created_at
+FROM (
+    SELECT target, MIN(created_at) AS created_at
+    FROM audit_log
+    WHERE action = 'user.registered'
+    GROUP BY target
+) a
+WHERE a.target = u.username;
+
+ALTER TABLE users
+    ALTER COLUMN created_at SET DEFAULT now();
+
+-- Entries are inserted unchained and linked to the hash chain in the
+-- background, so that appending no longer serializes every audited write on
+-- one lock. seq identifies an entry and may have gaps left by rolled back
+-- transactions; chain_seq is its contiguous position in the chain. digest and
+-- hash are HMACs keyed with a secret kept outside the database, so that the
+-- chain cannot be rebuilt by someone who can only write to the table. The
+-- existing unkeyed chain is dropped and rebuilt by the chainer.
+DROP TRIGGER audit_log_no_update ON audit_log;
+
+CREATE SEQUENCE audit_log_seq OWNED BY audit_log.seq;
+SELECT setval('audit_log_seq', COALESCE(MAX(seq), 0) + 1, false) FROM audit_log;
+
+ALTER TABLE audit_log
+    ALTER COLUMN seq SET DEFAULT nextval('audit_log_seq'),
+    ALTER COLUMN prev_hash DROP NOT NULL,
+    ALTER COLUMN hash DROP NOT NULL,
+    ADD COLUMN chain_seq BIGINT UNIQUE,
+    ADD COLUMN digest TEXT;
+
+UPDATE audit_log SET prev_hash = NULL, hash = NULL;
+
+CREATE INDEX audit_log_unchained_idx ON audit_log (seq) WHERE hash IS NULL;
+
+-- Only the chainer may touch an entry, once, to fill in its chain columns.
+CREATE FUNCTION audit_log_chain_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash IS NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text) THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_chain_only();
+
+CREATE INDEX rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
+
+-- Tokens carry the version they were issued at; bumping it revokes them.
+ALTER TABLE users
+    ADD COLUMN token_version INTEGER NOT NULL DEFAULT 0;
+
+-- Deleting a user is refused while anything still refers to them, so that
+-- erasure has to remove their personal data explicitly and history is never
+-- dropped along with a user by accident.
+ALTER TABLE wishlist_items
+    DROP CONSTRAINT wishlist_items_username_fkey,
+    ADD CONSTRAINT wishlist_items_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE notifications
+    DROP CONSTRAINT notifications_username_fkey,
+    ADD CONSTRAINT notifications_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE password_reset_tokens
+    DROP CONSTRAINT password_reset_tokens_username_fkey,
+    ADD CONSTRAINT password_reset_tokens_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE user_identities
+    DROP CONSTRAINT user_identities_username_fkey,
+    ADD CONSTRAINT user_identities_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE user_mfa
+    DROP CONSTRAINT user_mfa_username_fkey,
+    ADD CONSTRAINT user_mfa_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+ALTER TABLE balance_lots
+    DROP CONSTRAINT balance_lots_username_fkey,
+    ADD CONSTRAINT balance_lots_username_fkey
+        FOREIGN KEY (username) REFERENCES users(username) ON DELETE RESTRICT;
+
+-- Erasing a user pseudonymizes the audit entries mentioning them. Redacted
+-- entries keep their place in the hash chain, but their content no longer
+-- matches the digest taken when they were chained, so the redacted content
+-- gets a digest of its own, keyed like the chain, which is verified instead.
+ALTER TABLE audit_log
+    ADD COLUMN redacted_at TIMESTAMPTZ,
+    ADD COLUMN redacted_digest TEXT;
+
+-- Erased usernames are kept, as SHA-256 hashes, so that nobody registers
+-- under one again and is taken for the erased user.
+CREATE TABLE erased_usernames (
+    username_hash TEXT PRIMARY KEY,
+    erased_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The chainer may fill in the chain columns of an entry once. Erasure names
+-- the erased user and their pseudonym in the merch.erased_username and
+-- merch.erased_pseudonym settings of its transaction, and may then replace
+-- the username with the pseudonym in the actor, the target and the strings
+-- of the payloads, and clear the address and user agent of the entries the
+-- user made; nothing else about an entry changes. The digest of redacted
+-- content may be set on its own, as the key it is made with is not known to
+-- the database.
+CREATE OR REPLACE FUNCTION audit_log_chain_only() RETURNS trigger AS $$
+DECLARE
+    erased TEXT;
+    pseudonym TEXT;
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash IS NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text,
+             NEW.redacted_at, NEW.redacted_digest)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text,
+             OLD.redacted_at, OLD.redacted_digest) THEN
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'UPDATE' AND OLD.redacted_at IS NOT NULL
+        AND (NEW.seq, NEW.created_at, NEW.actor, NEW.action, NEW.target, NEW.ip,
+             NEW.user_agent, NEW.request_id, NEW.before::text, NEW.after::text,
+             NEW.redacted_at, NEW.chain_seq, NEW.digest, NEW.prev_hash, NEW.hash)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.actor, OLD.action, OLD.target, OLD.ip,
+             OLD.user_agent, OLD.request_id, OLD.before::text, OLD.after::text,
+             OLD.redacted_at, OLD.chain_seq, OLD.digest, OLD.prev_hash, OLD.hash) THEN
+        RETURN NEW;
+    END IF;
+    erased := current_setting('merch.erased_username', true);
+    pseudonym := current_setting('merch.erased_pseudonym', true);
+    IF TG_OP = 'UPDATE' AND NEW.redacted_at IS NOT NULL
+        AND erased <> '' AND pseudonym ~ '^erased-[0-9a-f]{16}$'
+        AND (NEW.seq, NEW.created_at, NEW.action, NEW.request_id,
+             NEW.chain_seq, NEW.digest, NEW.prev_hash, NEW.hash)
+            IS NOT DISTINCT FROM
+            (OLD.seq, OLD.created_at, OLD.action, OLD.request_id,
+             OLD.chain_seq, OLD.digest, OLD.prev_hash, OLD.hash)
+        AND NEW.actor = CASE WHEN OLD.actor = erased THEN pseudonym ELSE OLD.actor END
+        AND NEW.target = CASE
+            WHEN OLD.target = erased THEN pseudonym
+            WHEN OLD.target = 'user:' || erased THEN 'user:' || pseudonym
+            ELSE OLD.target
+        END
+        AND NEW.ip = CASE WHEN OLD.actor = erased THEN '' ELSE OLD.ip END
+        AND NEW.user_agent = CASE WHEN OLD.actor = erased THEN '' ELSE OLD.user_agent END
+        AND NEW.before::text IS NOT DISTINCT FROM
+            replace(OLD.before::text, to_json(erased)::text, to_json(pseudonym)::text)
+        AND NEW.after::text IS NOT DISTINCT FROM
+            replace(OLD.after::text, to_json(erased)::text, to_json(pseudonym)::text) THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Corrections of user balances by admins. A negative amount took coins away.
+CREATE TABLE balance_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES users(username) ON DELETE RESTRICT,
+    currency TEXT NOT NULL REFERENCES currencies(code),
+    amount INTEGER NOT NULL CHECK (amount <> 0),
+    reason TEXT NOT NULL DEFAULT '',
+    adjusted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX balance_adjustments_username_idx ON balance_adjustments (username, created_at);
@@ -0,0 +1,143 @@
+package ldap
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// BER tags of the LDAP messages used here (RFC 4511).
+const (
+	tagInteger          = 0x02
+	tagOctetString      = 0x04
+	tagEnumerated       = 0x0a
+	tagSequence         = 0x30
+	tagBindRequest      = 0x60
+	tagBindResponse     = 0x61
+	tagUnbindRequest    = 0x42
+	tagExtendedRequest  = 0x77
+	tagExtendedResponse = 0x78
+	tagSimpleAuth       = 0x80
+	tagRequestName      = 0x80
+)
+
+// maxElementLength bounds what a server response may make us allocate.
+const maxElementLength = 1 << 20
+
+type element struct {
+	tag   byte
+	value []byte
+}
+
+func encodeLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+func encode(tag byte, value []byte) []byte {
+	out := append([]byte{tag}, encodeLength(len(value))...)
+	return append(out, value...)
+}
+
+// encodeInt encodes v in the fewest two's complement bytes.
+func encodeInt(tag byte, v int64) []byte {
+	var b []byte
+	for {
+		b = append([]byte{byte(v)}, b...)
+		if v >= -0x80 && v < 0x80 {
+			break
+		}
+		v >>= 8
+	}
+	return encode(tag, b)
+}
+
+func concat(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+func message(id int64, op []byte) []byte {
+	return encode(tagSequence, concat(encodeInt(tagInteger, id), op))
+}
+
+func bindRequest(dn, password string) []byte {
+	return encode(tagBindRequest, concat(
+		encodeInt(tagInteger, 3),
+		encode(tagOctetString, []byte(dn)),
+		encode(tagSimpleAuth, []byte(password)),
+	))
+}
+
+func unbindRequest() []byte {
+	return encode(tagUnbindRequest, nil)
+}
+
+func extendedRequest(oid string) []byte {
+	return encode(tagExtendedRequest, encode(tagRequestName, []byte(oid)))
+}
+
+func readElement(r io.Reader) (element, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return element{}, err
+	}
+	e := element{tag: head[0]}
+	n := int(head[1])
+	if n&0x80 != 0 {
+		size := n & 0x7f
+		if size == 0 || size > 4 {
+			return e, errors.New("unsupported ber length")
+		}
+		lb := make([]byte, size)
+		if _, err := io.ReadFull(r, lb); err != nil {
+			return e, err
+		}
+		n = 0
+		for _, b := range lb {
+			n = n<<8 | int(b)
+		}
+	}
+	if n > maxElementLength {
+		return e, errors.New("ldap response too large")
+	}
+	e.value = make([]byte, n)
+	if _, err := io.ReadFull(r, e.value); err != nil {
+		return e, err
+	}
+	return e, nil
+}
+
+// children decodes the elements of a constructed element.
+func (e element) children() ([]element, error) {
+	var out []element
+	r := bytes.NewReader(e.value)
+	for r.Len() > 0 {
+		c, err := readElement(r)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (e element) int() int64 {
+	var v int64
+	for i, b := range e.value {
+		if i == 0 && b&0x80 != 0 {
+			v = -1
+		}
+		v = v<<8 | int64(b)
+	}
+	return v
+}
@@ -0,0 +1,211 @@
+// Package ldap checks passwords against a directory with an LDAPv3 simple
+// bind. Only the few protocol messages needed for that are implemented.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCredentials = errors.New("invalid directory credentials")
+
+const (
+	resultSuccess            = 0
+	resultInvalidCredentials = 49
+
+	startTLSOID = "1.3.6.1.4.1.1466.20037"
+)
+
+// Config describes how users are bound to the directory.
+type Config struct {
+	// URL is ldap://host[:port] or ldaps://host[:port].
+	URL string
+	// BindDN is the DN template users bind as, with %s standing for the
+	// escaped username, e.g. uid=%s,ou=people,dc=example,dc=com, or
+	// %s@corp.example.com for Active Directory.
+	BindDN string
+	// StartTLS upgrades ldap:// connections before binding. It has no effect
+	// on ldaps:// connections, which are encrypted from the start.
+	StartTLS bool
+	// TLS configures ldaps:// and StartTLS; the server name defaults to the
+	// URL host.
+	TLS     *tls.Config
+	Timeout time.Duration
+}
+
+type Client struct {
+	cfg     Config
+	network string
+	addr    string
+	host    string
+	secure  bool
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ldap url: %w", err)
+	}
+	c := &Client{cfg: cfg, network: "tcp", host: u.Hostname()}
+	port := u.Port()
+	switch u.Scheme {
+	case "ldap":
+		if port == "" {
+			port = "389"
+		}
+	case "ldaps":
+		c.secure = true
+		if port == "" {
+			port = "636"
+		}
+	default:
+		return nil, fmt.Errorf("ldap url scheme must be ldap or ldaps, got %q", u.Scheme)
+	}
+	if c.host == "" {
+		return nil, errors.New("ldap url has no host")
+	}
+	if strings.Count(cfg.BindDN, "%s") != 1 {
+		return nil, errors.New("ldap bind dn template must contain %s exactly once")
+	}
+	if c.secure {
+		c.cfg.StartTLS = false
+	}
+	if c.cfg.Timeout == 0 {
+		c.cfg.Timeout = 10 * time.Second
+	}
+	c.addr = net.JoinHostPort(c.host, port)
+	return c, nil
+}
+
+func (c *Client) tlsConfig() *tls.Config {
+	cfg := &tls.Config{}
+	if c.cfg.TLS != nil {
+		cfg = c.cfg.TLS.Clone()
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = c.host
+	}
+	return cfg
+}
+
+// Authenticate binds as the user and returns ErrInvalidCredentials if the
+// directory rejects the password. Empty passwords are rejected up front, as
+// directories treat such binds as anonymous and let them succeed.
+func (c *Client) Authenticate(ctx context.Context, username, password string) error {
+	if username == "" || password == "" {
+		return ErrInvalidCredentials
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.network, c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to ldap server: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if c.secure {
+		conn = tls.Client(conn, c.tlsConfig())
+	}
+
+	msgID := int64(1)
+	if c.cfg.StartTLS {
+		req := extendedRequest(startTLSOID)
+		if err := roundTrip(conn, msgID, req, tagExtendedResponse); err != nil {
+			return fmt.Errorf("ldap starttls failed: %w", err)
+		}
+		msgID++
+		tlsConn := tls.Client(conn, c.tlsConfig())
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("ldap starttls handshake failed: %w", err)
+		}
+		conn = tlsConn
+	}
+
+	dn := fmt.Sprintf(c.cfg.BindDN, EscapeDN(username))
+	err = roundTrip(conn, msgID, bindRequest(dn, password), tagBindResponse)
+	var re *resultError
+	if errors.As(err, &re) && re.code == resultInvalidCredentials {
+		return ErrInvalidCredentials
+	}
+	if err != nil {
+		return fmt.Errorf("ldap bind failed: %w", err)
+	}
+	_, _ = conn.Write(message(msgID+1, unbindRequest()))
+	return nil
+}
+
+type resultError struct {
+	code       int64
+	diagnostic string
+}
+
+func (e *resultError) Error() string {
+	if e.diagnostic == "" {
+		return fmt.Sprintf("ldap result code %d", e.code)
+	}
+	return fmt.Sprintf("ldap result code %d: %s", e.code, e.diagnostic)
+}
+
+// roundTrip sends one request and reads its response, which carries an
+// LDAPResult: resultCode, matchedDN and diagnosticMessage.
+func roundTrip(conn net.Conn, msgID int64, op []byte, responseTag byte) error {
+	if _, err := conn.Write(message(msgID, op)); err != nil {
+		return err
+	}
+	msg, err := readElement(conn)
+	if err != nil {
+		return err
+	}
+	if msg.tag != tagSequence {
+		return fmt.Errorf("unexpected ldap message tag %#x", msg.tag)
+	}
+	parts, err := msg.children()
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 || parts[0].tag != tagInteger || parts[1].tag != responseTag {
+		return errors.New("unexpected ldap response")
+	}
+	if id := parts[0].int(); id != msgID {
+		return fmt.Errorf("ldap response to message %d, expected %d", id, msgID)
+	}
+	result, err := parts[1].children()
+	if err != nil {
+		return err
+	}
+	if len(result) < 3 || result[0].tag != tagEnumerated {
+		return errors.New("malformed ldap result")
+	}
+	if code := result[0].int(); code != resultSuccess {
+		return &resultError{code: code, diagnostic: string(result[2].value)}
+	}
+	return nil
+}
+
+// EscapeDN escapes a value for use in a distinguished name (RFC 4514).
+func EscapeDN(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == 0:
+			b.WriteString(`\00`)
+			continue
+		case strings.ContainsRune(`,+"\<>;=`, r),
+			i == 0 && (r == ' ' || r == '#'),
+			i == len(s)-1 && r == ' ':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -1,26 +1,73 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"merchshop/internal/model"
+
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
 )
 
 var jwtSecretKey = []byte("wqjeklasjdasnj")
 
-func JWTMiddleware() gin.HandlerFunc {
+// publicPaths are served without a token.
+var publicPaths = map[string]bool{
+	"/api/auth":               true,
+	"/api/auth/mfa":           true,
+	"/api/auth/mfa/enroll":    true,
+	"/api/auth/oidc/login":    true,
+	"/api/auth/oidc/callback": true,
+	"/api/auth/oidc/link":     true,
+	"/api/password/reset":     true,
+}
+
+// apiKeyScopes are the routes service accounts may call with an API key and
+// the scope each of them requires.
+var apiKeyScopes = map[string]string{
+	"POST /api/coins/grant":         model.ScopeCoinsGrant,
+	"GET /api/users/:username/info": model.ScopeInfoRead,
+}
+
+// APIKeyVerifier resolves an API key to its service account, checking that
+// the key grants scope and recording its use for route.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key, route, scope string) (string, error)
+}
+
+// CredentialVerifier checks tokens and API keys against the current state of
+// the accounts they were issued to.
+type CredentialVerifier interface {
+	APIKeyVerifier
+	// VerifyToken returns model.ErrTokenRevoked if the token issued to
+	// username at version is no longer valid.
+	VerifyToken(ctx context.Context, username string, version uint32) error
+}
+
+// JWTMiddleware authenticates users with a bearer JWT, checked with auth
+// for revocation, and stores their name under "username". Requests with an
+// API key in the X-API-Key header or as "Authorization: ApiKey <key>" are
+// checked by auth instead and get the service account name under
+// "serviceAccount"; they are limited to the routes in apiKeyScopes.
+func JWTMiddleware(auth CredentialVerifier) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
 		fmt.Println(c.Request.URL.Path)
 
-		if c.Request.URL.Path == "/api/auth" {
+		if publicPaths[c.Request.URL.Path] {
 			c.Next()
 			return
 		}
 
+		if key := apiKey(c); key != "" {
+			authenticateAPIKey(c, auth, key)
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": "missing authorization header"})
@@ -59,7 +106,59 @@ func JWTMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Tokens issued for something else, such as two-factor challenges,
+		// carry a purpose and do not grant access.
+		if _, ok := claims["purpose"]; ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": "invalid token"})
+			return
+		}
+
+		version, _ := claims["ver"].(float64)
+		err = auth.VerifyToken(c, sub, uint32(version))
+		if errors.Is(err, model.ErrTokenRevoked) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": err.Error()})
+			return
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"errors": err.Error()})
+			return
+		}
+
 		c.Set("username", sub)
 		c.Next()
 	}
 }
+
+func apiKey(c *gin.Context) string {
+	if key := c.GetHeader("X-API-Key"); key != "" {
+		return key
+	}
+	scheme, key, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "apikey") {
+		return key
+	}
+	return ""
+}
+
+func authenticateAPIKey(c *gin.Context, keys APIKeyVerifier, key string) {
+	route := c.Request.Method + " " + c.FullPath()
+	scope, ok := apiKeyScopes[route]
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"errors": "route is not available to api keys"})
+		return
+	}
+	account, err := keys.VerifyAPIKey(c, key, route, scope)
+	switch {
+	case errors.Is(err, model.ErrInvalidAPIKey):
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": err.Error()})
+		return
+	case errors.Is(err, model.ErrAPIKeyScope):
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"errors": err.Error()})
+		return
+	case err != nil:
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"errors": err.Error()})
+		return
+	}
+	c.Set("serviceAccount", account)
+	c.Next()
+}
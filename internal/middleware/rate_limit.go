@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"merchshop/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClientRateLimitMiddleware limits all requests per client IP with the
+// client limit of cfg. It must run before JWTMiddleware, so that requests
+// with invalid tokens or API keys are limited as well.
+func ClientRateLimitMiddleware(store ratelimit.Store, cfg ratelimit.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if takeToken(c, store, "client|ip:"+c.ClientIP(), cfg.Client) {
+			c.Next()
+		}
+	}
+}
+
+// RateLimitMiddleware limits requests per route with token buckets kept in
+// store. Authenticated requests are limited per username or service
+// account, so it must run after JWTMiddleware; the others, such as
+// /api/auth, per client IP.
+func RateLimitMiddleware(store ratelimit.Store, cfg ratelimit.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+		client := "ip:" + c.ClientIP()
+		if username := c.GetString("username"); username != "" {
+			client = "user:" + username
+		}
+		if account := c.GetString("serviceAccount"); account != "" {
+			client = "service:" + account
+		}
+		if takeToken(c, store, c.Request.Method+" "+route+"|"+client, cfg.LimitFor(c.Request.Method, route)) {
+			c.Next()
+		}
+	}
+}
+
+// takeToken takes a token from the bucket under key and reports whether the
+// request may go on; otherwise it aborts it. Responses carry
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers, and
+// rejected ones Retry-After. If the store fails, requests are let through.
+func takeToken(c *gin.Context, store ratelimit.Store, key string, limit ratelimit.Limit) bool {
+	res, err := store.Take(c.Request.Context(), key, limit)
+	if err != nil {
+		log.Printf("rate limit store failed: %v", err)
+		return true
+	}
+	c.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	c.Header("RateLimit-Reset", ceilSeconds(res.Reset))
+	c.Header("RateLimit-Policy", strconv.Itoa(limit.Requests)+";w="+ceilSeconds(time.Duration(limit.Period)))
+	if !res.Allowed {
+		c.Header("Retry-After", ceilSeconds(res.RetryAfter))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"errors": "rate limit exceeded"})
+		return false
+	}
+	return true
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
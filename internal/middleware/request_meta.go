@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"merchshop/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// RequestMetaMiddleware stores the client IP, user agent and request id of
+// the request under "requestMeta" for the audit log and login throttling.
+// The client IP is only taken from X-Forwarded-For when the request comes
+// from one of the engine's trusted proxies. The request id is taken
+// from the X-Request-ID header when present and echoed in the response.
+func RequestMetaMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(requestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			b := make([]byte, 16)
+			_, _ = rand.Read(b)
+			requestID = hex.EncodeToString(b)
+		}
+		c.Header(requestIDHeader, requestID)
+		c.Set("requestMeta", model.RequestMeta{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			RequestID: requestID,
+		})
+		c.Next()
+	}
+}
@@ -1,40 +1,867 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrUserNotFound      = errors.New("user not found")
 	ErrInvalidPassword   = errors.New("invalid password")
 	ErrItemNotFound      = errors.New("item not found")
+	ErrItemInactive      = errors.New("item is not available")
 	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrVariantNotFound   = errors.New("variant not found")
+	ErrVariantRequired   = errors.New("variant must be specified for this item")
+	ErrOutOfStock        = errors.New("variant is out of stock")
+	ErrInvalidVariant    = errors.New("variant needs a sku and a positive price, if any")
+	ErrVariantSKUTaken   = errors.New("variant sku belongs to another item")
+
+	ErrWishlistItemNotFound = errors.New("item is not in wishlist")
+	ErrNotificationNotFound = errors.New("notification not found")
+
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeInactive      = errors.New("promo code is not active")
+	ErrPromoCodeNotApplicable = errors.New("promo code does not apply to this item")
+	ErrPromoCodeExhausted     = errors.New("promo code has no uses left")
+	ErrPromoCodeLimitReached  = errors.New("promo code usage limit reached for user")
+	ErrPromoCodeExists        = errors.New("promo code already exists")
+	ErrPromoCodeUsed          = errors.New("promo code has been used and cannot be deleted")
+	ErrInvalidPromoCode       = errors.New("promo code needs a code, one discount and a positive per-user limit")
+	ErrInvalidSale            = errors.New("sale needs a name, an item or a category, one discount and a valid period")
+	ErrInvalidDiscount        = errors.New("discount must be either 1-100 percent or a positive amount off")
+	ErrSaleNotFound           = errors.New("sale not found")
+	ErrSaleUsed               = errors.New("sale has been used and cannot be deleted")
+	ErrCategoryNotFound       = errors.New("category not found")
+
+	ErrInvalidPeriod = errors.New("period start must be before its end")
+
+	ErrUnknownReport       = errors.New("unknown report, expected transfers, purchases or balances")
+	ErrUnknownReportFormat = errors.New("unknown report format, expected csv, jsonl or xlsx")
+
+	ErrForbidden               = errors.New("forbidden")
+	ErrInvalidWebhookURL       = errors.New("webhook url must be an absolute http or https url")
+	ErrUnknownWebhookEvent     = errors.New("unknown webhook event type")
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("dead webhook delivery not found")
+
+	ErrInvalidLimitScope     = errors.New("spending limits apply to a user or a role")
+	ErrSpendingLimitNotFound = errors.New("spending limits not found")
+
+	ErrWeakPassword      = errors.New("password must be at least 8 characters long")
+	ErrInvalidResetToken = errors.New("password reset token is invalid or expired")
+	ErrExternalPasswords = errors.New("passwords are managed by the directory")
+	ErrTokenRevoked      = errors.New("token has been revoked, sign in again")
+
+	ErrIdentityNotFound = errors.New("external identity is not linked to a user")
+	ErrIdentityConflict = errors.New("a local user with this name already exists")
+	ErrInvalidLinkToken = errors.New("invalid or expired identity link token")
+	ErrInvalidIdentity  = errors.New("external identity carries no usable username")
+
+	ErrInvalidServiceAccount  = errors.New("service account name must not be empty")
+	ErrServiceAccountExists   = errors.New("service account already exists")
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrInvalidAPIKeyScopes    = errors.New("api key scopes must be one or more of coins:grant and info:read")
+	ErrInvalidAPIKeyExpiry    = errors.New("api key expiry must be in the future")
+	ErrAPIKeyNotFound         = errors.New("active api key not found")
+	ErrInvalidAPIKey          = errors.New("invalid api key")
+	ErrAPIKeyScope            = errors.New("api key lacks the scope of this route")
+	ErrInvalidGrant           = errors.New("granted amount must be positive")
+	ErrInvalidAdjustment      = errors.New("adjustment amount must not be zero")
+
+	ErrMFANotEnrolled      = errors.New("two-factor authentication is not set up")
+	ErrMFAAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrInvalidMFACode      = errors.New("invalid two-factor code")
+	ErrInvalidMFAChallenge = errors.New("invalid or expired two-factor challenge")
+	ErrMFARequired         = errors.New("two-factor authentication is mandatory for admins")
+
+	ErrUserDeactivated  = errors.New("user account is deactivated")
+	ErrInvalidEmail     = errors.New("invalid email address")
+	ErrInvalidAvatarURL = errors.New("avatar url must be an absolute http or https url")
+	ErrProfileTooLong   = errors.New("profile fields must be at most 200 characters long")
+
+	ErrInvalidTeam           = errors.New("team name must not be empty")
+	ErrTeamExists            = errors.New("team already exists")
+	ErrTeamNotFound          = errors.New("team not found")
+	ErrInvalidTeamRole       = errors.New("team role must be member or lead")
+	ErrNotTeamMember         = errors.New("user is not a member of the team")
+	ErrInsufficientTeamFunds = errors.New("insufficient team funds")
+	ErrTeamTransferToSelf    = errors.New("team leads cannot pay themselves from the team wallet")
+	ErrInvalidAmount         = errors.New("amount must be positive")
+
+	ErrEraseSelf      = errors.New("admins cannot erase their own account")
+	ErrUsernameErased = errors.New("username belonged to an erased user and cannot be registered")
+
+	ErrInvalidCurrency         = errors.New("currency code and name must not be empty")
+	ErrInvalidCurrencyLifetime = errors.New("currency lifetime must be a positive number of days")
+	ErrCurrencyExists          = errors.New("currency already exists")
+	ErrCurrencyNotFound        = errors.New("currency not found")
+	ErrCurrencyNotTransferable = errors.New("currency cannot be transferred")
+
+	ErrInvalidAuction  = errors.New("auction must have a positive reserve price and end in the future")
+	ErrAuctionNotFound = errors.New("auction not found")
+	ErrAuctionClosed   = errors.New("auction is closed")
+	ErrBidTooLow       = errors.New("bid must reach the reserve price and beat the highest bid")
+
+	ErrInvalidGroupPurchase  = errors.New("group purchase needs an item priced in coins and a deadline in the future")
+	ErrGroupPurchaseNotFound = errors.New("group purchase not found")
+	ErrGroupPurchaseClosed   = errors.New("group purchase is closed")
+	ErrPledgeTooHigh         = errors.New("pledge exceeds the amount still missing")
+	ErrGroupPurchaseForSelf  = errors.New("recipients cannot start or pledge to their own group purchase")
+)
+
+// LoginBlockedError is returned while logins for a user or a client address
+// are blocked after repeated failures.
+type LoginBlockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginBlockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// IdentityLinkError is returned when an external identity matches a user who
+// signs in with a password. The identity is only linked once the user
+// confirms the password together with Token.
+type IdentityLinkError struct {
+	Username  string
+	Token     string
+	ExpiresAt time.Time
+}
+
+func (e *IdentityLinkError) Error() string {
+	return fmt.Sprintf("user %s signs in with a password, confirm it to link the identity", e.Username)
+}
+
+// Spending limit kinds reported in LimitExceededError.
+const (
+	LimitDailyTransfer   = "daily_transfer"
+	LimitMaxTransfer     = "max_transfer"
+	LimitMonthlyPurchase = "monthly_purchase"
 )
 
+// LimitExceededError is returned when a transfer or a purchase would exceed
+// one of the user's spending limits.
+type LimitExceededError struct {
+	Limit     string
+	Max       uint32
+	Remaining uint32
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.Limit == LimitMaxTransfer {
+		return fmt.Sprintf("%s limit exceeded: at most %d coins per transfer", e.Limit, e.Max)
+	}
+	return fmt.Sprintf("%s limit of %d coins exceeded, %d remaining", e.Limit, e.Max, e.Remaining)
+}
+
+// CoinTransferTo is a sent transfer. Team is set for transfers the user made
+// from a team wallet as its lead.
 type CoinTransferTo struct {
 	ToUsername string
 	Amount     uint32
+	Currency   string
+	Team       string
 }
 
+// CoinTransferFrom is a received transfer. Team is set for transfers from a
+// team wallet, FromUsername then being the team lead who made it.
 type CoinTransferFrom struct {
 	FromUsername string
 	Amount       uint32
+	Currency     string
+	Team         string
 }
 
 type InventoryItem struct {
-	Item   string
-	Amount uint32
+	Item    string
+	Variant string
+	Amount  uint32
 }
 
 type Purchase struct {
-	Username string
+	Username  string
+	Item      string
+	Variant   string
+	Price     uint32
+	Currency  string
+	Sale      string
+	PromoCode string
+	CreatedAt time.Time
+}
+
+// PurchaseOrder describes a purchase about to be recorded. Price is the
+// effective price after the applied sale and promo code, if any.
+type PurchaseOrder struct {
+	Username  string
+	Item      string
+	VariantID *int32
+	Price     uint32
+	Currency  string
+	SaleID    *int32
+	PromoCode string
+	// AuctionID and GroupPurchaseID mark items won at an auction and bought
+	// by a group purchase, paid with coins held by bids and pledges.
+	AuctionID       *int32
+	GroupPurchaseID *int32
+}
+
+type Product struct {
+	Item     string
+	Price    uint32
+	Currency string
+	Category string
+	Active   bool
+}
+
+// LocalizedText holds the Russian and English versions of a display string.
+type LocalizedText struct {
+	RU string
+	EN string
+}
+
+type Category struct {
+	Slug string
+	Name LocalizedText
+}
+
+type CatalogItem struct {
+	Item        string
+	Price       uint32
+	Currency    string
+	Category    string
+	Name        LocalizedText
+	Description LocalizedText
+	Images      []string
+	Variants    []ProductVariant
+}
+
+type CatalogSort string
+
+const (
+	CatalogSortDefault   CatalogSort = ""
+	CatalogSortPriceAsc  CatalogSort = "price"
+	CatalogSortPriceDesc CatalogSort = "-price"
+	CatalogSortName      CatalogSort = "name"
+)
+
+type CatalogFilter struct {
+	Category string
+	MinPrice *uint32
+	MaxPrice *uint32
+	Sort     CatalogSort
+	// Lang selects the language used when sorting by name.
+	Lang string
+}
+
+type ProductVariant struct {
+	ID    int32
+	Item  string
+	SKU   string
+	Size  string
+	Color string
+	// Stock is nil when it is not limited.
+	Stock *uint32
+	// Price overrides the product price when set.
+	Price *uint32
+	// Default marks the variant bought when no SKU is given.
+	Default bool
+}
+
+// Discount is either a percentage or a fixed number of coins off the price.
+type Discount struct {
+	PercentOff uint32
+	AmountOff  uint32
+}
+
+// Sale discounts either an item or every item of a category.
+type Sale struct {
+	ID       int32
+	Name     string
+	Item     string
+	Category string
+	Discount Discount
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+type PromoCode struct {
+	Code string
+	// Item and Category restrict the code to a product or a category when set.
+	Item         string
+	Category     string
+	Discount     Discount
+	MaxUses      *uint32
+	Uses         uint32
+	PerUserLimit uint32
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+}
+
+type WishlistItem struct {
 	Item     string
 	Price    uint32
+	Currency string
+	// Missing is how much of Currency the user still lacks to buy the item.
+	Missing uint32
+	AddedAt time.Time
+}
+
+const (
+	NotificationCoinsReceived     = "coins_received"
+	NotificationPurchaseCompleted = "purchase_completed"
+	NotificationWishlistPriceDrop = "wishlist_price_drop"
+	NotificationWishlistRestock   = "wishlist_restock"
+	NotificationCoinsGranted      = "coins_granted"
+	NotificationBalanceAdjusted   = "balance_adjusted"
+	NotificationTeamCoinsReceived = "team_coins_received"
+	NotificationAuctionOutbid     = "auction_outbid"
+	NotificationAuctionWon        = "auction_won"
+	NotificationGroupGiftReceived = "group_gift_received"
+	NotificationGroupFunded       = "group_purchase_funded"
+	NotificationGroupRefunded     = "group_purchase_refunded"
+)
+
+type Notification struct {
+	ID        int64
+	Kind      string
+	Data      map[string]any
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
+
+type NotificationFilter struct {
+	UnreadOnly bool
+	// BeforeID returns only notifications older than the given one, for paging.
+	BeforeID *int64
+	Limit    int32
+}
+
+// Period bounds a statistic to [From, To); nil bounds are open.
+type Period struct {
+	From *time.Time
+	To   *time.Time
+}
+
+type LeaderboardEntry struct {
+	Username  string
+	Coins     uint64
+	Transfers uint32
+}
+
+type ItemRanking struct {
+	Item      string
+	Purchases uint32
+	Coins     uint64
+}
+
+type UserStats struct {
+	Username      string
+	TotalReceived uint64
+	TotalSent     uint64
+	TotalSpent    uint64
+	// ColleaguesThanked is the number of distinct users the user sent coins to.
+	ColleaguesThanked uint32
+}
+
+// LoginFailures counts the recent failed logins for a user or an address.
+type LoginFailures struct {
+	Count  uint32
+	LastAt time.Time
+}
+
+// RateLimitBucket is the state of a token bucket. A zero UpdatedAt marks a
+// new bucket.
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// RequestMeta identifies the HTTP request an action came from.
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// AuditActorSystem is the actor of actions run from the command line.
+const AuditActorSystem = "system"
+
+const (
+	AuditLogin                 = "auth.login"
+	AuditLoginFailed           = "auth.login_failed"
+	AuditLoginUnlocked         = "auth.unlocked"
+	AuditUserRegistered        = "user.registered"
+	AuditRoleChanged           = "user.role_changed"
+	AuditCoinsTransferred      = "coins.transferred"
+	AuditItemPurchased         = "item.purchased"
+	AuditWebhookCreated        = "webhook.created"
+	AuditWebhookDeleted        = "webhook.deleted"
+	AuditWebhookRetried        = "webhook.delivery_retried"
+	AuditReportExported        = "report.exported"
+	AuditLimitsChanged         = "limits.changed"
+	AuditPasswordChanged       = "user.password_changed"
+	AuditPasswordResetIssued   = "user.password_reset_issued"
+	AuditPasswordReset         = "user.password_reset"
+	AuditIdentityLinked        = "auth.identity_linked"
+	AuditServiceAccountCreated = "service_account.created"
+	AuditAPIKeyCreated         = "api_key.created"
+	AuditAPIKeyRevoked         = "api_key.revoked"
+	AuditAPIKeyUsed            = "api_key.used"
+	AuditCoinsGranted          = "coins.granted"
+	AuditBalanceAdjusted       = "coins.adjusted"
+	AuditMFAEnabled            = "auth.mfa_enabled"
+	AuditMFADisabled           = "auth.mfa_disabled"
+	AuditMFAFailed             = "auth.mfa_failed"
+	AuditRecoveryCodesRenewed  = "auth.recovery_codes_renewed"
+	AuditProfileUpdated        = "user.profile_updated"
+	AuditUserDeactivated       = "user.deactivated"
+	AuditUserReactivated       = "user.reactivated"
+	AuditTeamCreated           = "team.created"
+	AuditTeamMemberSet         = "team.member_set"
+	AuditTeamMemberRemoved     = "team.member_removed"
+	AuditTeamDeposit           = "team.deposit"
+	AuditTeamTransfer          = "team.transfer"
+	AuditDataExported          = "user.data_exported"
+	AuditUserErased            = "user.erased"
+	AuditCurrencyCreated       = "currency.created"
+	AuditAuctionCreated        = "auction.created"
+	AuditAuctionBid            = "auction.bid"
+	AuditAuctionClosed         = "auction.closed"
+	AuditGroupPurchaseCreated  = "group_purchase.created"
+	AuditGroupPurchasePledge   = "group_purchase.pledge"
+	AuditGroupPurchaseFunded   = "group_purchase.funded"
+	AuditGroupPurchaseRefunded = "group_purchase.refunded"
+	AuditVariantSet            = "product.variant_set"
+	AuditProductCurrencySet    = "product.currency_set"
+	AuditSaleCreated           = "sale.created"
+	AuditSaleDeleted           = "sale.deleted"
+	AuditPromoCodeCreated      = "promo_code.created"
+	AuditPromoCodeDeleted      = "promo_code.deleted"
+)
+
+// AuditEntry is a record in the append-only audit log. Seq is assigned when
+// the entry is stored; PrevHash and Hash stay empty until the entry is linked
+// to the hash chain in the background.
+type AuditEntry struct {
+	Seq       int64
+	CreatedAt time.Time
+	Actor     string
+	Action    string
+	Target    string
+	Meta      RequestMeta
+	Before    map[string]any
+	After     map[string]any
+	PrevHash  string
+	Hash      string
+}
+
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Period Period
+	// BeforeSeq returns only entries older than the given one, for paging.
+	BeforeSeq *int64
+	Limit     int32
+}
+
+// AuditVerification is the result of checking the audit hash chain. Entries
+// not linked to the chain yet are not checked. Redacted counts the checked
+// entries pseudonymized by erasure, whose content is checked against the
+// digest taken on redaction. BrokenSeq is the first entry that does not
+// match the chain.
+type AuditVerification struct {
+	Valid     bool
+	Checked   int64
+	Redacted  int64
+	BrokenSeq *int64
+}
+
+type ReportKind string
+
+const (
+	ReportTransfers ReportKind = "transfers"
+	ReportPurchases ReportKind = "purchases"
+	ReportBalances  ReportKind = "balances"
+)
+
+type ReportFormat string
+
+const (
+	ReportFormatCSV   ReportFormat = "csv"
+	ReportFormatJSONL ReportFormat = "jsonl"
+	// ReportFormatXLSX is CSV tuned for spreadsheet import: UTF-8 BOM, CRLF
+	// line endings and escaped formula-like cells.
+	ReportFormatXLSX ReportFormat = "xlsx"
+)
+
+type CoinTransfer struct {
+	ID           int64
+	FromUsername string
+	ToUsername   string
+	Amount       uint32
+	Currency     string
+	CreatedAt    time.Time
+}
+
+// BalanceReport is the coin flow of a user over a period. Opening and Closing
+// are the balances at the start and end of the period; users who signed up
+// during the period open at zero and receive their starting balance. Coins
+// held by auction bids and group purchase pledges count as spent, and as
+// received again when they are given back.
+type BalanceReport struct {
+	Username string
+	Opening  int64
+	Received uint64
+	Sent     uint64
+	Spent    uint64
+	Closing  int64
+}
+
+// Shop events written to the outbox, relayed downstream and delivered to
+// webhook subscribers.
+const (
+	ShopEventCoinsTransferred = "coins.transferred"
+	ShopEventItemPurchased    = "item.purchased"
+	ShopEventUserRegistered   = "user.registered"
+)
+
+// DomainEvent is a shop event published by MerchService. Events are encoded
+// as JSON in the outbox.
+type DomainEvent interface {
+	EventType() string
+}
+
+type CoinsTransferred struct {
+	FromUser string `json:"fromUser"`
+	ToUser   string `json:"toUser"`
+	Amount   uint32 `json:"amount"`
+	Currency string `json:"currency"`
 }
 
+func (CoinsTransferred) EventType() string { return ShopEventCoinsTransferred }
+
+type ItemPurchased struct {
+	Username  string `json:"username"`
+	Item      string `json:"item"`
+	Variant   string `json:"variant,omitempty"`
+	Price     uint32 `json:"price"`
+	Currency  string `json:"currency"`
+	PromoCode string `json:"promoCode,omitempty"`
+}
+
+func (ItemPurchased) EventType() string { return ShopEventItemPurchased }
+
+type UserRegistered struct {
+	Username string `json:"username"`
+}
+
+func (UserRegistered) EventType() string { return ShopEventUserRegistered }
+
+// OutboxEvent is a persisted domain event waiting to be relayed.
+type OutboxEvent struct {
+	ID        int64
+	Type      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+type WebhookSubscription struct {
+	ID     int32
+	URL    string
+	Secret string
+	// EventTypes limits the subscription to the listed events; empty means all.
+	EventTypes []string
+	Active     bool
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	EventID        int64
+	EventType      string
+	Status         string
+	Attempts       uint32
+	NextAttemptAt  time.Time
+	LastStatusCode *int32
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+type WebhookDeliveryFilter struct {
+	Status string
+	Limit  int32
+}
+
+// PendingWebhookDelivery is a delivery leased by the dispatcher together with
+// the subscription endpoint and the event to send.
+type PendingWebhookDelivery struct {
+	ID             int64
+	Attempts       uint32
+	URL            string
+	Secret         string
+	EventID        int64
+	EventType      string
+	Payload        []byte
+	EventCreatedAt time.Time
+}
+
+// WebhookAttempt is the outcome of a single delivery attempt.
+type WebhookAttempt struct {
+	Status        string
+	StatusCode    *int32
+	Error         string
+	NextAttemptAt time.Time
+}
+
+const (
+	EventBalanceChanged   = "balance"
+	EventTransferReceived = "transfer_received"
+	EventPurchase         = "purchase"
+)
+
+// UserEvent is a real-time change pushed to the user's event stream.
+type UserEvent struct {
+	Username string
+	Type     string
+	Data     map[string]any
+}
+
+const (
+	RoleUser    = "user"
+	RoleAdmin   = "admin"
+	RoleAuditor = "auditor"
+)
+
 type User struct {
 	Username     string
 	PasswordHash string
 	Coins        uint32
+	Role         string
+	Profile      Profile
+	// DeactivatedAt is set for users who can no longer sign in or receive
+	// coins. Their history is kept.
+	DeactivatedAt *time.Time
+	// TokenVersion is embedded in issued tokens; tokens of an older version
+	// are revoked.
+	TokenVersion uint32
+}
+
+// Profile is how a user presents themselves to others.
+type Profile struct {
+	DisplayName string
+	Email       string
+	Department  string
+	AvatarURL   string
+}
+
+// ProfileUpdate changes the profile fields that are not nil.
+type ProfileUpdate struct {
+	DisplayName *string
+	Email       *string
+	Department  *string
+	AvatarURL   *string
+}
+
+// DirectoryEntry is an active user as listed in the user directory, which
+// leaves out emails.
+type DirectoryEntry struct {
+	Username    string
+	DisplayName string
+	Department  string
+	AvatarURL   string
+}
+
+const (
+	TeamRoleMember = "member"
+	TeamRoleLead   = "lead"
+)
+
+// Team has a wallet of its own, funded by admins and spent by team leads on
+// transfers to the members.
+type Team struct {
+	Name        string
+	Description string
+	Coins       uint32
+	CreatedBy   string
+	CreatedAt   time.Time
+	Members     []TeamMember
+}
+
+type TeamMember struct {
+	Username string
+	Role     string
+	JoinedAt time.Time
+}
+
+const (
+	TeamTransactionDeposit  = "deposit"
+	TeamTransactionTransfer = "transfer"
+)
+
+// TeamTransaction is an entry of a team wallet ledger. Username is the
+// recipient of a transfer and empty for deposits.
+type TeamTransaction struct {
+	ID        int64
+	Team      string
+	Kind      string
+	Username  string
+	Amount    uint32
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// DataExport is what is stored about a user, handed out to the user on
+// request.
+type DataExport struct {
+	ExportedAt  time.Time
+	User        User
+	Transfers   []TransferRecord
+	Balances    []Balance
+	Purchases   []Purchase
+	AuditEvents []AuditEntry
+}
+
+// TransferRecord is a transfer the user sent or received. Team is set for
+// transfers from a team wallet.
+type TransferRecord struct {
+	FromUsername string
+	ToUsername   string
+	Amount       uint32
+	Currency     string
+	Team         string
+	CreatedAt    time.Time
+}
+
+// PasswordResetToken is a one-time token letting a user set a new password.
+type PasswordResetToken struct {
+	Username  string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// AuthResult is the outcome of a login: the token, or a challenge for users
+// who have to pass two-factor authentication first. RecoveryCodes are set
+// when TOTP was set up while signing in.
+type AuthResult struct {
+	Token         string
+	Challenge     *MFAChallenge
+	RecoveryCodes []string
+}
+
+// MFAChallenge has to be completed with a TOTP or recovery code before the
+// token is issued. EnrollmentRequired is set for admins who have not set up
+// TOTP yet; they enroll with the challenge token first.
+type MFAChallenge struct {
+	Token              string
+	EnrollmentRequired bool
+	ExpiresAt          time.Time
+}
+
+// UserMFA is a user's TOTP enrollment, pending until the first code is
+// confirmed. LastUsedStep is the time step of the last accepted code.
+type UserMFA struct {
+	Username     string
+	Secret       string
+	Confirmed    bool
+	LastUsedStep int64
+}
+
+// MFAEnrollment is what an authenticator app is set up with.
+type MFAEnrollment struct {
+	Secret string
+	URI    string
+}
+
+type MFAStatus struct {
+	Enabled           bool
+	RecoveryCodesLeft uint32
+}
+
+// ExternalIdentity is a user authenticated by an external identity provider.
+// Username is the name the user gets when they sign in for the first time.
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified bool
+}
+
+// Actor is who performs an action: a signed-in user or a service account
+// authenticated with an API key.
+type Actor struct {
+	Username       string
+	ServiceAccount string
+}
+
+// String returns the actor's name in the audit log. Service accounts are
+// prefixed with "service:".
+func (a Actor) String() string {
+	if a.ServiceAccount != "" {
+		return "service:" + a.ServiceAccount
+	}
+	return a.Username
+}
+
+// API key scopes, each granting access to a set of routes.
+const (
+	ScopeCoinsGrant = "coins:grant"
+	ScopeInfoRead   = "info:read"
+)
+
+type ServiceAccount struct {
+	Name        string
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+// APIKey authenticates a service account. Key is only known when the key is
+// created; afterwards the key is identified by its ID and Prefix.
+type APIKey struct {
+	ID             int32
+	ServiceAccount string
+	Prefix         string
+	Key            string
+	Scopes         []string
+	CreatedBy      string
+	CreatedAt      time.Time
+	ExpiresAt      *time.Time
+	LastUsedAt     *time.Time
+	RevokedAt      *time.Time
+}
+
+// CoinGrant is a credit of coins, or of another currency, issued by an
+// admin or a service account rather than transferred from another user.
+type CoinGrant struct {
+	ToUser    string
+	Amount    int32
+	Currency  string
+	Reason    string
+	GrantedBy string
+}
+
+// BalanceAdjustment is a correction of a user's balance by an admin; a
+// negative amount takes coins away.
+type BalanceAdjustment struct {
+	Username   string
+	Amount     int32
+	Currency   string
+	Reason     string
+	AdjustedBy string
 }
 
 type CoinHistory struct {
@@ -44,6 +871,134 @@ type CoinHistory struct {
 
 type Info struct {
 	Coins       uint32
+	Balances    []Balance
 	Inventory   []InventoryItem
 	CoinHistory CoinHistory
+	Allowance   SpendingAllowance
+}
+
+const (
+	LimitScopeUser = "user"
+	LimitScopeRole = "role"
+)
+
+// SpendingLimits caps how many coins a user may move. Days and months are
+// calendar periods in UTC. Nil fields are not limited.
+type SpendingLimits struct {
+	// DailyTransfer caps the coins sent to other users per day.
+	DailyTransfer *uint32
+	// MaxTransfer caps a single transfer.
+	MaxTransfer *uint32
+	// MonthlyPurchase caps the coins spent on purchases per month.
+	MonthlyPurchase *uint32
+}
+
+// SpendingLimitRule sets the limits of a single user or of every user with
+// a role. A user's own limits take precedence over their role's.
+type SpendingLimitRule struct {
+	Scope     string
+	Subject   string
+	Limits    SpendingLimits
+	UpdatedAt time.Time
+}
+
+type Allowance struct {
+	Limit     uint32
+	Remaining uint32
+}
+
+// SpendingAllowance is what is left of the user's limits in the current
+// period. Nil fields are not limited.
+type SpendingAllowance struct {
+	DailyTransfer   *Allowance
+	MaxTransfer     *uint32
+	MonthlyPurchase *Allowance
+}
+
+// DefaultCurrency is the currency of coins, kept in User.Coins. Balances in
+// other currencies are made of lots credited separately.
+const DefaultCurrency = "coins"
+
+// Currency is a kind of points users can hold. Non-transferable currencies
+// can only be granted and spent; with a lifetime, every credit expires that
+// many days after it was made.
+type Currency struct {
+	Code         string
+	Name         string
+	Transferable bool
+	LifetimeDays *uint32
+	CreatedAt    time.Time
+}
+
+// BalanceLot is an amount of a currency that expires at ExpiresAt, if set.
+type BalanceLot struct {
+	Amount    int32
+	ExpiresAt *time.Time
+}
+
+// Balance is what the user holds in a currency. NextExpiresAt is when the
+// earliest expiring part of it expires, if any does.
+type Balance struct {
+	Currency      string
+	Amount        uint32
+	NextExpiresAt *time.Time
+}
+
+// Auction sells a one-off item to the highest bidder. The highest bid holds
+// the bidder's coins until it is outbid or the auction closes, when it is
+// converted into a purchase of the item. HighestBid is zero before the first
+// bid.
+type Auction struct {
+	ID            int32
+	Item          string
+	ReservePrice  uint32
+	EndsAt        time.Time
+	CreatedBy     string
+	CreatedAt     time.Time
+	ClosedAt      *time.Time
+	Winner        string
+	HighestBid    uint32
+	HighestBidder string
+	Bids          []AuctionBid
+}
+
+// AuctionBid is a bid placed on an auction. Released bids were outbid and
+// their coins given back.
+type AuctionBid struct {
+	Username  string
+	Amount    uint32
+	CreatedAt time.Time
+	Released  bool
+}
+
+const (
+	GroupPurchaseOpen     = "open"
+	GroupPurchaseFunded   = "funded"
+	GroupPurchaseRefunded = "refunded"
+)
+
+// GroupPurchase pools the coins of several users to buy an item as a gift
+// for the recipient. Pledges hold the pledgers' coins until the pledged
+// amount reaches the price, when the item is bought, or until the deadline
+// passes, when they are refunded.
+type GroupPurchase struct {
+	ID        int32
+	Item      string
+	Variant   string
+	VariantID *int32
+	Recipient string
+	Price     uint32
+	Pledged   uint32
+	Deadline  time.Time
+	CreatedBy string
+	CreatedAt time.Time
+	Status    string
+	ClosedAt  *time.Time
+	Pledges   []Pledge
+}
+
+type Pledge struct {
+	Username  string
+	Amount    uint32
+	CreatedAt time.Time
 }
@@ -0,0 +1,271 @@
+// Package nats publishes messages to a NATS server over its text protocol.
+// Only what publishing needs is implemented: the INFO/CONNECT handshake,
+// HPUB and PING/PONG to learn that the server has processed a message.
+package nats
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxLineLength bounds the protocol lines read from the server.
+const maxLineLength = 1 << 20
+
+// Config describes the NATS server messages are published to.
+type Config struct {
+	// URL is nats://[user:password@]host[:port], or tls://... to require
+	// TLS. Servers that require TLS get it with either scheme.
+	URL string
+	// TLS configures encrypted connections; the server name defaults to the
+	// URL host.
+	TLS     *tls.Config
+	Timeout time.Duration
+}
+
+// serverInfo is the part of the server's INFO message used here.
+type serverInfo struct {
+	Headers      bool  `json:"headers"`
+	TLSRequired  bool  `json:"tls_required"`
+	AuthRequired bool  `json:"auth_required"`
+	MaxPayload   int64 `json:"max_payload"`
+}
+
+// Publisher keeps a connection to the server, redialling after errors.
+type Publisher struct {
+	cfg      Config
+	addr     string
+	host     string
+	user     string
+	password string
+	secure   bool
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+	info serverInfo
+}
+
+func NewPublisher(cfg Config) (*Publisher, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nats url: %w", err)
+	}
+	p := &Publisher{cfg: cfg, host: u.Hostname()}
+	switch u.Scheme {
+	case "nats":
+	case "tls":
+		p.secure = true
+	default:
+		return nil, fmt.Errorf("nats url scheme must be nats or tls, got %q", u.Scheme)
+	}
+	if p.host == "" {
+		return nil, errors.New("nats url has no host")
+	}
+	port := u.Port()
+	if port == "" {
+		port = "4222"
+	}
+	if u.User != nil {
+		p.user = u.User.Username()
+		p.password, _ = u.User.Password()
+	}
+	if p.cfg.Timeout == 0 {
+		p.cfg.Timeout = 10 * time.Second
+	}
+	p.addr = net.JoinHostPort(p.host, port)
+	return p, nil
+}
+
+// Publish sends data to subject and waits until the server has processed
+// it. The key goes in the Nats-Msg-Id header, which JetStream streams use
+// to drop duplicates.
+func (p *Publisher) Publish(ctx context.Context, subject, key string, data []byte) error {
+	if subject == "" || strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("invalid nats subject %q", subject)
+	}
+	if strings.ContainsAny(key, "\r\n") {
+		return errors.New("nats message key must not contain line breaks")
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if err := p.publish(ctx, subject, key, data); err != nil {
+		p.conn.Close()
+		p.conn = nil
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection, if there is one.
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn = nil
+	return err
+}
+
+func (p *Publisher) tlsConfig() *tls.Config {
+	cfg := &tls.Config{}
+	if p.cfg.TLS != nil {
+		cfg = p.cfg.TLS.Clone()
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = p.host
+	}
+	return cfg
+}
+
+// connect dials the server, reads its INFO, upgrades to TLS when either
+// side requires it and sends CONNECT, confirmed with a PING.
+func (p *Publisher) connect(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to nats server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	r := bufio.NewReader(conn)
+	line, err := readLine(r)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to read nats server info: %w", err)
+	}
+	op, args, _ := strings.Cut(line, " ")
+	var info serverInfo
+	if !strings.EqualFold(op, "INFO") || json.Unmarshal([]byte(args), &info) != nil {
+		conn.Close()
+		return errors.New("unexpected nats server greeting")
+	}
+	if !info.Headers {
+		conn.Close()
+		return errors.New("nats server does not support message headers")
+	}
+	if p.secure || info.TLSRequired {
+		tlsConn := tls.Client(conn, p.tlsConfig())
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return fmt.Errorf("nats tls handshake failed: %w", err)
+		}
+		conn = tlsConn
+		r = bufio.NewReader(conn)
+	}
+
+	connect, err := json.Marshal(map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"headers":  true,
+		"lang":     "go",
+		"version":  "1.0.0",
+		"name":     "merch",
+		"user":     p.user,
+		"pass":     p.password,
+	})
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	p.conn, p.r, p.info = conn, r, info
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", connect); err != nil {
+		p.conn.Close()
+		p.conn = nil
+		return fmt.Errorf("failed to send nats connect: %w", err)
+	}
+	if err := p.awaitPong(); err != nil {
+		p.conn.Close()
+		p.conn = nil
+		return fmt.Errorf("nats connect failed: %w", err)
+	}
+	return nil
+}
+
+func (p *Publisher) publish(ctx context.Context, subject, key string, data []byte) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = p.conn.SetDeadline(deadline)
+	}
+	header := "NATS/1.0\r\n"
+	if key != "" {
+		header += "Nats-Msg-Id: " + key + "\r\n"
+	}
+	header += "\r\n"
+	total := len(header) + len(data)
+	if p.info.MaxPayload > 0 && int64(total) > p.info.MaxPayload {
+		return fmt.Errorf("nats message of %d bytes exceeds the server limit of %d", total, p.info.MaxPayload)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "HPUB %s %d %d\r\n", subject, len(header), total)
+	b.WriteString(header)
+	b.Write(data)
+	b.WriteString("\r\nPING\r\n")
+	if _, err := p.conn.Write([]byte(b.String())); err != nil {
+		return fmt.Errorf("failed to publish to nats: %w", err)
+	}
+	if err := p.awaitPong(); err != nil {
+		return fmt.Errorf("nats publish failed: %w", err)
+	}
+	return nil
+}
+
+// awaitPong reads server messages until the PONG answering our PING,
+// answering the server's own PINGs on the way.
+func (p *Publisher) awaitPong() error {
+	for {
+		line, err := readLine(p.r)
+		if err != nil {
+			return err
+		}
+		op, args, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(op) {
+		case "PONG":
+			return nil
+		case "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case "-ERR":
+			return fmt.Errorf("nats server error: %s", strings.Trim(args, "' "))
+		case "+OK", "INFO":
+		default:
+			return fmt.Errorf("unexpected nats message %q", op)
+		}
+	}
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := r.ReadLine()
+		if err != nil {
+			return "", err
+		}
+		line = append(line, chunk...)
+		if len(line) > maxLineLength {
+			return "", errors.New("nats protocol line too long")
+		}
+		if !isPrefix {
+			return string(line), nil
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package ratelimit implements token bucket rate limiting with pluggable
+// bucket stores.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+// Limit allows Requests requests per Period, refilled continuously, with
+// bursts of up to Requests.
+type Limit struct {
+	Requests int      `json:"requests"`
+	Period   Duration `json:"period"`
+}
+
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / time.Duration(l.Period).Seconds()
+}
+
+// refillTime is how long an empty bucket takes to fill up to its burst.
+func (l Limit) refillTime() time.Duration {
+	return seconds(float64(l.Requests) / l.rate())
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until a token is available when not allowed.
+	RetryAfter time.Duration
+}
+
+// Take refills the bucket for the time elapsed since its last update and
+// takes a token from it if one is available. A bucket that was never updated
+// starts full.
+func (l Limit) Take(b *model.RateLimitBucket, now time.Time) Result {
+	capacity := float64(l.Requests)
+	if b.UpdatedAt.IsZero() {
+		b.Tokens = capacity
+	} else if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(capacity, b.Tokens+elapsed*l.rate())
+	}
+	b.UpdatedAt = now
+
+	res := Result{Limit: l.Requests}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.Tokens) / l.rate())
+	}
+	res.Remaining = int(b.Tokens)
+	res.Reset = seconds((capacity - b.Tokens) / l.rate())
+	return res
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// Store keeps token buckets by key.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Config holds the default limit and per-route overrides keyed by
+// "<METHOD> <route>", with routes as registered in gin, e.g.
+// "GET /api/buy/:item". Client limits all requests from a client address
+// before they are authenticated, so that requests with bad tokens or keys
+// are limited too.
+type Config struct {
+	Client  Limit            `json:"client"`
+	Default Limit            `json:"default"`
+	Routes  map[string]Limit `json:"routes"`
+}
+
+// DefaultConfig is used when no configuration file is given.
+var DefaultConfig = Config{
+	Client:  Limit{Requests: 1200, Period: Duration(time.Minute)},
+	Default: Limit{Requests: 300, Period: Duration(time.Minute)},
+	Routes: map[string]Limit{
+		"POST /api/auth":     {Requests: 20, Period: Duration(time.Minute)},
+		"POST /api/sendCoin": {Requests: 30, Period: Duration(time.Minute)},
+		"GET /api/buy/:item": {Requests: 30, Period: Duration(time.Minute)},
+	},
+}
+
+// LoadConfig reads a JSON configuration file, for example:
+//
+//	{
+//	  "client": {"requests": 1200, "period": "1m"},
+//	  "default": {"requests": 300, "period": "1m"},
+//	  "routes": {"POST /api/sendCoin": {"requests": 10, "period": "1m"}}
+//	}
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("invalid rate limit config: %w", err)
+	}
+	for route, l := range cfg.Routes {
+		if l.Requests <= 0 || l.Period <= 0 {
+			return Config{}, fmt.Errorf("invalid rate limit for %q", route)
+		}
+	}
+	if cfg.Default.Requests <= 0 || cfg.Default.Period <= 0 {
+		return Config{}, fmt.Errorf("invalid default rate limit")
+	}
+	if cfg.Client == (Limit{}) {
+		cfg.Client = DefaultConfig.Client
+	} else if cfg.Client.Requests <= 0 || cfg.Client.Period <= 0 {
+		return Config{}, fmt.Errorf("invalid client rate limit")
+	}
+	return cfg, nil
+}
+
+// LimitFor returns the limit of a route.
+func (c Config) LimitFor(method, route string) Limit {
+	if l, ok := c.Routes[method+" "+route]; ok {
+		return l
+	}
+	return c.Default
+}
+
+// Duration is a time.Duration encoded in JSON as a string like "1m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
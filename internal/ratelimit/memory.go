@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+// pruneBatch is how many buckets are looked at for every new one.
+const pruneBatch = 16
+
+// memoryBucket is a bucket with the time it takes to refill under the limit
+// it was last taken from. A bucket idle for that long is full again and as
+// good as a new one, so it can be dropped.
+type memoryBucket struct {
+	model.RateLimitBucket
+	refill time.Duration
+}
+
+// MemoryStore keeps buckets in process memory. Limits are enforced per
+// backend instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (m *MemoryStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	b, ok := m.buckets[key]
+	if !ok {
+		m.prune(now)
+		b = &memoryBucket{}
+		m.buckets[key] = b
+	}
+	b.refill = limit.refillTime()
+	return limit.Take(&b.RateLimitBucket, now), nil
+}
+
+// prune drops buckets that have been idle long enough to refill, among a few
+// ones. Map iteration starts at a random
+// position, so pruning a little for every new bucket keeps the map bounded
+// without ever scanning it whole under the lock.
+func (m *MemoryStore) prune(now time.Time) {
+	n := 0
+	for key, b := range m.buckets {
+		if now.Sub(b.UpdatedAt) >= b.refill {
+			delete(m.buckets, key)
+		}
+		if n++; n == pruneBatch {
+			return
+		}
+	}
+}
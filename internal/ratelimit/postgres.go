@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+// BucketRepository persists buckets. UpdateRateLimitBucket must call fn with
+// the stored bucket, or a zero one, while holding a lock on the key, and save
+// the bucket fn leaves behind.
+type BucketRepository interface {
+	UpdateRateLimitBucket(ctx context.Context, key string, fn func(b *model.RateLimitBucket)) error
+	DeleteIdleRateLimitBuckets(ctx context.Context, idle time.Duration) (int64, error)
+}
+
+const bucketCleanupInterval = 10 * time.Minute
+
+// PostgresStore shares buckets between backend instances through the
+// database.
+type PostgresStore struct {
+	repo BucketRepository
+	// maxRefill is the longest refill time of the limits taken from, in
+	// nanoseconds. Buckets idle for longer are full under any of them.
+	maxRefill atomic.Int64
+}
+
+func NewPostgresStore(repo BucketRepository) *PostgresStore {
+	return &PostgresStore{repo: repo}
+}
+
+func (p *PostgresStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	refill := int64(limit.refillTime())
+	for {
+		cur := p.maxRefill.Load()
+		if refill <= cur || p.maxRefill.CompareAndSwap(cur, refill) {
+			break
+		}
+	}
+	var res Result
+	err := p.repo.UpdateRateLimitBucket(ctx, key, func(b *model.RateLimitBucket) {
+		res = limit.Take(b, time.Now())
+	})
+	return res, err
+}
+
+// Run deletes buckets idle long enough to have refilled until ctx is
+// cancelled. Nothing is deleted before the first Take, when it is not known
+// yet how long buckets take to refill.
+func (p *PostgresStore) Run(ctx context.Context) {
+	ticker := time.NewTicker(bucketCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		idle := time.Duration(p.maxRefill.Load())
+		if idle == 0 {
+			continue
+		}
+		if _, err := p.repo.DeleteIdleRateLimitBuckets(ctx, idle); err != nil && ctx.Err() == nil {
+			log.Printf("failed to delete idle rate limit buckets: %v", err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+// MemoryLoginAttemptStore keeps failed logins in process memory. It is only
+// suitable for a single backend instance.
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	failures map[string]model.LoginFailures
+}
+
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{failures: make(map[string]model.LoginFailures)}
+}
+
+func (m *MemoryLoginAttemptStore) GetLoginFailures(ctx context.Context, key string, window time.Duration) (model.LoginFailures, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.failures[key]
+	if !ok || time.Since(f.LastAt) >= window {
+		return model.LoginFailures{}, nil
+	}
+	return f, nil
+}
+
+func (m *MemoryLoginAttemptStore) UpdateLoginFailures(ctx context.Context, key string, window time.Duration, fn func(f *model.LoginFailures)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	f := m.failures[key]
+	if now.Sub(f.LastAt) >= window {
+		f = model.LoginFailures{}
+	}
+	fn(&f)
+	m.failures[key] = f
+	m.prune(now, window)
+	return nil
+}
+
+func (m *MemoryLoginAttemptStore) ResetLoginFailures(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.failures, key)
+	return nil
+}
+
+// prune drops expired entries once the map grows, so that attempts with many
+// usernames or addresses do not accumulate forever.
+func (m *MemoryLoginAttemptStore) prune(now time.Time, window time.Duration) {
+	if len(m.failures) < 10000 {
+		return
+	}
+	for key, f := range m.failures {
+		if now.Sub(f.LastAt) >= window {
+			delete(m.failures, key)
+		}
+	}
+}
@@ -3,17 +3,163 @@ package repository
 import (
 	"context"
 	"merchshop/internal/model"
+	"time"
 )
 
+// EventListener delivers user events published by MerchRepository writes.
+type EventListener interface {
+	ListenEvents(ctx context.Context, fn func(model.UserEvent)) error
+}
+
+// EventPublisher publishes domain events. MerchRepository persists them in the
+// outbox, so events published inside Atomic are committed or rolled back
+// together with the changes they describe.
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.DomainEvent) error
+}
+
+// LoginAttemptStore tracks failed logins per key. Failures older than the
+// window are forgotten. UpdateLoginFailures runs fn on the failures of a key
+// and stores the result atomically with respect to other updates of the key.
+type LoginAttemptStore interface {
+	GetLoginFailures(ctx context.Context, key string, window time.Duration) (model.LoginFailures, error)
+	UpdateLoginFailures(ctx context.Context, key string, window time.Duration, fn func(f *model.LoginFailures)) error
+	ResetLoginFailures(ctx context.Context, key string) error
+}
+
 type MerchRepository interface {
+	EventPublisher
 	Atomic(context.Context, func(r MerchRepository) error) error
 	CreateUser(ctx context.Context, username string, passwordHash string) error
 	AddCoins(ctx context.Context, username string, amount int32) error
 	DeductCoins(ctx context.Context, username string, amount int32) error
-	InsertCoinTransfer(ctx context.Context, fromUsername string, toUsername string, amount int32) error
+	InsertCoinTransfer(ctx context.Context, fromUsername string, toUsername string, currency string, amount int32) error
 	GetCoinHistorySent(ctx context.Context, username string) ([]model.CoinTransferTo, error)
 	GetCoinHistoryReceived(ctx context.Context, username string) ([]model.CoinTransferFrom, error)
 	GetInventory(ctx context.Context, username string) ([]model.InventoryItem, error)
+	GetProduct(ctx context.Context, item string) (*model.Product, error)
 	GetProductPrice(ctx context.Context, item string) (uint32, error)
+	ListCatalog(ctx context.Context, filter model.CatalogFilter) ([]model.CatalogItem, error)
+	ListCategories(ctx context.Context) ([]model.Category, error)
+	GetProductVariant(ctx context.Context, item string, sku string) (*model.ProductVariant, error)
+	GetDefaultProductVariant(ctx context.Context, item string) (*model.ProductVariant, error)
+	HasProductVariants(ctx context.Context, item string) (bool, error)
+	DecrementVariantStock(ctx context.Context, variantID int32) error
+	SetProductCurrency(ctx context.Context, item, currency string) error
+	SetProductVariant(ctx context.Context, variant model.ProductVariant) (*model.ProductVariant, error)
+	CreatePurchase(ctx context.Context, order model.PurchaseOrder) error
+	GetPurchases(ctx context.Context, username string) ([]model.Purchase, error)
+	GetActiveSales(ctx context.Context, item string) ([]model.Sale, error)
+	GetPromoCodeForUpdate(ctx context.Context, code string) (*model.PromoCode, error)
+	CountUserPromoCodeUses(ctx context.Context, code string, username string) (uint32, error)
+	IncrementPromoCodeUses(ctx context.Context, code string) error
+	CreateSale(ctx context.Context, sale model.Sale) (*model.Sale, error)
+	ListSales(ctx context.Context) ([]model.Sale, error)
+	DeleteSale(ctx context.Context, id int32) error
+	CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]model.PromoCode, error)
+	DeletePromoCode(ctx context.Context, code string) error
 	GetUser(ctx context.Context, username string) (*model.User, error)
+	LockUsers(ctx context.Context, usernames ...string) error
+	GetTopReceivers(ctx context.Context, period model.Period, limit int32) ([]model.LeaderboardEntry, error)
+	GetTopSenders(ctx context.Context, period model.Period, limit int32) ([]model.LeaderboardEntry, error)
+	GetTopItems(ctx context.Context, period model.Period, limit int32) ([]model.ItemRanking, error)
+	GetUserStats(ctx context.Context, username string, period model.Period) (*model.UserStats, error)
+	StreamTransfers(ctx context.Context, period model.Period, fn func(model.CoinTransfer) error) error
+	StreamPurchases(ctx context.Context, period model.Period, fn func(model.Purchase) error) error
+	StreamBalances(ctx context.Context, period model.Period, fn func(model.BalanceReport) error) error
+	AppendAudit(ctx context.Context, entry model.AuditEntry) error
+	ChainAuditEntries(ctx context.Context, key []byte, limit int32) (int, error)
+	GetAuditLog(ctx context.Context, filter model.AuditFilter) ([]model.AuditEntry, error)
+	VerifyAuditChain(ctx context.Context, key []byte) (*model.AuditVerification, error)
+	SetUserRole(ctx context.Context, username string, role string) error
+	AddWishlistItem(ctx context.Context, username string, item string) error
+	RemoveWishlistItem(ctx context.Context, username string, item string) error
+	GetWishlist(ctx context.Context, username string) ([]model.WishlistItem, error)
+	NotifyWishlistPriceDrops(ctx context.Context) (int64, error)
+	GetNotifications(ctx context.Context, username string, filter model.NotificationFilter) ([]model.Notification, error)
+	CreateNotification(ctx context.Context, username string, kind string, data map[string]any) error
+	MarkNotificationsRead(ctx context.Context, username string, ids []int64) error
+	MarkAllNotificationsRead(ctx context.Context, username string) error
+	CountUnreadNotifications(ctx context.Context, username string) (uint32, error)
+	ClaimOutboxEvents(ctx context.Context, lease time.Duration, limit int32) ([]model.OutboxEvent, error)
+	MarkOutboxEventsRelayed(ctx context.Context, ids []int64) error
+	ReleaseOutboxEvents(ctx context.Context, ids []int64) error
+	PruneOutboxEvents(ctx context.Context, before time.Time, limit int32) (int64, error)
+	CreateWebhookSubscription(ctx context.Context, sub model.WebhookSubscription) (*model.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id int32) error
+	GetWebhookDeliveries(ctx context.Context, subscriptionID int32, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+	RetryWebhookDelivery(ctx context.Context, id int64) error
+	LeaseWebhookDeliveries(ctx context.Context, lease time.Duration, limit int32) ([]model.PendingWebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, id int64, attempt model.WebhookAttempt) error
+	GetUserSpendingLimits(ctx context.Context, username string) (model.SpendingLimits, error)
+	ListSpendingLimits(ctx context.Context) ([]model.SpendingLimitRule, error)
+	SetSpendingLimits(ctx context.Context, rule model.SpendingLimitRule) error
+	DeleteSpendingLimits(ctx context.Context, scope string, subject string) error
+	GetCoinsSentSince(ctx context.Context, username string, since time.Time) (uint32, error)
+	GetPurchaseSpendSince(ctx context.Context, username string, since time.Time) (uint32, error)
+	UpdatePasswordHash(ctx context.Context, username string, hash string) error
+	RevokeTokens(ctx context.Context, username string) error
+	CreatePasswordResetToken(ctx context.Context, username string, tokenHash string, createdBy string, expiresAt time.Time) error
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (string, error)
+	TouchUserIdentity(ctx context.Context, identity model.ExternalIdentity) (string, error)
+	CreateUserIdentity(ctx context.Context, identity model.ExternalIdentity, username string) error
+	CreateServiceAccount(ctx context.Context, account model.ServiceAccount) (*model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error)
+	CreateAPIKey(ctx context.Context, key model.APIKey, keyHash string) (*model.APIKey, error)
+	ListAPIKeys(ctx context.Context, serviceAccount string) ([]model.APIKey, error)
+	GetActiveAPIKey(ctx context.Context, keyHash string) (*model.APIKey, error)
+	TouchAPIKeys(ctx context.Context, lastUsed map[int32]time.Time) error
+	RevokeAPIKey(ctx context.Context, id int32) error
+	InsertCoinGrant(ctx context.Context, grant model.CoinGrant) error
+	InsertBalanceAdjustment(ctx context.Context, adjustment model.BalanceAdjustment) error
+	GetUserMFA(ctx context.Context, username string) (*model.UserMFA, error)
+	SetPendingMFASecret(ctx context.Context, username string, secret string) error
+	ConfirmUserMFA(ctx context.Context, username string) error
+	UseMFAStep(ctx context.Context, username string, step int64) error
+	DeleteUserMFA(ctx context.Context, username string) error
+	ReplaceRecoveryCodes(ctx context.Context, username string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, username string, codeHash string) error
+	CountRecoveryCodes(ctx context.Context, username string) (uint32, error)
+	UpdateUserProfile(ctx context.Context, username string, profile model.Profile) error
+	SearchUsers(ctx context.Context, query string, limit int32) ([]model.DirectoryEntry, error)
+	SetUserDeactivated(ctx context.Context, username string, deactivated bool) error
+	CreateTeam(ctx context.Context, team model.Team) (*model.Team, error)
+	GetTeam(ctx context.Context, name string) (*model.Team, error)
+	ListTeams(ctx context.Context) ([]model.Team, error)
+	SetTeamMember(ctx context.Context, team string, username string, role string) error
+	RemoveTeamMember(ctx context.Context, team string, username string) error
+	GetTeamMember(ctx context.Context, team string, username string) (*model.TeamMember, error)
+	ListTeamMembers(ctx context.Context, team string) ([]model.TeamMember, error)
+	AddTeamCoins(ctx context.Context, team string, amount int32) error
+	DeductTeamCoins(ctx context.Context, team string, amount int32) error
+	InsertTeamTransaction(ctx context.Context, tx model.TeamTransaction) error
+	ListTeamTransactions(ctx context.Context, team string, limit int32) ([]model.TeamTransaction, error)
+	ExportCoinTransfers(ctx context.Context, username string) ([]model.TransferRecord, error)
+	EraseUser(ctx context.Context, key []byte, username string, pseudonym string) error
+	IsUsernameErased(ctx context.Context, username string) (bool, error)
+	CreateCurrency(ctx context.Context, currency model.Currency) (*model.Currency, error)
+	GetCurrency(ctx context.Context, code string) (*model.Currency, error)
+	ListCurrencies(ctx context.Context) ([]model.Currency, error)
+	AddBalance(ctx context.Context, username string, currency string, amount int32, expiresAt *time.Time) error
+	DeductBalance(ctx context.Context, username string, currency string, amount int32) ([]model.BalanceLot, error)
+	GetBalances(ctx context.Context, username string) ([]model.Balance, error)
+	CreateAuction(ctx context.Context, auction model.Auction) (*model.Auction, error)
+	GetAuction(ctx context.Context, id int32) (*model.Auction, error)
+	LockAuction(ctx context.Context, id int32) (*model.Auction, error)
+	ListOpenAuctions(ctx context.Context) ([]model.Auction, error)
+	ListDueAuctions(ctx context.Context, limit int32) ([]int32, error)
+	ListAuctionBids(ctx context.Context, id int32) ([]model.AuctionBid, error)
+	InsertAuctionBid(ctx context.Context, id int32, username string, amount int32) error
+	ReleaseAuctionHold(ctx context.Context, id int32) error
+	CloseAuction(ctx context.Context, id int32, winner string) error
+	CreateGroupPurchase(ctx context.Context, purchase model.GroupPurchase) (*model.GroupPurchase, error)
+	GetGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error)
+	LockGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error)
+	ListOpenGroupPurchases(ctx context.Context) ([]model.GroupPurchase, error)
+	ListExpiredGroupPurchases(ctx context.Context, limit int32) ([]int32, error)
+	ListPledges(ctx context.Context, id int32) ([]model.Pledge, error)
+	InsertPledge(ctx context.Context, id int32, username string, amount int32) error
+	CloseGroupPurchase(ctx context.Context, id int32, status string) error
 }
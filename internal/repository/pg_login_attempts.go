@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) GetLoginFailures(ctx context.Context, key string, window time.Duration) (model.LoginFailures, error) {
+	row, err := r.queries.GetLoginAttempts(ctx, queries.GetLoginAttemptsParams{
+		Key:           key,
+		WindowSeconds: int32(window.Seconds()),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.LoginFailures{}, nil
+		}
+		return model.LoginFailures{}, err
+	}
+	return model.LoginFailures{
+		Count:  uint32(row.Failures),
+		LastAt: row.LastFailureAt.Time,
+	}, nil
+}
+
+// UpdateLoginFailures runs fn on the failures stored under key and saves the
+// result. Updates of a key are serialized with a transaction-scoped advisory
+// lock, which also covers keys without failures yet.
+func (r *PgMerchRepository) UpdateLoginFailures(ctx context.Context, key string, window time.Duration, fn func(f *model.LoginFailures)) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	txRepo := &PgMerchRepository{queries: r.queries.WithTx(tx), pool: r.pool}
+
+	if err := txRepo.queries.LockLoginAttempts(ctx, key); err != nil {
+		return err
+	}
+	f, err := txRepo.GetLoginFailures(ctx, key, window)
+	if err != nil {
+		return err
+	}
+	fn(&f)
+	err = txRepo.queries.SaveLoginAttempts(ctx, queries.SaveLoginAttemptsParams{
+		Key:           key,
+		Failures:      int32(f.Count),
+		LastFailureAt: pgtype.Timestamptz{Time: f.LastAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PgMerchRepository) ResetLoginFailures(ctx context.Context, key string) error {
+	_, err := r.queries.ResetLoginAttempts(ctx, key)
+	return err
+}
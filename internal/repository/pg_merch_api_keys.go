@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateServiceAccount(ctx context.Context, account model.ServiceAccount) (*model.ServiceAccount, error) {
+	row, err := r.queries.CreateServiceAccount(ctx, queries.CreateServiceAccountParams{
+		Name:        account.Name,
+		Description: account.Description,
+		CreatedBy:   account.CreatedBy,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationErrCode {
+			return nil, model.ErrServiceAccountExists
+		}
+		return nil, err
+	}
+	created := toServiceAccount(row)
+	return &created, nil
+}
+
+func (r *PgMerchRepository) ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error) {
+	rows, err := r.queries.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var accounts []model.ServiceAccount
+	for _, row := range rows {
+		accounts = append(accounts, toServiceAccount(row))
+	}
+	return accounts, nil
+}
+
+// CreateAPIKey stores a key of a service account by the hash of the key.
+func (r *PgMerchRepository) CreateAPIKey(ctx context.Context, key model.APIKey, keyHash string) (*model.APIKey, error) {
+	params := queries.CreateAPIKeyParams{
+		ServiceAccount: key.ServiceAccount,
+		Prefix:         key.Prefix,
+		KeyHash:        keyHash,
+		Scopes:         key.Scopes,
+		CreatedBy:      key.CreatedBy,
+	}
+	if key.ExpiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: *key.ExpiresAt, Valid: true}
+	}
+	row, err := r.queries.CreateAPIKey(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return nil, model.ErrServiceAccountNotFound
+		}
+		return nil, err
+	}
+	created := toAPIKey(row)
+	return &created, nil
+}
+
+func (r *PgMerchRepository) ListAPIKeys(ctx context.Context, serviceAccount string) ([]model.APIKey, error) {
+	rows, err := r.queries.ListAPIKeys(ctx, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	var keys []model.APIKey
+	for _, row := range rows {
+		keys = append(keys, toAPIKey(row))
+	}
+	return keys, nil
+}
+
+// GetActiveAPIKey returns the unrevoked and unexpired key with the hash.
+func (r *PgMerchRepository) GetActiveAPIKey(ctx context.Context, keyHash string) (*model.APIKey, error) {
+	row, err := r.queries.GetActiveAPIKey(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	key := toAPIKey(row)
+	return &key, nil
+}
+
+// TouchAPIKeys sets when the keys were last used, keeping later times
+// already recorded.
+func (r *PgMerchRepository) TouchAPIKeys(ctx context.Context, lastUsed map[int32]time.Time) error {
+	var params queries.TouchAPIKeysParams
+	for id, at := range lastUsed {
+		params.Ids = append(params.Ids, id)
+		params.UsedAt = append(params.UsedAt, pgtype.Timestamptz{Time: at, Valid: true})
+	}
+	return r.queries.TouchAPIKeys(ctx, params)
+}
+
+func (r *PgMerchRepository) RevokeAPIKey(ctx context.Context, id int32) error {
+	rows, err := r.queries.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) InsertCoinGrant(ctx context.Context, grant model.CoinGrant) error {
+	return r.queries.InsertCoinGrant(ctx, queries.InsertCoinGrantParams{
+		ToUsername: grant.ToUser,
+		Amount:     grant.Amount,
+		Currency:   grant.Currency,
+		Reason:     grant.Reason,
+		GrantedBy:  grant.GrantedBy,
+	})
+}
+
+func (r *PgMerchRepository) InsertBalanceAdjustment(ctx context.Context, adjustment model.BalanceAdjustment) error {
+	return r.queries.InsertBalanceAdjustment(ctx, queries.InsertBalanceAdjustmentParams{
+		Username:   adjustment.Username,
+		Currency:   adjustment.Currency,
+		Amount:     adjustment.Amount,
+		Reason:     adjustment.Reason,
+		AdjustedBy: adjustment.AdjustedBy,
+	})
+}
+
+func toServiceAccount(row queries.ServiceAccount) model.ServiceAccount {
+	return model.ServiceAccount{
+		Name:        row.Name,
+		Description: row.Description,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func toAPIKey(row queries.ApiKey) model.APIKey {
+	key := model.APIKey{
+		ID:             row.ID,
+		ServiceAccount: row.ServiceAccount,
+		Prefix:         row.Prefix,
+		Scopes:         row.Scopes,
+		CreatedBy:      row.CreatedBy,
+		CreatedAt:      row.CreatedAt.Time,
+	}
+	if row.ExpiresAt.Valid {
+		key.ExpiresAt = &row.ExpiresAt.Time
+	}
+	if row.LastUsedAt.Valid {
+		key.LastUsedAt = &row.LastUsedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		key.RevokedAt = &row.RevokedAt.Time
+	}
+	return key
+}
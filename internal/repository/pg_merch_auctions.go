@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateAuction(ctx context.Context, auction model.Auction) (*model.Auction, error) {
+	row, err := r.queries.CreateAuction(ctx, queries.CreateAuctionParams{
+		Item:         auction.Item,
+		ReservePrice: int32(auction.ReservePrice),
+		EndsAt:       pgtype.Timestamptz{Time: auction.EndsAt, Valid: true},
+		CreatedBy:    auction.CreatedBy,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return nil, model.ErrItemNotFound
+		}
+		return nil, err
+	}
+	created := toAuction(queries.GetAuctionRow{
+		ID:           row.ID,
+		Item:         row.Item,
+		ReservePrice: row.ReservePrice,
+		EndsAt:       row.EndsAt,
+		CreatedBy:    row.CreatedBy,
+		CreatedAt:    row.CreatedAt,
+	})
+	return &created, nil
+}
+
+// GetAuction returns the auction and its highest bid, without the bids.
+func (r *PgMerchRepository) GetAuction(ctx context.Context, id int32) (*model.Auction, error) {
+	row, err := r.queries.GetAuction(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrAuctionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	auction := toAuction(row)
+	return &auction, nil
+}
+
+// LockAuction is GetAuction locking the auction until the transaction ends,
+// so that bids and closing are applied one at a time.
+func (r *PgMerchRepository) LockAuction(ctx context.Context, id int32) (*model.Auction, error) {
+	row, err := r.queries.LockAuction(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrAuctionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	auction := toAuction(queries.GetAuctionRow(row))
+	return &auction, nil
+}
+
+// ListOpenAuctions returns the auctions not closed yet, those ending first
+// first.
+func (r *PgMerchRepository) ListOpenAuctions(ctx context.Context) ([]model.Auction, error) {
+	rows, err := r.queries.ListOpenAuctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var auctions []model.Auction
+	for _, row := range rows {
+		auctions = append(auctions, toAuction(queries.GetAuctionRow(row)))
+	}
+	return auctions, nil
+}
+
+// ListDueAuctions returns the ids of ended auctions still to be closed.
+func (r *PgMerchRepository) ListDueAuctions(ctx context.Context, limit int32) ([]int32, error) {
+	return r.queries.ListDueAuctions(ctx, limit)
+}
+
+// ListAuctionBids returns the bids placed on the auction, oldest first.
+func (r *PgMerchRepository) ListAuctionBids(ctx context.Context, id int32) ([]model.AuctionBid, error) {
+	rows, err := r.queries.ListAuctionBids(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var bids []model.AuctionBid
+	for _, row := range rows {
+		bids = append(bids, model.AuctionBid{
+			Username:  row.Username,
+			Amount:    uint32(row.Amount),
+			CreatedAt: row.CreatedAt.Time,
+			Released:  row.ReleasedAt.Valid,
+		})
+	}
+	return bids, nil
+}
+
+func (r *PgMerchRepository) InsertAuctionBid(ctx context.Context, id int32, username string, amount int32) error {
+	err := r.queries.InsertAuctionBid(ctx, queries.InsertAuctionBidParams{
+		AuctionID: id,
+		Username:  username,
+		Amount:    amount,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ReleaseAuctionHold marks the bid holding coins on the auction as released.
+// Giving the coins back is up to the caller.
+func (r *PgMerchRepository) ReleaseAuctionHold(ctx context.Context, id int32) error {
+	rows, err := r.queries.ReleaseAuctionHold(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrAuctionNotFound
+	}
+	return nil
+}
+
+// CloseAuction closes the auction, won by winner unless it is empty.
+func (r *PgMerchRepository) CloseAuction(ctx context.Context, id int32, winner string) error {
+	rows, err := r.queries.CloseAuction(ctx, queries.CloseAuctionParams{
+		ID:     id,
+		Winner: optionalText(winner),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrAuctionClosed
+	}
+	return nil
+}
+
+func toAuction(row queries.GetAuctionRow) model.Auction {
+	a := model.Auction{
+		ID:            row.ID,
+		Item:          row.Item,
+		ReservePrice:  uint32(row.ReservePrice),
+		EndsAt:        row.EndsAt.Time,
+		CreatedBy:     row.CreatedBy,
+		CreatedAt:     row.CreatedAt.Time,
+		Winner:        row.Winner.String,
+		HighestBid:    uint32(row.Bid.Int32),
+		HighestBidder: row.Bidder.String,
+	}
+	if row.ClosedAt.Valid {
+		a.ClosedAt = &row.ClosedAt.Time
+	}
+	return a
+}
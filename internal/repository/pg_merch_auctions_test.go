@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func TestPurchaseSpendCountsAuctionHolds(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	bidder := testUser(t, r)
+	other := testUser(t, r)
+	since := time.Now().Add(-time.Minute)
+
+	won, err := r.CreateAuction(ctx, model.Auction{Item: "cup", ReservePrice: 10, EndsAt: time.Now().Add(time.Hour), CreatedBy: other})
+	if err != nil {
+		t.Fatalf("CreateAuction: %v", err)
+	}
+	outbid, err := r.CreateAuction(ctx, model.Auction{Item: "pen", ReservePrice: 10, EndsAt: time.Now().Add(time.Hour), CreatedBy: other})
+	if err != nil {
+		t.Fatalf("CreateAuction: %v", err)
+	}
+	if err := r.InsertAuctionBid(ctx, won.ID, bidder, 70); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.InsertAuctionBid(ctx, outbid.ID, bidder, 40); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ReleaseAuctionHold(ctx, outbid.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.CloseAuction(ctx, won.ID, bidder); err != nil {
+		t.Fatal(err)
+	}
+	err = r.CreatePurchase(ctx, model.PurchaseOrder{
+		Username:  bidder,
+		Item:      "cup",
+		Price:     70,
+		Currency:  model.DefaultCurrency,
+		AuctionID: &won.ID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+	err = r.CreatePurchase(ctx, model.PurchaseOrder{Username: bidder, Item: "book", Price: 50, Currency: model.DefaultCurrency})
+	if err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+
+	spent, err := r.GetPurchaseSpendSince(ctx, bidder, since)
+	if err != nil {
+		t.Fatalf("GetPurchaseSpendSince: %v", err)
+	}
+	// The won hold and the regular purchase; neither the released hold nor
+	// the purchase the won hold turned into.
+	if spent != 120 {
+		t.Fatalf("GetPurchaseSpendSince = %d, want 120", spent)
+	}
+}
@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// auditContent is the canonical form of an audit entry covered by its digest.
+type auditContent struct {
+	Seq       int64           `json:"seq"`
+	CreatedAt string          `json:"createdAt"`
+	Actor     string          `json:"actor"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	IP        string          `json:"ip"`
+	UserAgent string          `json:"userAgent"`
+	RequestID string          `json:"requestId"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+}
+
+// auditLink is what the chain hash of an entry covers.
+type auditLink struct {
+	ChainSeq int64  `json:"chainSeq"`
+	Digest   string `json:"digest"`
+	PrevHash string `json:"prevHash"`
+}
+
+// auditMAC returns the hex HMAC-SHA256 of the JSON form of v.
+func auditMAC(key []byte, v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func auditDigest(key []byte, row queries.AuditLog) (string, error) {
+	return auditMAC(key, auditContent{
+		Seq:       row.Seq,
+		CreatedAt: row.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+		Actor:     row.Actor,
+		Action:    row.Action,
+		Target:    row.Target,
+		IP:        row.Ip,
+		UserAgent: row.UserAgent,
+		RequestID: row.RequestID,
+		Before:    row.Before,
+		After:     row.After,
+	})
+}
+
+func encodeAuditValues(values map[string]any) ([]byte, error) {
+	if values == nil {
+		return nil, nil
+	}
+	return json.Marshal(values)
+}
+
+// AppendAudit stores the entry. It is linked to the hash chain later by
+// ChainAuditEntries, so appending takes no lock.
+func (r *PgMerchRepository) AppendAudit(ctx context.Context, entry model.AuditEntry) error {
+	params := queries.CreateAuditEntryParams{
+		CreatedAt: pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true},
+		Actor:     entry.Actor,
+		Action:    entry.Action,
+		Target:    entry.Target,
+		Ip:        entry.Meta.IP,
+		UserAgent: entry.Meta.UserAgent,
+		RequestID: entry.Meta.RequestID,
+	}
+	var err error
+	if params.Before, err = encodeAuditValues(entry.Before); err != nil {
+		return fmt.Errorf("failed to encode audit values: %w", err)
+	}
+	if params.After, err = encodeAuditValues(entry.After); err != nil {
+		return fmt.Errorf("failed to encode audit values: %w", err)
+	}
+	return r.queries.CreateAuditEntry(ctx, params)
+}
+
+// ChainAuditEntries links up to limit unchained entries to the end of the
+// hash chain, in the order they were appended, and returns how many it
+// linked. Digests and hashes are keyed with key. Only one chainer runs at a
+// time; the others link nothing. It must be called inside Atomic.
+func (r *PgMerchRepository) ChainAuditEntries(ctx context.Context, key []byte, limit int32) (int, error) {
+	locked, err := r.queries.TryLockAuditChain(ctx)
+	if err != nil || !locked {
+		return 0, err
+	}
+	var chainSeq int64
+	var prevHash string
+	head, err := r.queries.GetAuditChainHead(ctx)
+	if err == nil {
+		chainSeq = head.ChainSeq.Int64
+		prevHash = head.Hash.String
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
+	}
+	rows, err := r.queries.ListUnchainedAuditEntries(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		chainSeq++
+		digest, err := auditDigest(key, queries.AuditLog{
+			Seq:       row.Seq,
+			CreatedAt: row.CreatedAt,
+			Actor:     row.Actor,
+			Action:    row.Action,
+			Target:    row.Target,
+			Ip:        row.Ip,
+			UserAgent: row.UserAgent,
+			RequestID: row.RequestID,
+			Before:    row.Before,
+			After:     row.After,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to hash audit entry: %w", err)
+		}
+		hash, err := auditMAC(key, auditLink{ChainSeq: chainSeq, Digest: digest, PrevHash: prevHash})
+		if err != nil {
+			return 0, fmt.Errorf("failed to hash audit entry: %w", err)
+		}
+		err = r.queries.ChainAuditEntry(ctx, queries.ChainAuditEntryParams{
+			Seq:      row.Seq,
+			ChainSeq: pgtype.Int8{Int64: chainSeq, Valid: true},
+			Digest:   pgtype.Text{String: digest, Valid: true},
+			PrevHash: pgtype.Text{String: prevHash, Valid: true},
+			Hash:     pgtype.Text{String: hash, Valid: true},
+		})
+		if err != nil {
+			return 0, err
+		}
+		prevHash = hash
+	}
+	return len(rows), nil
+}
+
+func (r *PgMerchRepository) GetAuditLog(ctx context.Context, filter model.AuditFilter) ([]model.AuditEntry, error) {
+	params := queries.ListAuditLogParams{
+		Actor:    optionalText(filter.Actor),
+		Action:   optionalText(filter.Action),
+		Target:   optionalText(filter.Target),
+		Since:    optionalTimestamptz(filter.Period.From),
+		Until:    optionalTimestamptz(filter.Period.To),
+		RowLimit: filter.Limit,
+	}
+	if filter.BeforeSeq != nil {
+		params.BeforeSeq = pgtype.Int8{Int64: *filter.BeforeSeq, Valid: true}
+	}
+	rows, err := r.queries.ListAuditLog(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	var entries []model.AuditEntry
+	for _, row := range rows {
+		entry := model.AuditEntry{
+			Seq:       row.Seq,
+			CreatedAt: row.CreatedAt.Time,
+			Actor:     row.Actor,
+			Action:    row.Action,
+			Target:    row.Target,
+			Meta: model.RequestMeta{
+				IP:        row.Ip,
+				UserAgent: row.UserAgent,
+				RequestID: row.RequestID,
+			},
+			PrevHash: row.PrevHash.String,
+			Hash:     row.Hash.String,
+		}
+		if row.Before != nil {
+			if err := json.Unmarshal(row.Before, &entry.Before); err != nil {
+				return nil, fmt.Errorf("failed to decode audit entry %d: %w", row.Seq, err)
+			}
+		}
+		if row.After != nil {
+			if err := json.Unmarshal(row.After, &entry.After); err != nil {
+				return nil, fmt.Errorf("failed to decode audit entry %d: %w", row.Seq, err)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+const streamAuditChain = `
+SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after,
+       chain_seq, digest, prev_hash, hash, redacted_at IS NOT NULL, redacted_digest
+FROM audit_log
+WHERE chain_seq IS NOT NULL
+ORDER BY chain_seq
+`
+
+// VerifyAuditChain walks the chained part of the audit log checking that
+// chain positions are contiguous and that every entry links to and hashes
+// like it did when it was chained, using key. The content of entries
+// redacted since is checked against the digest taken on redaction instead.
+func (r *PgMerchRepository) VerifyAuditChain(ctx context.Context, key []byte) (*model.AuditVerification, error) {
+	rows, err := r.pool.Query(ctx, streamAuditChain)
+	if err != nil {
+		return nil, err
+	}
+	result := &model.AuditVerification{Valid: true}
+	var row queries.AuditLog
+	var redacted bool
+	prevHash := ""
+	errBroken := errors.New("audit chain broken")
+	_, err = pgx.ForEachRow(rows, []any{
+		&row.Seq, &row.CreatedAt, &row.Actor, &row.Action, &row.Target, &row.Ip,
+		&row.UserAgent, &row.RequestID, &row.Before, &row.After,
+		&row.ChainSeq, &row.Digest, &row.PrevHash, &row.Hash, &redacted, &row.RedactedDigest,
+	}, func() error {
+		digest, err := auditDigest(key, row)
+		if err != nil {
+			return err
+		}
+		want := row.Digest
+		if redacted {
+			want = row.RedactedDigest
+		}
+		if !want.Valid || want.String != digest {
+			return errBroken
+		}
+		hash, err := auditMAC(key, auditLink{ChainSeq: row.ChainSeq.Int64, Digest: row.Digest.String, PrevHash: row.PrevHash.String})
+		if err != nil {
+			return err
+		}
+		if row.ChainSeq.Int64 != result.Checked+1 || row.PrevHash.String != prevHash || row.Hash.String != hash {
+			return errBroken
+		}
+		result.Checked++
+		if redacted {
+			result.Redacted++
+		}
+		prevHash = row.Hash.String
+		return nil
+	})
+	if errors.Is(err, errBroken) {
+		seq := row.Seq
+		result.Valid = false
+		result.BrokenSeq = &seq
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func optionalText(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: s, Valid: true}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SetProductCurrency sets the currency the item is priced in. It returns
+// ErrCurrencyNotFound for unknown currencies and ErrItemNotFound for unknown
+// items.
+func (r *PgMerchRepository) SetProductCurrency(ctx context.Context, item, currency string) error {
+	rows, err := r.queries.SetProductCurrency(ctx, queries.SetProductCurrencyParams{
+		Item:     item,
+		Currency: currency,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrCurrencyNotFound
+		}
+		return err
+	}
+	if rows == 0 {
+		return model.ErrItemNotFound
+	}
+	return nil
+}
+
+// SetProductVariant adds the variant to its item, or updates the variant with
+// the same SKU when the item already has it.
+func (r *PgMerchRepository) SetProductVariant(ctx context.Context, variant model.ProductVariant) (*model.ProductVariant, error) {
+	params := queries.UpsertProductVariantParams{
+		Item:      variant.Item,
+		Sku:       variant.SKU,
+		Size:      optionalText(variant.Size),
+		Color:     optionalText(variant.Color),
+		IsDefault: variant.Default,
+	}
+	if variant.Stock != nil {
+		params.Stock = pgtype.Int4{Int32: int32(*variant.Stock), Valid: true}
+	}
+	if variant.Price != nil {
+		params.Price = pgtype.Int4{Int32: int32(*variant.Price), Valid: true}
+	}
+	// An item has at most one default variant, so the old one gives way.
+	if variant.Default {
+		err := r.queries.ClearDefaultProductVariant(ctx, queries.ClearDefaultProductVariantParams{
+			Item: variant.Item,
+			Sku:  variant.SKU,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	row, err := r.queries.UpsertProductVariant(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrVariantSKUTaken
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return nil, model.ErrItemNotFound
+		}
+		return nil, err
+	}
+	updated := toProductVariant(row)
+	return &updated, nil
+}
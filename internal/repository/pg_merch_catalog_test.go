@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"merchshop/internal/model"
+)
+
+func TestHoodyDefaultVariant(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+
+	v, err := r.GetDefaultProductVariant(ctx, "hoody")
+	if err != nil {
+		t.Fatalf("GetDefaultProductVariant: %v", err)
+	}
+	if v.SKU != "hoody-classic" || v.Stock != nil {
+		t.Fatalf("default hoody variant = %+v, want hoody-classic with unlimited stock", v)
+	}
+	product, err := r.GetProduct(ctx, "pink-hoody")
+	if err != nil {
+		t.Fatalf("GetProduct: %v", err)
+	}
+	if !product.Active {
+		t.Fatalf("pink-hoody is inactive")
+	}
+}
+
+func TestSetProductVariantDefaultAndStock(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	item := testName("item")
+	if _, err := r.pool.Exec(ctx, "INSERT INTO products (item, price) VALUES ($1, 10)", item); err != nil {
+		t.Fatal(err)
+	}
+	one := uint32(1)
+
+	first, err := r.SetProductVariant(ctx, model.ProductVariant{Item: item, SKU: item + "-a", Default: true})
+	if err != nil {
+		t.Fatalf("SetProductVariant: %v", err)
+	}
+	second, err := r.SetProductVariant(ctx, model.ProductVariant{Item: item, SKU: item + "-b", Stock: &one, Default: true})
+	if err != nil {
+		t.Fatalf("SetProductVariant: %v", err)
+	}
+	v, err := r.GetDefaultProductVariant(ctx, item)
+	if err != nil || v.ID != second.ID {
+		t.Fatalf("GetDefaultProductVariant = %+v, %v, want the second variant", v, err)
+	}
+
+	// Unlimited stock never runs out; limited stock does.
+	for range 3 {
+		if err := r.DecrementVariantStock(ctx, first.ID); err != nil {
+			t.Fatalf("DecrementVariantStock of unlimited stock: %v", err)
+		}
+	}
+	if err := r.DecrementVariantStock(ctx, second.ID); err != nil {
+		t.Fatalf("DecrementVariantStock: %v", err)
+	}
+	if err := r.DecrementVariantStock(ctx, second.ID); !errors.Is(err, model.ErrOutOfStock) {
+		t.Fatalf("DecrementVariantStock of an empty variant = %v, want %v", err, model.ErrOutOfStock)
+	}
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateCurrency(ctx context.Context, currency model.Currency) (*model.Currency, error) {
+	params := queries.CreateCurrencyParams{
+		Code:         currency.Code,
+		Name:         currency.Name,
+		Transferable: currency.Transferable,
+	}
+	if currency.LifetimeDays != nil {
+		params.LifetimeDays = pgtype.Int4{Int32: int32(*currency.LifetimeDays), Valid: true}
+	}
+	row, err := r.queries.CreateCurrency(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationErrCode {
+			return nil, model.ErrCurrencyExists
+		}
+		return nil, err
+	}
+	created := toCurrency(row)
+	return &created, nil
+}
+
+func (r *PgMerchRepository) GetCurrency(ctx context.Context, code string) (*model.Currency, error) {
+	row, err := r.queries.GetCurrency(ctx, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrCurrencyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	currency := toCurrency(row)
+	return &currency, nil
+}
+
+func (r *PgMerchRepository) ListCurrencies(ctx context.Context) ([]model.Currency, error) {
+	rows, err := r.queries.ListCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var currencies []model.Currency
+	for _, row := range rows {
+		currencies = append(currencies, toCurrency(row))
+	}
+	return currencies, nil
+}
+
+// AddBalance credits the user with amount of the currency. Coins go to the
+// user's balance; other currencies are credited as a lot of their own that
+// expires at expiresAt, if set.
+func (r *PgMerchRepository) AddBalance(ctx context.Context, username string, currency string, amount int32, expiresAt *time.Time) error {
+	if currency == model.DefaultCurrency {
+		return r.AddCoins(ctx, username, amount)
+	}
+	err := r.queries.InsertBalanceLot(ctx, queries.InsertBalanceLotParams{
+		Username:  username,
+		Currency:  currency,
+		Amount:    amount,
+		ExpiresAt: optionalTimestamptz(expiresAt),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			if pgErr.ConstraintName == "balance_lots_currency_fkey" {
+				return model.ErrCurrencyNotFound
+			}
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return r.queries.NotifyBalance(ctx, username)
+}
+
+// DeductBalance debits the user by amount of the currency and returns what
+// it took, so that it can be credited elsewhere with the same expiry. Other
+// currencies than coins are taken from the unexpired lots, those expiring
+// first used first. The lots are locked, so concurrent debits cannot
+// overdraw them.
+func (r *PgMerchRepository) DeductBalance(ctx context.Context, username string, currency string, amount int32) ([]model.BalanceLot, error) {
+	if currency == model.DefaultCurrency {
+		if err := r.DeductCoins(ctx, username, amount); err != nil {
+			return nil, err
+		}
+		return []model.BalanceLot{{Amount: amount}}, nil
+	}
+	lots, err := r.queries.LockBalanceLots(ctx, queries.LockBalanceLotsParams{
+		Username: username,
+		Currency: currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, lot := range lots {
+		total += int64(lot.Amount)
+	}
+	if total < int64(amount) {
+		return nil, model.ErrInsufficientFunds
+	}
+	var taken []model.BalanceLot
+	left := amount
+	for _, lot := range lots {
+		if left == 0 {
+			break
+		}
+		take := min(left, lot.Amount)
+		err := r.queries.SetBalanceLotAmount(ctx, queries.SetBalanceLotAmountParams{
+			Amount: lot.Amount - take,
+			ID:     lot.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		t := model.BalanceLot{Amount: take}
+		if lot.ExpiresAt.Valid {
+			t.ExpiresAt = &lot.ExpiresAt.Time
+		}
+		taken = append(taken, t)
+		left -= take
+	}
+	if err := r.queries.NotifyBalance(ctx, username); err != nil {
+		return nil, err
+	}
+	return taken, nil
+}
+
+// GetBalances returns the user's unexpired balances in other currencies than
+// coins.
+func (r *PgMerchRepository) GetBalances(ctx context.Context, username string) ([]model.Balance, error) {
+	rows, err := r.queries.ListUserBalances(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var balances []model.Balance
+	for _, row := range rows {
+		b := model.Balance{
+			Currency: row.Currency,
+			Amount:   uint32(row.Amount),
+		}
+		if row.NextExpiresAt.Valid {
+			b.NextExpiresAt = &row.NextExpiresAt.Time
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil
+}
+
+func toCurrency(row queries.Currency) model.Currency {
+	c := model.Currency{
+		Code:         row.Code,
+		Name:         row.Name,
+		Transferable: row.Transferable,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+	if row.LifetimeDays.Valid {
+		days := uint32(row.LifetimeDays.Int32)
+		c.LifetimeDays = &days
+	}
+	return c
+}
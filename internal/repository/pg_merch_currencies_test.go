@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func testCurrency(t *testing.T, r *PgMerchRepository) string {
+	t.Helper()
+	c, err := r.CreateCurrency(context.Background(), model.Currency{Code: testName("points"), Name: "Баллы", Transferable: true})
+	if err != nil {
+		t.Fatalf("failed to create currency: %v", err)
+	}
+	return c.Code
+}
+
+func TestDeductBalanceCoins(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	username := testUser(t, r)
+
+	lots, err := r.DeductBalance(ctx, username, model.DefaultCurrency, 300)
+	if err != nil {
+		t.Fatalf("DeductBalance: %v", err)
+	}
+	if len(lots) != 1 || lots[0].Amount != 300 || lots[0].ExpiresAt != nil {
+		t.Fatalf("DeductBalance took %+v, want a single lot of 300 without expiry", lots)
+	}
+	user, err := r.GetUser(ctx, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Coins != 700 {
+		t.Fatalf("user has %d coins, want 700", user.Coins)
+	}
+}
+
+func TestDeductCoinsInsufficient(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	username := testUser(t, r)
+
+	if err := r.DeductCoins(ctx, username, 1001); !errors.Is(err, model.ErrInsufficientFunds) {
+		t.Fatalf("DeductCoins over the balance = %v, want %v", err, model.ErrInsufficientFunds)
+	}
+	if _, err := r.DeductBalance(ctx, username, model.DefaultCurrency, 1001); !errors.Is(err, model.ErrInsufficientFunds) {
+		t.Fatalf("DeductBalance over the balance = %v, want %v", err, model.ErrInsufficientFunds)
+	}
+	if err := r.DeductCoins(ctx, testName("missing"), 1); !errors.Is(err, model.ErrUserNotFound) {
+		t.Fatalf("DeductCoins of an unknown user = %v, want %v", err, model.ErrUserNotFound)
+	}
+	user, err := r.GetUser(ctx, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Coins != 1000 {
+		t.Fatalf("user has %d coins, want 1000", user.Coins)
+	}
+}
+
+func TestDeductBalanceTakesLotsExpiringFirst(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	username := testUser(t, r)
+	currency := testCurrency(t, r)
+	soon := time.Now().Add(24 * time.Hour).Truncate(time.Microsecond)
+	later := time.Now().Add(48 * time.Hour).Truncate(time.Microsecond)
+	past := time.Now().Add(-time.Hour)
+	credits := []struct {
+		amount    int32
+		expiresAt *time.Time
+	}{
+		{20, nil},
+		{30, &later},
+		{50, &soon},
+		{100, &past},
+	}
+	for _, c := range credits {
+		if err := r.AddBalance(ctx, username, currency, c.amount, c.expiresAt); err != nil {
+			t.Fatalf("AddBalance: %v", err)
+		}
+	}
+
+	lots, err := r.DeductBalance(ctx, username, currency, 90)
+	if err != nil {
+		t.Fatalf("DeductBalance: %v", err)
+	}
+	want := []model.BalanceLot{{Amount: 50, ExpiresAt: &soon}, {Amount: 30, ExpiresAt: &later}, {Amount: 10}}
+	if len(lots) != len(want) {
+		t.Fatalf("DeductBalance took %d lots, want %d", len(lots), len(want))
+	}
+	for i, lot := range lots {
+		w := want[i]
+		sameExpiry := (lot.ExpiresAt == nil) == (w.ExpiresAt == nil) &&
+			(lot.ExpiresAt == nil || lot.ExpiresAt.Equal(*w.ExpiresAt))
+		if lot.Amount != w.Amount || !sameExpiry {
+			t.Errorf("lot %d = %d expiring %v, want %d expiring %v", i, lot.Amount, lot.ExpiresAt, w.Amount, w.ExpiresAt)
+		}
+	}
+
+	balances, err := r.GetBalances(ctx, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != 1 || balances[0].Amount != 10 || balances[0].NextExpiresAt != nil {
+		t.Fatalf("balances = %+v, want 10 without expiry", balances)
+	}
+}
+
+func TestDeductBalanceInsufficient(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	username := testUser(t, r)
+	currency := testCurrency(t, r)
+	past := time.Now().Add(-time.Hour)
+	if err := r.AddBalance(ctx, username, currency, 10, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddBalance(ctx, username, currency, 100, &past); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.DeductBalance(ctx, username, currency, 11); !errors.Is(err, model.ErrInsufficientFunds) {
+		t.Fatalf("DeductBalance = %v, want %v", err, model.ErrInsufficientFunds)
+	}
+	balances, err := r.GetBalances(ctx, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != 1 || balances[0].Amount != 10 {
+		t.Fatalf("balances = %+v, want 10 left", balances)
+	}
+}
+
+func TestSetProductCurrencyErrors(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+
+	if err := r.SetProductCurrency(ctx, "cup", testName("missing")); !errors.Is(err, model.ErrCurrencyNotFound) {
+		t.Fatalf("SetProductCurrency with an unknown currency = %v, want %v", err, model.ErrCurrencyNotFound)
+	}
+	if err := r.SetProductCurrency(ctx, testName("item"), model.DefaultCurrency); !errors.Is(err, model.ErrItemNotFound) {
+		t.Fatalf("SetProductCurrency of an unknown item = %v, want %v", err, model.ErrItemNotFound)
+	}
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+)
+
+// ExportCoinTransfers returns the transfers the user sent or received,
+// including those from team wallets, oldest first.
+func (r *PgMerchRepository) ExportCoinTransfers(ctx context.Context, username string) ([]model.TransferRecord, error) {
+	rows, err := r.queries.ExportCoinTransfers(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var transfers []model.TransferRecord
+	for _, row := range rows {
+		transfers = append(transfers, model.TransferRecord{
+			FromUsername: row.FromUsername,
+			ToUsername:   row.ToUsername,
+			Amount:       uint32(row.Amount),
+			Currency:     row.Currency,
+			Team:         row.Team,
+			CreatedAt:    row.CreatedAt.Time,
+		})
+	}
+	return transfers, nil
+}
+
+// EraseUser replaces the username with pseudonym in the history other users
+// share with them and in the audit log, then deletes their personal data and
+// the user. A deactivated user without password or profile is left under
+// pseudonym for the history to refer to, and the hash of the username is
+// kept so that it cannot be registered again.
+//
+// Audit entries are redacted wherever the username appears: as actor, as
+// target or as a string in the payloads, which are searched in full as
+// erasure is rare. The address and user agent of the entries the user made
+// are cleared. The redacted content of every entry is digested with key, so
+// that VerifyAuditChain can tell erasure from tampering. Outbox events are
+// pseudonymized the same way, which covers the bodies of webhook deliveries
+// still to be made, as those are rendered from the events. It must be called
+// inside Atomic, for the audit log accepts the redaction only within the
+// transaction that names the erased user.
+func (r *PgMerchRepository) EraseUser(ctx context.Context, key []byte, username string, pseudonym string) error {
+	err := r.queries.SetErasureContext(ctx, queries.SetErasureContextParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set erasure context: %w", err)
+	}
+	if err := r.queries.InsertErasedUser(ctx, pseudonym); err != nil {
+		return fmt.Errorf("failed to create pseudonymous user: %w", err)
+	}
+	if err := r.queries.InsertErasedUsername(ctx, hashUsername(username)); err != nil {
+		return fmt.Errorf("failed to reserve username: %w", err)
+	}
+	redacted, err := r.queries.PseudonymizeAuditLog(ctx, queries.PseudonymizeAuditLogParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize audit log: %w", err)
+	}
+	var digests queries.SetAuditRedactedDigestsParams
+	for _, row := range redacted {
+		digest, err := auditDigest(key, queries.AuditLog{
+			Seq:       row.Seq,
+			CreatedAt: row.CreatedAt,
+			Actor:     row.Actor,
+			Action:    row.Action,
+			Target:    row.Target,
+			Ip:        row.Ip,
+			UserAgent: row.UserAgent,
+			RequestID: row.RequestID,
+			Before:    row.Before,
+			After:     row.After,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to hash audit entry: %w", err)
+		}
+		digests.Seqs = append(digests.Seqs, row.Seq)
+		digests.Digests = append(digests.Digests, digest)
+	}
+	if err := r.queries.SetAuditRedactedDigests(ctx, digests); err != nil {
+		return fmt.Errorf("failed to record redacted audit digests: %w", err)
+	}
+	err = r.queries.PseudonymizeOutboxEvents(ctx, queries.PseudonymizeOutboxEventsParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize outbox events: %w", err)
+	}
+	err = r.queries.PseudonymizeCoinTransfers(ctx, queries.PseudonymizeCoinTransfersParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize coin transfers: %w", err)
+	}
+	err = r.queries.PseudonymizePurchases(ctx, queries.PseudonymizePurchasesParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize purchases: %w", err)
+	}
+	err = r.queries.PseudonymizeCoinGrants(ctx, queries.PseudonymizeCoinGrantsParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize coin grants: %w", err)
+	}
+	err = r.queries.PseudonymizeBalanceAdjustments(ctx, queries.PseudonymizeBalanceAdjustmentsParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize balance adjustments: %w", err)
+	}
+	err = r.queries.PseudonymizeTeamTransactions(ctx, queries.PseudonymizeTeamTransactionsParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize team transactions: %w", err)
+	}
+	err = r.queries.PseudonymizeAuctionBids(ctx, queries.PseudonymizeAuctionBidsParams{
+		Pseudonym: pseudonym,
+		Username:  username,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize auction bids: %w", err)
+	}
+	err = r.queries.PseudonymizeAuctionWinners(ctx, queries.PseudonymizeAuctionWinnersParams{
+		Pseudonym: pseudonym,
+		Username:  username,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize auction winners: %w", err)
+	}
+	err = r.queries.PseudonymizeGroupPurchases(ctx, queries.PseudonymizeGroupPurchasesParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize group purchases: %w", err)
+	}
+	err = r.queries.PseudonymizeGroupPurchasePledges(ctx, queries.PseudonymizeGroupPurchasePledgesParams{
+		Pseudonym: pseudonym,
+		Username:  username,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize pledges: %w", err)
+	}
+	err = r.queries.PseudonymizeNotificationSenders(ctx, queries.PseudonymizeNotificationSendersParams{
+		Username:  username,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pseudonymize notifications: %w", err)
+	}
+	if err := r.queries.DeleteUserTeamMemberships(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete team memberships: %w", err)
+	}
+	err = r.DeleteSpendingLimits(ctx, model.LimitScopeUser, username)
+	if err != nil && !errors.Is(err, model.ErrSpendingLimitNotFound) {
+		return fmt.Errorf("failed to delete spending limits: %w", err)
+	}
+	if err := r.queries.DeleteUserWishlist(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete wishlist: %w", err)
+	}
+	if err := r.queries.DeleteUserNotifications(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete notifications: %w", err)
+	}
+	if err := r.queries.DeleteUserPasswordResetTokens(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete password reset tokens: %w", err)
+	}
+	if err := r.queries.DeleteUserIdentities(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete identities: %w", err)
+	}
+	if err := r.queries.DeleteRecoveryCodes(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := r.queries.DeleteUserMFA(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete mfa: %w", err)
+	}
+	if err := r.queries.DeleteUserBalanceLots(ctx, username); err != nil {
+		return fmt.Errorf("failed to delete balances: %w", err)
+	}
+	rows, err := r.queries.DeleteUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// IsUsernameErased reports whether a user of that name has been erased.
+func (r *PgMerchRepository) IsUsernameErased(ctx context.Context, username string) (bool, error) {
+	return r.queries.IsUsernameErased(ctx, hashUsername(username))
+}
+
+// hashUsername is how erased usernames are kept.
+func hashUsername(username string) string {
+	sum := sha256.Sum256([]byte(username))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+)
+
+func TestEraseUserRedactsAuditAndOutbox(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	key := []byte("test audit key")
+	username := testUser(t, r)
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		t.Fatal(err)
+	}
+	pseudonym := "erased-" + hex.EncodeToString(raw)
+	err := r.AppendAudit(ctx, model.AuditEntry{
+		Actor:  username,
+		Action: model.AuditUserErased,
+		Target: username,
+		Meta:   model.RequestMeta{IP: "192.0.2.1", UserAgent: "test"},
+		After:  map[string]any{"toUser": username},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Publish(ctx, model.UserRegistered{Username: username}); err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.Atomic(ctx, func(tx MerchRepository) error {
+		return tx.EraseUser(ctx, key, username, pseudonym)
+	})
+	if err != nil {
+		t.Fatalf("EraseUser: %v", err)
+	}
+
+	var row queries.AuditLog
+	err = r.pool.QueryRow(ctx, `
+		SELECT seq, created_at, actor, action, target, ip, user_agent, request_id, before, after, redacted_digest
+		FROM audit_log WHERE target = $1`, pseudonym).Scan(
+		&row.Seq, &row.CreatedAt, &row.Actor, &row.Action, &row.Target, &row.Ip,
+		&row.UserAgent, &row.RequestID, &row.Before, &row.After, &row.RedactedDigest)
+	if err != nil {
+		t.Fatalf("redacted audit entry: %v", err)
+	}
+	if row.Actor != pseudonym || row.Ip != "" || row.UserAgent != "" || strings.Contains(string(row.After), username) {
+		t.Fatalf("audit entry = %+v, want it pseudonymized", row)
+	}
+	digest, err := auditDigest(key, row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !row.RedactedDigest.Valid || row.RedactedDigest.String != digest {
+		t.Fatalf("redacted digest = %v, want %s", row.RedactedDigest, digest)
+	}
+
+	var mentions int
+	err = r.pool.QueryRow(ctx, `SELECT count(*) FROM outbox_events WHERE strpos(payload::text, $1) > 0`, username).Scan(&mentions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mentions != 0 {
+		t.Fatalf("%d outbox events still mention the erased user", mentions)
+	}
+
+	// Outside an erasure, the content of the entry cannot be rewritten.
+	if _, err := r.pool.Exec(ctx, `UPDATE audit_log SET actor = 'mallory', redacted_at = now() WHERE seq = $1`, row.Seq); err == nil {
+		t.Fatal("rewriting a redacted audit entry succeeded, want it refused")
+	}
+}
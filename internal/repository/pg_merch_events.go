@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"merchshop/internal/model"
+)
+
+// eventsChannel is the Postgres NOTIFY channel carrying user events. NOTIFY is
+// transactional, so events sent inside Atomic are only delivered on commit.
+const eventsChannel = "merch_events"
+
+type eventPayload struct {
+	Username string         `json:"username"`
+	Type     string         `json:"type"`
+	Data     map[string]any `json:"data"`
+}
+
+func (r *PgMerchRepository) notifyEvent(ctx context.Context, username string, eventType string, data map[string]any) error {
+	payload, err := json.Marshal(eventPayload{
+		Username: username,
+		Type:     eventType,
+		Data:     data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	return r.queries.NotifyEvent(ctx, string(payload))
+}
+
+// ListenEvents blocks delivering user events published by any backend replica
+// to fn until ctx is cancelled or the connection fails.
+func (r *PgMerchRepository) ListenEvents(ctx context.Context, fn func(model.UserEvent)) error {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "UNLISTEN "+eventsChannel)
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return err
+	}
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var e eventPayload
+		if err := json.Unmarshal([]byte(n.Payload), &e); err != nil {
+			log.Printf("skipping malformed event %q: %v", n.Payload, err)
+			continue
+		}
+		fn(model.UserEvent{
+			Username: e.Username,
+			Type:     e.Type,
+			Data:     e.Data,
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateGroupPurchase(ctx context.Context, purchase model.GroupPurchase) (*model.GroupPurchase, error) {
+	id, err := r.queries.CreateGroupPurchase(ctx, queries.CreateGroupPurchaseParams{
+		Item:      purchase.Item,
+		VariantID: optionalInt4(purchase.VariantID),
+		Recipient: purchase.Recipient,
+		Price:     int32(purchase.Price),
+		Deadline:  pgtype.Timestamptz{Time: purchase.Deadline, Valid: true},
+		CreatedBy: purchase.CreatedBy,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			if pgErr.ConstraintName == "group_purchases_item_fkey" {
+				return nil, model.ErrItemNotFound
+			}
+			return nil, model.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return r.GetGroupPurchase(ctx, id)
+}
+
+// GetGroupPurchase returns the group purchase and the amount pledged so far,
+// without the pledges.
+func (r *PgMerchRepository) GetGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error) {
+	row, err := r.queries.GetGroupPurchase(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrGroupPurchaseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	purchase := toGroupPurchase(row)
+	return &purchase, nil
+}
+
+// LockGroupPurchase is GetGroupPurchase locking the group purchase until the
+// transaction ends, so that pledges and refunds are applied one at a time.
+func (r *PgMerchRepository) LockGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error) {
+	row, err := r.queries.LockGroupPurchase(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrGroupPurchaseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	purchase := toGroupPurchase(queries.GetGroupPurchaseRow(row))
+	return &purchase, nil
+}
+
+// ListOpenGroupPurchases returns the group purchases still collecting
+// pledges, those with the nearest deadline first.
+func (r *PgMerchRepository) ListOpenGroupPurchases(ctx context.Context) ([]model.GroupPurchase, error) {
+	rows, err := r.queries.ListOpenGroupPurchases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var purchases []model.GroupPurchase
+	for _, row := range rows {
+		purchases = append(purchases, toGroupPurchase(queries.GetGroupPurchaseRow(row)))
+	}
+	return purchases, nil
+}
+
+// ListExpiredGroupPurchases returns the ids of open group purchases past
+// their deadline.
+func (r *PgMerchRepository) ListExpiredGroupPurchases(ctx context.Context, limit int32) ([]int32, error) {
+	return r.queries.ListExpiredGroupPurchases(ctx, limit)
+}
+
+// ListPledges returns the pledges made to the group purchase, oldest first.
+func (r *PgMerchRepository) ListPledges(ctx context.Context, id int32) ([]model.Pledge, error) {
+	rows, err := r.queries.ListGroupPurchasePledges(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var pledges []model.Pledge
+	for _, row := range rows {
+		pledges = append(pledges, model.Pledge{
+			Username:  row.Username,
+			Amount:    uint32(row.Amount),
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return pledges, nil
+}
+
+func (r *PgMerchRepository) InsertPledge(ctx context.Context, id int32, username string, amount int32) error {
+	err := r.queries.InsertGroupPurchasePledge(ctx, queries.InsertGroupPurchasePledgeParams{
+		GroupPurchaseID: id,
+		Username:        username,
+		Amount:          amount,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// CloseGroupPurchase moves the open group purchase to status, funded or
+// refunded.
+func (r *PgMerchRepository) CloseGroupPurchase(ctx context.Context, id int32, status string) error {
+	rows, err := r.queries.CloseGroupPurchase(ctx, queries.CloseGroupPurchaseParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrGroupPurchaseClosed
+	}
+	return nil
+}
+
+func toGroupPurchase(row queries.GetGroupPurchaseRow) model.GroupPurchase {
+	g := model.GroupPurchase{
+		ID:        row.ID,
+		Item:      row.Item,
+		Variant:   row.Sku.String,
+		Recipient: row.Recipient,
+		Price:     uint32(row.Price),
+		Pledged:   uint32(row.Pledged),
+		Deadline:  row.Deadline.Time,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: row.CreatedAt.Time,
+		Status:    row.Status,
+	}
+	if row.VariantID.Valid {
+		g.VariantID = &row.VariantID.Int32
+	}
+	if row.ClosedAt.Valid {
+		g.ClosedAt = &row.ClosedAt.Time
+	}
+	return g
+}
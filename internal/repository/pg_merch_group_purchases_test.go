@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func testGroupPurchase(t *testing.T, r *PgMerchRepository, recipient, createdBy string, price uint32) int32 {
+	t.Helper()
+	g, err := r.CreateGroupPurchase(context.Background(), model.GroupPurchase{
+		Item:      "cup",
+		Recipient: recipient,
+		Price:     price,
+		Deadline:  time.Now().Add(time.Hour),
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		t.Fatalf("CreateGroupPurchase: %v", err)
+	}
+	return g.ID
+}
+
+func TestCoinsSentCountPledges(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	pledger := testUser(t, r)
+	recipient := testUser(t, r)
+	since := time.Now().Add(-time.Minute)
+
+	open := testGroupPurchase(t, r, recipient, pledger, 100)
+	refunded := testGroupPurchase(t, r, recipient, pledger, 100)
+	if err := r.InsertPledge(ctx, open, pledger, 30); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.InsertPledge(ctx, refunded, pledger, 40); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.CloseGroupPurchase(ctx, refunded, model.GroupPurchaseRefunded); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.InsertCoinTransfer(ctx, pledger, recipient, model.DefaultCurrency, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	sent, err := r.GetCoinsSentSince(ctx, pledger, since)
+	if err != nil {
+		t.Fatalf("GetCoinsSentSince: %v", err)
+	}
+	if sent != 35 {
+		t.Fatalf("GetCoinsSentSince = %d, want 35", sent)
+	}
+}
+
+func TestGiftsDoNotCountAsRecipientSpend(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	pledger := testUser(t, r)
+	recipient := testUser(t, r)
+	since := time.Now().Add(-time.Minute)
+
+	id := testGroupPurchase(t, r, recipient, pledger, 20)
+	if err := r.InsertPledge(ctx, id, pledger, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.CloseGroupPurchase(ctx, id, model.GroupPurchaseFunded); err != nil {
+		t.Fatal(err)
+	}
+	gift := model.PurchaseOrder{Username: recipient, Item: "cup", Price: 20, Currency: model.DefaultCurrency, GroupPurchaseID: &id}
+	if err := r.CreatePurchase(ctx, gift); err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+	own := model.PurchaseOrder{Username: recipient, Item: "pen", Price: 10, Currency: model.DefaultCurrency}
+	if err := r.CreatePurchase(ctx, own); err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+
+	spent, err := r.GetPurchaseSpendSince(ctx, recipient, since)
+	if err != nil {
+		t.Fatalf("GetPurchaseSpendSince: %v", err)
+	}
+	if spent != 10 {
+		t.Fatalf("GetPurchaseSpendSince = %d, want 10", spent)
+	}
+	stats, err := r.GetUserStats(ctx, recipient, model.Period{})
+	if err != nil {
+		t.Fatalf("GetUserStats: %v", err)
+	}
+	if stats.TotalSpent != 10 {
+		t.Fatalf("TotalSpent = %d, want 10", stats.TotalSpent)
+	}
+}
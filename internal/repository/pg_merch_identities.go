@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TouchUserIdentity records a login through the identity and returns the
+// linked user.
+func (r *PgMerchRepository) TouchUserIdentity(ctx context.Context, identity model.ExternalIdentity) (string, error) {
+	username, err := r.queries.TouchUserIdentity(ctx, queries.TouchUserIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		Email:   identity.Email,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrIdentityNotFound
+		}
+		return "", err
+	}
+	return username, nil
+}
+
+func (r *PgMerchRepository) CreateUserIdentity(ctx context.Context, identity model.ExternalIdentity, username string) error {
+	return r.queries.CreateUserIdentity(ctx, queries.CreateUserIdentityParams{
+		Issuer:   identity.Issuer,
+		Subject:  identity.Subject,
+		Username: username,
+		Email:    identity.Email,
+	})
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetUserSpendingLimits merges the user's own limits over the limits of
+// their role.
+func (r *PgMerchRepository) GetUserSpendingLimits(ctx context.Context, username string) (model.SpendingLimits, error) {
+	rows, err := r.queries.GetUserSpendingLimits(ctx, username)
+	if err != nil {
+		return model.SpendingLimits{}, err
+	}
+	var role, user model.SpendingLimits
+	for _, row := range rows {
+		limits := model.SpendingLimits{
+			DailyTransfer:   limitFromInt4(row.DailyTransfer),
+			MaxTransfer:     limitFromInt4(row.MaxTransfer),
+			MonthlyPurchase: limitFromInt4(row.MonthlyPurchase),
+		}
+		if row.Scope == model.LimitScopeUser {
+			user = limits
+		} else {
+			role = limits
+		}
+	}
+	return model.SpendingLimits{
+		DailyTransfer:   cmp.Or(user.DailyTransfer, role.DailyTransfer),
+		MaxTransfer:     cmp.Or(user.MaxTransfer, role.MaxTransfer),
+		MonthlyPurchase: cmp.Or(user.MonthlyPurchase, role.MonthlyPurchase),
+	}, nil
+}
+
+func (r *PgMerchRepository) ListSpendingLimits(ctx context.Context) ([]model.SpendingLimitRule, error) {
+	rows, err := r.queries.ListSpendingLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]model.SpendingLimitRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, model.SpendingLimitRule{
+			Scope:   row.Scope,
+			Subject: row.Subject,
+			Limits: model.SpendingLimits{
+				DailyTransfer:   limitFromInt4(row.DailyTransfer),
+				MaxTransfer:     limitFromInt4(row.MaxTransfer),
+				MonthlyPurchase: limitFromInt4(row.MonthlyPurchase),
+			},
+			UpdatedAt: row.UpdatedAt.Time,
+		})
+	}
+	return rules, nil
+}
+
+func (r *PgMerchRepository) SetSpendingLimits(ctx context.Context, rule model.SpendingLimitRule) error {
+	return r.queries.UpsertSpendingLimits(ctx, queries.UpsertSpendingLimitsParams{
+		Scope:           rule.Scope,
+		Subject:         rule.Subject,
+		DailyTransfer:   limitToInt4(rule.Limits.DailyTransfer),
+		MaxTransfer:     limitToInt4(rule.Limits.MaxTransfer),
+		MonthlyPurchase: limitToInt4(rule.Limits.MonthlyPurchase),
+	})
+}
+
+func (r *PgMerchRepository) DeleteSpendingLimits(ctx context.Context, scope string, subject string) error {
+	rows, err := r.queries.DeleteSpendingLimits(ctx, queries.DeleteSpendingLimitsParams{
+		Scope:   scope,
+		Subject: subject,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrSpendingLimitNotFound
+	}
+	return nil
+}
+
+// GetCoinsSentSince sums the coins the user sent since the given time:
+// transfers, team wallet payouts they made as lead and pledges to group
+// purchases that were not refunded.
+func (r *PgMerchRepository) GetCoinsSentSince(ctx context.Context, username string, since time.Time) (uint32, error) {
+	total, err := r.queries.GetCoinsSentSince(ctx, queries.GetCoinsSentSinceParams{
+		Username: username,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(total), nil
+}
+
+// GetPurchaseSpendSince sums the user's coin purchases and auction holds
+// since the given time. A won auction counts by its hold, which is never
+// released, rather than by the purchase it turns into. Gifts from group
+// purchases are paid by the pledgers and do not count.
+func (r *PgMerchRepository) GetPurchaseSpendSince(ctx context.Context, username string, since time.Time) (uint32, error) {
+	total, err := r.queries.GetPurchaseSpendSince(ctx, queries.GetPurchaseSpendSinceParams{
+		Username: username,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(total), nil
+}
+
+func limitFromInt4(v pgtype.Int4) *uint32 {
+	if !v.Valid {
+		return nil
+	}
+	limit := uint32(v.Int32)
+	return &limit
+}
+
+func limitToInt4(v *uint32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *PgMerchRepository) GetUserMFA(ctx context.Context, username string) (*model.UserMFA, error) {
+	row, err := r.queries.GetUserMFA(ctx, username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrMFANotEnrolled
+		}
+		return nil, err
+	}
+	return &model.UserMFA{
+		Username:     row.Username,
+		Secret:       row.Secret,
+		Confirmed:    row.ConfirmedAt.Valid,
+		LastUsedStep: row.LastUsedStep,
+	}, nil
+}
+
+// SetPendingMFASecret starts a TOTP enrollment, replacing a pending one.
+func (r *PgMerchRepository) SetPendingMFASecret(ctx context.Context, username string, secret string) error {
+	rows, err := r.queries.SetPendingMFASecret(ctx, queries.SetPendingMFASecretParams{
+		Username: username,
+		Secret:   secret,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) ConfirmUserMFA(ctx context.Context, username string) error {
+	return r.queries.ConfirmUserMFA(ctx, username)
+}
+
+// UseMFAStep records the time step of an accepted code. Codes of the same or
+// an earlier step are rejected as replayed.
+func (r *PgMerchRepository) UseMFAStep(ctx context.Context, username string, step int64) error {
+	rows, err := r.queries.UseMFAStep(ctx, queries.UseMFAStepParams{
+		Username:     username,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// DeleteUserMFA removes the enrollment together with its recovery codes.
+func (r *PgMerchRepository) DeleteUserMFA(ctx context.Context, username string) error {
+	rows, err := r.queries.DeleteUserMFA(ctx, username)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrMFANotEnrolled
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) ReplaceRecoveryCodes(ctx context.Context, username string, codeHashes []string) error {
+	if err := r.queries.DeleteRecoveryCodes(ctx, username); err != nil {
+		return err
+	}
+	return r.queries.InsertRecoveryCodes(ctx, queries.InsertRecoveryCodesParams{
+		Username:   username,
+		CodeHashes: codeHashes,
+	})
+}
+
+func (r *PgMerchRepository) UseRecoveryCode(ctx context.Context, username string, codeHash string) error {
+	rows, err := r.queries.UseRecoveryCode(ctx, queries.UseRecoveryCodeParams{
+		Username: username,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) CountRecoveryCodes(ctx context.Context, username string) (uint32, error) {
+	count, err := r.queries.CountRecoveryCodes(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(count), nil
+}
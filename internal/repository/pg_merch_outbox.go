@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Publish records the event in the outbox and queues a delivery for every
+// active webhook subscription interested in it.
+func (r *PgMerchRepository) Publish(ctx context.Context, event model.DomainEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event.EventType(), err)
+	}
+	eventID, err := r.queries.CreateOutboxEvent(ctx, queries.CreateOutboxEventParams{
+		EventType: event.EventType(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return err
+	}
+	return r.queries.CreateWebhookDeliveries(ctx, queries.CreateWebhookDeliveriesParams{
+		EventID:   eventID,
+		EventType: event.EventType(),
+	})
+}
+
+// ClaimOutboxEvents picks up to limit events not yet relayed, oldest first,
+// and hides them from other relays for lease. The claim is committed right
+// away, so no transaction stays open while the events are published; a
+// crashed relay only delays them until the lease runs out.
+func (r *PgMerchRepository) ClaimOutboxEvents(ctx context.Context, lease time.Duration, limit int32) ([]model.OutboxEvent, error) {
+	rows, err := r.queries.ClaimOutboxEvents(ctx, queries.ClaimOutboxEventsParams{
+		LeaseSeconds: int32(lease.Seconds()),
+		BatchSize:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var events []model.OutboxEvent
+	for _, row := range rows {
+		events = append(events, model.OutboxEvent{
+			ID:        row.ID,
+			Type:      row.EventType,
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return events, nil
+}
+
+func (r *PgMerchRepository) MarkOutboxEventsRelayed(ctx context.Context, ids []int64) error {
+	return r.queries.MarkOutboxEventsRelayed(ctx, ids)
+}
+
+// ReleaseOutboxEvents gives up the claim on events that were not relayed, so
+// the next poll retries them without waiting for the lease.
+func (r *PgMerchRepository) ReleaseOutboxEvents(ctx context.Context, ids []int64) error {
+	return r.queries.ReleaseOutboxEvents(ctx, ids)
+}
+
+// PruneOutboxEvents deletes up to limit unrelayed events created before
+// before, keeping those a webhook delivery refers to. It returns how many
+// were deleted.
+func (r *PgMerchRepository) PruneOutboxEvents(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	return r.queries.PruneOutboxEvents(ctx, queries.PruneOutboxEventsParams{
+		Before:    pgtype.Timestamptz{Time: before, Valid: true},
+		BatchSize: limit,
+	})
+}
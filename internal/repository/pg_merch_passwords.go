@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) UpdatePasswordHash(ctx context.Context, username string, hash string) error {
+	rows, err := r.queries.UpdatePasswordHash(ctx, queries.UpdatePasswordHashParams{
+		PasswordHash: hash,
+		Username:     username,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// RevokeTokens invalidates all tokens issued to the user so far.
+func (r *PgMerchRepository) RevokeTokens(ctx context.Context, username string) error {
+	rows, err := r.queries.RevokeUserTokens(ctx, username)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// CreatePasswordResetToken stores a new reset token for the user, revoking
+// the unused ones issued before.
+func (r *PgMerchRepository) CreatePasswordResetToken(ctx context.Context, username string, tokenHash string, createdBy string, expiresAt time.Time) error {
+	if err := r.queries.DeleteUnusedPasswordResetTokens(ctx, username); err != nil {
+		return err
+	}
+	err := r.queries.CreatePasswordResetToken(ctx, queries.CreatePasswordResetTokenParams{
+		TokenHash: tokenHash,
+		Username:  username,
+		CreatedBy: createdBy,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ConsumePasswordResetToken marks the token used and returns its user.
+func (r *PgMerchRepository) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (string, error) {
+	username, err := r.queries.ConsumePasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrInvalidResetToken
+		}
+		return "", err
+	}
+	return username, nil
+}
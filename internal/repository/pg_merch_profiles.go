@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+)
+
+// likeEscaper escapes the LIKE wildcards in user input.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *PgMerchRepository) UpdateUserProfile(ctx context.Context, username string, profile model.Profile) error {
+	rows, err := r.queries.UpdateUserProfile(ctx, queries.UpdateUserProfileParams{
+		Username:    username,
+		DisplayName: profile.DisplayName,
+		Email:       profile.Email,
+		Department:  profile.Department,
+		AvatarUrl:   profile.AvatarURL,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// SearchUsers returns active users whose username, display name or
+// department contains query, ignoring case.
+func (r *PgMerchRepository) SearchUsers(ctx context.Context, query string, limit int32) ([]model.DirectoryEntry, error) {
+	rows, err := r.queries.SearchUsers(ctx, queries.SearchUsersParams{
+		Pattern:  "%" + likeEscaper.Replace(query) + "%",
+		RowLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []model.DirectoryEntry
+	for _, row := range rows {
+		entries = append(entries, model.DirectoryEntry{
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Department:  row.Department,
+			AvatarURL:   row.AvatarUrl,
+		})
+	}
+	return entries, nil
+}
+
+// SetUserDeactivated deactivates or reactivates the user. Deactivating an
+// already deactivated user keeps the original time.
+func (r *PgMerchRepository) SetUserDeactivated(ctx context.Context, username string, deactivated bool) error {
+	rows, err := r.queries.SetUserDeactivated(ctx, queries.SetUserDeactivatedParams{
+		Deactivated: deactivated,
+		Username:    username,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
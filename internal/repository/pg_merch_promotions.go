@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateSale(ctx context.Context, sale model.Sale) (*model.Sale, error) {
+	percentOff, amountOff := fromDiscount(sale.Discount)
+	row, err := r.queries.CreateSale(ctx, queries.CreateSaleParams{
+		Name:       sale.Name,
+		Item:       optionalText(sale.Item),
+		Category:   optionalText(sale.Category),
+		PercentOff: percentOff,
+		AmountOff:  amountOff,
+		StartsAt:   pgtype.Timestamptz{Time: sale.StartsAt, Valid: true},
+		EndsAt:     pgtype.Timestamptz{Time: sale.EndsAt, Valid: true},
+	})
+	if err != nil {
+		return nil, promotionTargetError(err)
+	}
+	created := toSale(row)
+	return &created, nil
+}
+
+// ListSales returns all sales, past and upcoming ones included, the latest
+// first.
+func (r *PgMerchRepository) ListSales(ctx context.Context) ([]model.Sale, error) {
+	rows, err := r.queries.ListSales(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var sales []model.Sale
+	for _, row := range rows {
+		sales = append(sales, toSale(row))
+	}
+	return sales, nil
+}
+
+// DeleteSale removes the sale. Purchases refer to the sale they were made
+// during, so a sale that has been used cannot be deleted.
+func (r *PgMerchRepository) DeleteSale(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteSale(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrSaleUsed
+		}
+		return err
+	}
+	if rows == 0 {
+		return model.ErrSaleNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	percentOff, amountOff := fromDiscount(promo.Discount)
+	params := queries.CreatePromoCodeParams{
+		Code:         promo.Code,
+		Item:         optionalText(promo.Item),
+		Category:     optionalText(promo.Category),
+		PercentOff:   percentOff,
+		AmountOff:    amountOff,
+		PerUserLimit: int32(promo.PerUserLimit),
+		StartsAt:     optionalTimestamptz(promo.StartsAt),
+		EndsAt:       optionalTimestamptz(promo.EndsAt),
+	}
+	if promo.MaxUses != nil {
+		params.MaxUses = pgtype.Int4{Int32: int32(*promo.MaxUses), Valid: true}
+	}
+	row, err := r.queries.CreatePromoCode(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationErrCode {
+			return nil, model.ErrPromoCodeExists
+		}
+		return nil, promotionTargetError(err)
+	}
+	created := toPromoCode(row)
+	return &created, nil
+}
+
+func (r *PgMerchRepository) ListPromoCodes(ctx context.Context) ([]model.PromoCode, error) {
+	rows, err := r.queries.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var promos []model.PromoCode
+	for _, row := range rows {
+		promos = append(promos, toPromoCode(row))
+	}
+	return promos, nil
+}
+
+// DeletePromoCode removes the promo code. Purchases refer to the promo code
+// they were made with, so a code that has been used cannot be deleted.
+func (r *PgMerchRepository) DeletePromoCode(ctx context.Context, code string) error {
+	rows, err := r.queries.DeletePromoCode(ctx, code)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrPromoCodeUsed
+		}
+		return err
+	}
+	if rows == 0 {
+		return model.ErrPromoCodeNotFound
+	}
+	return nil
+}
+
+// promotionTargetError tells a missing item from a missing category when a
+// sale or a promo code refers to one.
+func promotionTargetError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+		switch pgErr.ConstraintName {
+		case "sales_category_fkey", "promo_codes_category_fkey":
+			return model.ErrCategoryNotFound
+		}
+		return model.ErrItemNotFound
+	}
+	return err
+}
+
+func fromDiscount(d model.Discount) (percentOff pgtype.Int4, amountOff pgtype.Int4) {
+	if d.PercentOff > 0 {
+		return pgtype.Int4{Int32: int32(d.PercentOff), Valid: true}, pgtype.Int4{}
+	}
+	return pgtype.Int4{}, pgtype.Int4{Int32: int32(d.AmountOff), Valid: true}
+}
+
+func toSale(row queries.Sale) model.Sale {
+	return model.Sale{
+		ID:       row.ID,
+		Name:     row.Name,
+		Item:     row.Item.String,
+		Category: row.Category.String,
+		Discount: toDiscount(row.PercentOff, row.AmountOff),
+		StartsAt: row.StartsAt.Time,
+		EndsAt:   row.EndsAt.Time,
+	}
+}
+
+func toPromoCode(row queries.PromoCode) model.PromoCode {
+	promo := model.PromoCode{
+		Code:         row.Code,
+		Item:         row.Item.String,
+		Category:     row.Category.String,
+		Discount:     toDiscount(row.PercentOff, row.AmountOff),
+		Uses:         uint32(row.Uses),
+		PerUserLimit: uint32(row.PerUserLimit),
+	}
+	if row.MaxUses.Valid {
+		maxUses := uint32(row.MaxUses.Int32)
+		promo.MaxUses = &maxUses
+	}
+	if row.StartsAt.Valid {
+		promo.StartsAt = &row.StartsAt.Time
+	}
+	if row.EndsAt.Valid {
+		promo.EndsAt = &row.EndsAt.Time
+	}
+	return promo
+}
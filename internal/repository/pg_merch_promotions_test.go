@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func TestDeleteUsedPromotions(t *testing.T) {
+	r := testRepo(t)
+	ctx := context.Background()
+	username := testUser(t, r)
+
+	sale, err := r.CreateSale(ctx, model.Sale{
+		Name:     testName("sale"),
+		Item:     "pen",
+		Discount: model.Discount{PercentOff: 10},
+		StartsAt: time.Now().Add(-time.Hour),
+		EndsAt:   time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateSale: %v", err)
+	}
+	promo, err := r.CreatePromoCode(ctx, model.PromoCode{
+		Code:         testName("promo"),
+		Discount:     model.Discount{AmountOff: 1},
+		PerUserLimit: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreatePromoCode: %v", err)
+	}
+	err = r.CreatePurchase(ctx, model.PurchaseOrder{
+		Username:  username,
+		Item:      "pen",
+		Price:     8,
+		Currency:  model.DefaultCurrency,
+		SaleID:    &sale.ID,
+		PromoCode: promo.Code,
+	})
+	if err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+
+	if err := r.DeleteSale(ctx, sale.ID); !errors.Is(err, model.ErrSaleUsed) {
+		t.Fatalf("DeleteSale of a used sale = %v, want %v", err, model.ErrSaleUsed)
+	}
+	if err := r.DeletePromoCode(ctx, promo.Code); !errors.Is(err, model.ErrPromoCodeUsed) {
+		t.Fatalf("DeletePromoCode of a used code = %v, want %v", err, model.ErrPromoCodeUsed)
+	}
+}
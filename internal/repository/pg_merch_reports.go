@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Report queries are run directly on the pool rather than through sqlc, whose
+// :many queries collect all rows in memory, so that reports are streamed row
+// by row.
+
+const streamTransfers = `
+SELECT id, from_username, to_username, amount, currency, created_at
+FROM coin_transfers
+WHERE ($1::timestamptz IS NULL OR created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR created_at < $2::timestamptz)
+ORDER BY created_at, id
+`
+
+const streamPurchases = `
+SELECT p.username, p.item, COALESCE(v.sku, ''), p.price, p.currency, COALESCE(s.name, ''),
+       COALESCE(p.promo_code, ''), p.created_at
+FROM purchases p
+LEFT JOIN product_variants v ON v.id = p.variant_id
+LEFT JOIN sales s ON s.id = p.sale_id
+WHERE ($1::timestamptz IS NULL OR p.created_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR p.created_at < $2::timestamptz)
+ORDER BY p.created_at, p.id
+`
+
+// streamBalances derives the closing balance from the current one by undoing
+// the flows after the period, which keeps it correct for users created with a
+// different starting balance. Users created after the period are left out,
+// and those created during it open at zero with their starting balance
+// counted as received. Only coins are counted: grants, team payouts and
+// admin adjustments in the user's favour are received, adjustments taking
+// coins away are spent, coins held by bids and pledges are spent when held
+// and received when given back, and the purchases paid with them are left
+// out so that they are not counted twice.
+const streamBalances = `
+WITH flows AS (
+    SELECT to_username AS username, amount AS received, 0 AS sent, 0 AS spent, created_at
+    FROM coin_transfers
+    WHERE currency = 'coins'
+    UNION ALL
+    SELECT from_username, 0, amount, 0, created_at
+    FROM coin_transfers
+    WHERE currency = 'coins'
+    UNION ALL
+    SELECT to_username, amount, 0, 0, created_at
+    FROM coin_grants
+    WHERE currency = 'coins'
+    UNION ALL
+    SELECT username, amount, 0, 0, created_at
+    FROM team_transactions
+    WHERE kind = 'transfer'
+    UNION ALL
+    SELECT username, GREATEST(amount, 0), 0, GREATEST(-amount, 0), created_at
+    FROM balance_adjustments
+    WHERE currency = 'coins'
+    UNION ALL
+    SELECT username, 0, 0, price, created_at
+    FROM purchases
+    WHERE currency = 'coins' AND auction_id IS NULL AND group_purchase_id IS NULL
+    UNION ALL
+    SELECT username, 0, 0, amount, created_at
+    FROM auction_bids
+    UNION ALL
+    SELECT username, amount, 0, 0, released_at
+    FROM auction_bids
+    WHERE released_at IS NOT NULL
+    UNION ALL
+    SELECT p.username, 0, 0, p.amount, p.created_at
+    FROM group_purchase_pledges p
+    UNION ALL
+    SELECT p.username, p.amount, 0, 0, g.closed_at
+    FROM group_purchase_pledges p
+    JOIN group_purchases g ON g.id = p.group_purchase_id
+    WHERE g.status = 'refunded'
+), totals AS (
+    SELECT u.username,
+           u.coins,
+           u.created_at IS NOT NULL AND ($1::timestamptz IS NULL OR u.created_at >= $1::timestamptz) AS created_in_period,
+           COALESCE(SUM(f.received) FILTER (WHERE ($1::timestamptz IS NULL OR f.created_at >= $1::timestamptz)
+               AND ($2::timestamptz IS NULL OR f.created_at < $2::timestamptz)), 0) AS received,
+           COALESCE(SUM(f.sent) FILTER (WHERE ($1::timestamptz IS NULL OR f.created_at >= $1::timestamptz)
+               AND ($2::timestamptz IS NULL OR f.created_at < $2::timestamptz)), 0) AS sent,
+           COALESCE(SUM(f.spent) FILTER (WHERE ($1::timestamptz IS NULL OR f.created_at >= $1::timestamptz)
+               AND ($2::timestamptz IS NULL OR f.created_at < $2::timestamptz)), 0) AS spent,
+           COALESCE(SUM(f.received - f.sent - f.spent) FILTER (WHERE f.created_at >= $2::timestamptz), 0) AS net_after,
+           COALESCE(SUM(f.received - f.sent - f.spent), 0) AS net_total
+    FROM users u
+    LEFT JOIN flows f ON f.username = u.username
+    WHERE $2::timestamptz IS NULL OR u.created_at IS NULL OR u.created_at < $2::timestamptz
+    GROUP BY u.username, u.coins, u.created_at
+)
+SELECT username,
+       (received + CASE WHEN created_in_period THEN coins - net_total ELSE 0 END)::bigint,
+       sent::bigint,
+       spent::bigint,
+       (coins - net_after)::bigint
+FROM totals
+ORDER BY username
+`
+
+func (r *PgMerchRepository) StreamTransfers(ctx context.Context, period model.Period, fn func(model.CoinTransfer) error) error {
+	rows, err := r.pool.Query(ctx, streamTransfers, optionalTimestamptz(period.From), optionalTimestamptz(period.To))
+	if err != nil {
+		return err
+	}
+	var t model.CoinTransfer
+	var id, amount int32
+	var createdAt time.Time
+	_, err = pgx.ForEachRow(rows, []any{&id, &t.FromUsername, &t.ToUsername, &amount, &t.Currency, &createdAt}, func() error {
+		t.ID = int64(id)
+		t.Amount = uint32(amount)
+		t.CreatedAt = createdAt
+		return fn(t)
+	})
+	return err
+}
+
+func (r *PgMerchRepository) StreamPurchases(ctx context.Context, period model.Period, fn func(model.Purchase) error) error {
+	rows, err := r.pool.Query(ctx, streamPurchases, optionalTimestamptz(period.From), optionalTimestamptz(period.To))
+	if err != nil {
+		return err
+	}
+	var p model.Purchase
+	var price int32
+	_, err = pgx.ForEachRow(rows, []any{&p.Username, &p.Item, &p.Variant, &price, &p.Currency, &p.Sale, &p.PromoCode, &p.CreatedAt}, func() error {
+		p.Price = uint32(price)
+		return fn(p)
+	})
+	return err
+}
+
+func (r *PgMerchRepository) StreamBalances(ctx context.Context, period model.Period, fn func(model.BalanceReport) error) error {
+	rows, err := r.pool.Query(ctx, streamBalances, optionalTimestamptz(period.From), optionalTimestamptz(period.To))
+	if err != nil {
+		return err
+	}
+	var b model.BalanceReport
+	var received, sent, spent int64
+	_, err = pgx.ForEachRow(rows, []any{&b.Username, &received, &sent, &spent, &b.Closing}, func() error {
+		b.Received = uint64(received)
+		b.Sent = uint64(sent)
+		b.Spent = uint64(spent)
+		b.Opening = b.Closing - received + sent + spent
+		return fn(b)
+	})
+	return err
+}
@@ -2,13 +2,17 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 
 	"merchshop/internal/db/queries"
 	"merchshop/internal/model"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -81,9 +85,12 @@ func (r *PgMerchRepository) AddCoins(ctx context.Context, username string, amoun
 	if rows == 0 {
 		return model.ErrUserNotFound
 	}
-	return nil
+	return r.queries.NotifyBalance(ctx, username)
 }
 
+// DeductCoins takes amount coins from the user. It returns
+// ErrInsufficientFunds if the user has fewer and ErrUserNotFound if there is
+// no such user.
 func (r *PgMerchRepository) DeductCoins(ctx context.Context, username string, amount int32) error {
 	rows, err := r.queries.DeductCoins(ctx, queries.DeductCoinsParams{
 		Coins:    amount,
@@ -93,16 +100,20 @@ func (r *PgMerchRepository) DeductCoins(ctx context.Context, username string, am
 		return err
 	}
 	if rows == 0 {
-		return model.ErrUserNotFound
+		if _, err := r.GetUser(ctx, username); err != nil {
+			return err
+		}
+		return model.ErrInsufficientFunds
 	}
-	return nil
+	return r.queries.NotifyBalance(ctx, username)
 }
 
-func (r *PgMerchRepository) InsertCoinTransfer(ctx context.Context, fromUsername string, toUsername string, amount int32) error {
+func (r *PgMerchRepository) InsertCoinTransfer(ctx context.Context, fromUsername string, toUsername string, currency string, amount int32) error {
 	err := r.queries.InsertCoinTransfer(ctx, queries.InsertCoinTransferParams{
 		FromUsername: fromUsername,
 		ToUsername:   toUsername,
 		Amount:       amount,
+		Currency:     currency,
 	})
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -111,7 +122,11 @@ func (r *PgMerchRepository) InsertCoinTransfer(ctx context.Context, fromUsername
 		}
 		return err
 	}
-	return nil
+	return r.notifyEvent(ctx, toUsername, model.EventTransferReceived, map[string]any{
+		"fromUser": fromUsername,
+		"amount":   amount,
+		"currency": currency,
+	})
 }
 
 func (r *PgMerchRepository) GetCoinHistorySent(ctx context.Context, username string) ([]model.CoinTransferTo, error) {
@@ -124,6 +139,8 @@ func (r *PgMerchRepository) GetCoinHistorySent(ctx context.Context, username str
 		transfers = append(transfers, model.CoinTransferTo{
 			ToUsername: row.ToUsername,
 			Amount:     uint32(row.Amount),
+			Currency:   row.Currency,
+			Team:       row.Team,
 		})
 	}
 	return transfers, nil
@@ -139,6 +156,8 @@ func (r *PgMerchRepository) GetCoinHistoryReceived(ctx context.Context, username
 		transfers = append(transfers, model.CoinTransferFrom{
 			FromUsername: row.FromUsername,
 			Amount:       uint32(row.Amount),
+			Currency:     row.Currency,
+			Team:         row.Team,
 		})
 	}
 	return transfers, nil
@@ -152,8 +171,9 @@ func (r *PgMerchRepository) GetInventory(ctx context.Context, username string) (
 	var inventory []model.InventoryItem
 	for _, row := range rows {
 		inventory = append(inventory, model.InventoryItem{
-			Item:   row.Item,
-			Amount: uint32(row.Quantity),
+			Item:    row.Item,
+			Variant: row.Sku.String,
+			Amount:  uint32(row.Quantity),
 		})
 	}
 	return inventory, nil
@@ -170,6 +190,247 @@ func (r *PgMerchRepository) GetProductPrice(ctx context.Context, item string) (u
 	return uint32(price), nil
 }
 
+func (r *PgMerchRepository) GetProduct(ctx context.Context, item string) (*model.Product, error) {
+	product, err := r.queries.GetProduct(ctx, item)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrItemNotFound
+		}
+		return nil, err
+	}
+	return &model.Product{
+		Item:     product.Item,
+		Price:    uint32(product.Price),
+		Currency: product.Currency,
+		Category: product.Category.String,
+		Active:   product.Active,
+	}, nil
+}
+
+func (r *PgMerchRepository) ListCatalog(ctx context.Context, filter model.CatalogFilter) ([]model.CatalogItem, error) {
+	params := queries.ListCatalogProductsParams{
+		Category: pgtype.Text{String: filter.Category, Valid: filter.Category != ""},
+		Sort:     string(filter.Sort),
+		Lang:     filter.Lang,
+	}
+	if filter.MinPrice != nil {
+		params.MinPrice = pgtype.Int4{Int32: int32(*filter.MinPrice), Valid: true}
+	}
+	if filter.MaxPrice != nil {
+		params.MaxPrice = pgtype.Int4{Int32: int32(*filter.MaxPrice), Valid: true}
+	}
+	products, err := r.queries.ListCatalogProducts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, nil
+	}
+
+	items := make([]model.CatalogItem, 0, len(products))
+	index := make(map[string]int, len(products))
+	names := make([]string, 0, len(products))
+	for _, p := range products {
+		index[p.Item] = len(items)
+		names = append(names, p.Item)
+		items = append(items, model.CatalogItem{
+			Item:        p.Item,
+			Price:       uint32(p.Price),
+			Currency:    p.Currency,
+			Category:    p.Category.String,
+			Name:        model.LocalizedText{RU: p.NameRu, EN: p.NameEn},
+			Description: model.LocalizedText{RU: p.DescriptionRu, EN: p.DescriptionEn},
+		})
+	}
+
+	images, err := r.queries.ListProductImagesByItems(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		item := &items[index[img.Item]]
+		item.Images = append(item.Images, img.Url)
+	}
+
+	variants, err := r.queries.ListProductVariantsByItems(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		item := &items[index[v.Item]]
+		item.Variants = append(item.Variants, toProductVariant(v))
+	}
+	return items, nil
+}
+
+func (r *PgMerchRepository) ListCategories(ctx context.Context) ([]model.Category, error) {
+	rows, err := r.queries.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var categories []model.Category
+	for _, row := range rows {
+		categories = append(categories, model.Category{
+			Slug: row.Slug,
+			Name: model.LocalizedText{RU: row.NameRu, EN: row.NameEn},
+		})
+	}
+	return categories, nil
+}
+
+func (r *PgMerchRepository) GetProductVariant(ctx context.Context, item string, sku string) (*model.ProductVariant, error) {
+	row, err := r.queries.GetProductVariant(ctx, queries.GetProductVariantParams{
+		Item: item,
+		Sku:  sku,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrVariantNotFound
+		}
+		return nil, err
+	}
+	variant := toProductVariant(row)
+	return &variant, nil
+}
+
+func (r *PgMerchRepository) GetDefaultProductVariant(ctx context.Context, item string) (*model.ProductVariant, error) {
+	row, err := r.queries.GetDefaultProductVariant(ctx, item)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrVariantNotFound
+		}
+		return nil, err
+	}
+	variant := toProductVariant(row)
+	return &variant, nil
+}
+
+func (r *PgMerchRepository) HasProductVariants(ctx context.Context, item string) (bool, error) {
+	count, err := r.queries.CountProductVariants(ctx, item)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *PgMerchRepository) DecrementVariantStock(ctx context.Context, variantID int32) error {
+	rows, err := r.queries.DecrementVariantStock(ctx, variantID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrOutOfStock
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) CreatePurchase(ctx context.Context, order model.PurchaseOrder) error {
+	params := queries.CreatePurchaseParams{
+		Username:        order.Username,
+		Item:            order.Item,
+		Price:           int32(order.Price),
+		Currency:        order.Currency,
+		VariantID:       optionalInt4(order.VariantID),
+		SaleID:          optionalInt4(order.SaleID),
+		PromoCode:       pgtype.Text{String: order.PromoCode, Valid: order.PromoCode != ""},
+		AuctionID:       optionalInt4(order.AuctionID),
+		GroupPurchaseID: optionalInt4(order.GroupPurchaseID),
+	}
+	if _, err := r.queries.CreatePurchase(ctx, params); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return r.notifyEvent(ctx, order.Username, model.EventPurchase, map[string]any{
+		"item":     order.Item,
+		"price":    order.Price,
+		"currency": order.Currency,
+	})
+}
+
+func (r *PgMerchRepository) GetPurchases(ctx context.Context, username string) ([]model.Purchase, error) {
+	rows, err := r.queries.ListPurchases(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var purchases []model.Purchase
+	for _, row := range rows {
+		purchases = append(purchases, model.Purchase{
+			Username:  username,
+			Item:      row.Item,
+			Variant:   row.Sku.String,
+			Price:     uint32(row.Price),
+			Currency:  row.Currency,
+			Sale:      row.Sale.String,
+			PromoCode: row.PromoCode.String,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return purchases, nil
+}
+
+func (r *PgMerchRepository) GetActiveSales(ctx context.Context, item string) ([]model.Sale, error) {
+	rows, err := r.queries.ListActiveSales(ctx, pgtype.Text{String: item, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	var sales []model.Sale
+	for _, row := range rows {
+		sales = append(sales, toSale(row))
+	}
+	return sales, nil
+}
+
+func (r *PgMerchRepository) GetPromoCodeForUpdate(ctx context.Context, code string) (*model.PromoCode, error) {
+	row, err := r.queries.GetPromoCodeForUpdate(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+	promo := toPromoCode(row)
+	return &promo, nil
+}
+
+func (r *PgMerchRepository) CountUserPromoCodeUses(ctx context.Context, code string, username string) (uint32, error) {
+	count, err := r.queries.CountUserPromoCodeUses(ctx, queries.CountUserPromoCodeUsesParams{
+		Username:  username,
+		PromoCode: pgtype.Text{String: code, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(count), nil
+}
+
+func (r *PgMerchRepository) IncrementPromoCodeUses(ctx context.Context, code string) error {
+	rows, err := r.queries.IncrementPromoCodeUses(ctx, code)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrPromoCodeExhausted
+	}
+	return nil
+}
+
+// LockUsers locks the rows of the users until the transaction ends, in the
+// order of their names, so that transactions locking several users cannot
+// deadlock one another. It returns ErrUserNotFound unless all of them exist.
+func (r *PgMerchRepository) LockUsers(ctx context.Context, usernames ...string) error {
+	rows, err := r.queries.LockUsers(ctx, usernames)
+	if err != nil {
+		return err
+	}
+	if rows != int64(len(slices.Compact(slices.Sorted(slices.Values(usernames))))) {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
 func (r *PgMerchRepository) GetUser(ctx context.Context, username string) (*model.User, error) {
 	user, err := r.queries.GetUser(ctx, username)
 	if err != nil {
@@ -178,9 +439,211 @@ func (r *PgMerchRepository) GetUser(ctx context.Context, username string) (*mode
 		}
 		return nil, err
 	}
-	return &model.User{
+	u := &model.User{
 		Username:     user.Username,
 		PasswordHash: user.PasswordHash,
 		Coins:        uint32(user.Coins),
-	}, nil
+		Role:         user.Role,
+		Profile: model.Profile{
+			DisplayName: user.DisplayName,
+			Email:       user.Email,
+			Department:  user.Department,
+			AvatarURL:   user.AvatarUrl,
+		},
+	}
+	if user.DeactivatedAt.Valid {
+		u.DeactivatedAt = &user.DeactivatedAt.Time
+	}
+	u.TokenVersion = uint32(user.TokenVersion)
+	return u, nil
+}
+
+func (r *PgMerchRepository) SetUserRole(ctx context.Context, username string, role string) error {
+	rows, err := r.queries.SetUserRole(ctx, queries.SetUserRoleParams{
+		Role:     role,
+		Username: username,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) AddWishlistItem(ctx context.Context, username string, item string) error {
+	err := r.queries.AddWishlistItem(ctx, queries.AddWishlistItemParams{
+		Username: username,
+		Item:     item,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			if pgErr.ConstraintName == "wishlist_items_item_fkey" {
+				return model.ErrItemNotFound
+			}
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) RemoveWishlistItem(ctx context.Context, username string, item string) error {
+	rows, err := r.queries.RemoveWishlistItem(ctx, queries.RemoveWishlistItemParams{
+		Username: username,
+		Item:     item,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrWishlistItemNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) GetWishlist(ctx context.Context, username string) ([]model.WishlistItem, error) {
+	rows, err := r.queries.ListWishlist(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var wishlist []model.WishlistItem
+	for _, row := range rows {
+		wishlist = append(wishlist, model.WishlistItem{
+			Item:     row.Item,
+			Price:    uint32(row.Price),
+			Currency: row.Currency,
+			AddedAt:  row.CreatedAt.Time,
+		})
+	}
+	return wishlist, nil
+}
+
+// NotifyWishlistPriceDrops compares the effective prices of wished items with
+// the prices their users last saw and notifies those whose item got cheaper.
+// It returns how many notifications it created.
+func (r *PgMerchRepository) NotifyWishlistPriceDrops(ctx context.Context) (int64, error) {
+	return r.queries.NotifyWishlistPriceDrops(ctx)
+}
+
+func (r *PgMerchRepository) GetNotifications(ctx context.Context, username string, filter model.NotificationFilter) ([]model.Notification, error) {
+	params := queries.ListNotificationsParams{
+		Username:   username,
+		UnreadOnly: filter.UnreadOnly,
+		RowLimit:   filter.Limit,
+	}
+	if filter.BeforeID != nil {
+		params.BeforeID = pgtype.Int8{Int64: *filter.BeforeID, Valid: true}
+	}
+	rows, err := r.queries.ListNotifications(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	var notifications []model.Notification
+	for _, row := range rows {
+		n, err := toNotification(row)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+func (r *PgMerchRepository) CreateNotification(ctx context.Context, username string, kind string, data map[string]any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification payload: %w", err)
+	}
+	err = r.queries.CreateNotification(ctx, queries.CreateNotificationParams{
+		Username: username,
+		Kind:     kind,
+		Payload:  payload,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) MarkNotificationsRead(ctx context.Context, username string, ids []int64) error {
+	rows, err := r.queries.MarkNotificationsRead(ctx, queries.MarkNotificationsReadParams{
+		Username: username,
+		Ids:      ids,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) MarkAllNotificationsRead(ctx context.Context, username string) error {
+	_, err := r.queries.MarkAllNotificationsRead(ctx, username)
+	return err
+}
+
+func (r *PgMerchRepository) CountUnreadNotifications(ctx context.Context, username string) (uint32, error) {
+	count, err := r.queries.CountUnreadNotifications(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(count), nil
+}
+
+func optionalInt4(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func toDiscount(percentOff pgtype.Int4, amountOff pgtype.Int4) model.Discount {
+	return model.Discount{
+		PercentOff: uint32(percentOff.Int32),
+		AmountOff:  uint32(amountOff.Int32),
+	}
+}
+
+func toProductVariant(row queries.ProductVariant) model.ProductVariant {
+	variant := model.ProductVariant{
+		ID:      row.ID,
+		Item:    row.Item,
+		SKU:     row.Sku,
+		Size:    row.Size.String,
+		Color:   row.Color.String,
+		Default: row.IsDefault,
+	}
+	if row.Stock.Valid {
+		stock := uint32(row.Stock.Int32)
+		variant.Stock = &stock
+	}
+	if row.Price.Valid {
+		price := uint32(row.Price.Int32)
+		variant.Price = &price
+	}
+	return variant
+}
+
+func toNotification(row queries.Notification) (model.Notification, error) {
+	n := model.Notification{
+		ID:        row.ID,
+		Kind:      row.Kind,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.ReadAt.Valid {
+		n.ReadAt = &row.ReadAt.Time
+	}
+	if err := json.Unmarshal(row.Payload, &n.Data); err != nil {
+		return model.Notification{}, fmt.Errorf("invalid notification payload: %w", err)
+	}
+	return n, nil
 }
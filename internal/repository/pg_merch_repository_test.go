@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+// testRepo connects to the database in TEST_DATABASE_URL, migrating it to
+// the latest version, and skips the test if the variable is not set. Tests
+// share the database, so they work with users and records of their own.
+func testRepo(t *testing.T) *PgMerchRepository {
+	t.Helper()
+	dbSource := os.Getenv("TEST_DATABASE_URL")
+	if dbSource == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	m, err := migrate.New("file://../db/migrations", dbSource)
+	if err != nil {
+		t.Fatalf("failed to open migrations: %v", err)
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	m.Close()
+	r, err := NewPgMerchRepository(context.Background(), dbSource)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(r.Close)
+	return r
+}
+
+var testNameSeq atomic.Int64
+
+// testName returns a name no other test run has used.
+func testName(prefix string) string {
+	return fmt.Sprintf("%s-%d-%d", prefix, time.Now().UnixNano(), testNameSeq.Add(1))
+}
+
+// testUser creates a user with the starting balance of coins.
+func testUser(t *testing.T, r *PgMerchRepository) string {
+	t.Helper()
+	username := testName("user")
+	if err := r.CreateUser(context.Background(), username, "x"); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return username
+}
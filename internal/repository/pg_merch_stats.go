@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) GetTopReceivers(ctx context.Context, period model.Period, limit int32) ([]model.LeaderboardEntry, error) {
+	rows, err := r.queries.TopReceivers(ctx, queries.TopReceiversParams{
+		Since:    optionalTimestamptz(period.From),
+		Until:    optionalTimestamptz(period.To),
+		RowLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []model.LeaderboardEntry
+	for _, row := range rows {
+		entries = append(entries, model.LeaderboardEntry{
+			Username:  row.Username,
+			Coins:     uint64(row.Coins),
+			Transfers: uint32(row.Transfers),
+		})
+	}
+	return entries, nil
+}
+
+func (r *PgMerchRepository) GetTopSenders(ctx context.Context, period model.Period, limit int32) ([]model.LeaderboardEntry, error) {
+	rows, err := r.queries.TopSenders(ctx, queries.TopSendersParams{
+		Since:    optionalTimestamptz(period.From),
+		Until:    optionalTimestamptz(period.To),
+		RowLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []model.LeaderboardEntry
+	for _, row := range rows {
+		entries = append(entries, model.LeaderboardEntry{
+			Username:  row.Username,
+			Coins:     uint64(row.Coins),
+			Transfers: uint32(row.Transfers),
+		})
+	}
+	return entries, nil
+}
+
+func (r *PgMerchRepository) GetTopItems(ctx context.Context, period model.Period, limit int32) ([]model.ItemRanking, error) {
+	rows, err := r.queries.TopItems(ctx, queries.TopItemsParams{
+		Since:    optionalTimestamptz(period.From),
+		Until:    optionalTimestamptz(period.To),
+		RowLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var items []model.ItemRanking
+	for _, row := range rows {
+		items = append(items, model.ItemRanking{
+			Item:      row.Item,
+			Purchases: uint32(row.Purchases),
+			Coins:     uint64(row.Coins),
+		})
+	}
+	return items, nil
+}
+
+func (r *PgMerchRepository) GetUserStats(ctx context.Context, username string, period model.Period) (*model.UserStats, error) {
+	row, err := r.queries.GetUserStats(ctx, queries.GetUserStatsParams{
+		Username: username,
+		Since:    optionalTimestamptz(period.From),
+		Until:    optionalTimestamptz(period.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.UserStats{
+		Username:          username,
+		TotalReceived:     uint64(row.TotalReceived),
+		TotalSent:         uint64(row.TotalSent),
+		TotalSpent:        uint64(row.TotalSpent),
+		ColleaguesThanked: uint32(row.ColleaguesThanked),
+	}, nil
+}
+
+func optionalTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateTeam(ctx context.Context, team model.Team) (*model.Team, error) {
+	row, err := r.queries.CreateTeam(ctx, queries.CreateTeamParams{
+		Name:        team.Name,
+		Description: team.Description,
+		CreatedBy:   team.CreatedBy,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationErrCode {
+			return nil, model.ErrTeamExists
+		}
+		return nil, err
+	}
+	created := toTeam(row)
+	return &created, nil
+}
+
+// GetTeam returns the team without its members.
+func (r *PgMerchRepository) GetTeam(ctx context.Context, name string) (*model.Team, error) {
+	row, err := r.queries.GetTeam(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	team := toTeam(row)
+	return &team, nil
+}
+
+func (r *PgMerchRepository) ListTeams(ctx context.Context) ([]model.Team, error) {
+	rows, err := r.queries.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var teams []model.Team
+	for _, row := range rows {
+		teams = append(teams, toTeam(row))
+	}
+	return teams, nil
+}
+
+// SetTeamMember adds the user to the team or changes their role in it.
+func (r *PgMerchRepository) SetTeamMember(ctx context.Context, team string, username string, role string) error {
+	err := r.queries.UpsertTeamMember(ctx, queries.UpsertTeamMemberParams{
+		Team:     team,
+		Username: username,
+		Role:     role,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrCode {
+			if pgErr.ConstraintName == "team_members_team_fkey" {
+				return model.ErrTeamNotFound
+			}
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) RemoveTeamMember(ctx context.Context, team string, username string) error {
+	rows, err := r.queries.DeleteTeamMember(ctx, queries.DeleteTeamMemberParams{
+		Team:     team,
+		Username: username,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrNotTeamMember
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) GetTeamMember(ctx context.Context, team string, username string) (*model.TeamMember, error) {
+	row, err := r.queries.GetTeamMember(ctx, queries.GetTeamMemberParams{
+		Team:     team,
+		Username: username,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrNotTeamMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	member := toTeamMember(row)
+	return &member, nil
+}
+
+func (r *PgMerchRepository) ListTeamMembers(ctx context.Context, team string) ([]model.TeamMember, error) {
+	rows, err := r.queries.ListTeamMembers(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	var members []model.TeamMember
+	for _, row := range rows {
+		members = append(members, toTeamMember(row))
+	}
+	return members, nil
+}
+
+func (r *PgMerchRepository) AddTeamCoins(ctx context.Context, team string, amount int32) error {
+	rows, err := r.queries.AddTeamCoins(ctx, queries.AddTeamCoinsParams{
+		Coins: amount,
+		Name:  team,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrTeamNotFound
+	}
+	return nil
+}
+
+// DeductTeamCoins takes the coins from the team wallet, locking the team row
+// until the transaction ends. It returns ErrInsufficientTeamFunds when the
+// wallet holds less than amount.
+func (r *PgMerchRepository) DeductTeamCoins(ctx context.Context, team string, amount int32) error {
+	rows, err := r.queries.DeductTeamCoins(ctx, queries.DeductTeamCoinsParams{
+		Coins: amount,
+		Name:  team,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInsufficientTeamFunds
+	}
+	return nil
+}
+
+// InsertTeamTransaction records an entry in the team wallet ledger. The
+// recipient of a transfer is notified like of a transfer from a user.
+func (r *PgMerchRepository) InsertTeamTransaction(ctx context.Context, tx model.TeamTransaction) error {
+	params := queries.InsertTeamTransactionParams{
+		Team:   tx.Team,
+		Kind:   tx.Kind,
+		Amount: int32(tx.Amount),
+		Actor:  tx.Actor,
+		Reason: tx.Reason,
+	}
+	if tx.Username != "" {
+		params.Username = pgtype.Text{String: tx.Username, Valid: true}
+	}
+	if err := r.queries.InsertTeamTransaction(ctx, params); err != nil {
+		return err
+	}
+	if tx.Kind != model.TeamTransactionTransfer {
+		return nil
+	}
+	return r.notifyEvent(ctx, tx.Username, model.EventTransferReceived, map[string]any{
+		"fromUser": tx.Actor,
+		"team":     tx.Team,
+		"amount":   tx.Amount,
+	})
+}
+
+// ListTeamTransactions returns the latest entries of the team wallet ledger,
+// newest first.
+func (r *PgMerchRepository) ListTeamTransactions(ctx context.Context, team string, limit int32) ([]model.TeamTransaction, error) {
+	rows, err := r.queries.ListTeamTransactions(ctx, queries.ListTeamTransactionsParams{
+		Team:  team,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []model.TeamTransaction
+	for _, row := range rows {
+		txs = append(txs, model.TeamTransaction{
+			ID:        row.ID,
+			Team:      row.Team,
+			Kind:      row.Kind,
+			Username:  row.Username.String,
+			Amount:    uint32(row.Amount),
+			Actor:     row.Actor,
+			Reason:    row.Reason,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return txs, nil
+}
+
+func toTeam(row queries.Team) model.Team {
+	return model.Team{
+		Name:        row.Name,
+		Description: row.Description,
+		Coins:       uint32(row.Coins),
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func toTeamMember(row queries.TeamMember) model.TeamMember {
+	return model.TeamMember{
+		Username: row.Username,
+		Role:     row.Role,
+		JoinedAt: row.JoinedAt.Time,
+	}
+}
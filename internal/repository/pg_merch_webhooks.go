@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *PgMerchRepository) CreateWebhookSubscription(ctx context.Context, sub model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	row, err := r.queries.CreateWebhookSubscription(ctx, queries.CreateWebhookSubscriptionParams{
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: sub.EventTypes,
+		CreatedBy:  sub.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toWebhookSubscription(row)
+	return &created, nil
+}
+
+func (r *PgMerchRepository) ListWebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	rows, err := r.queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var subs []model.WebhookSubscription
+	for _, row := range rows {
+		subs = append(subs, toWebhookSubscription(row))
+	}
+	return subs, nil
+}
+
+func (r *PgMerchRepository) DeleteWebhookSubscription(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *PgMerchRepository) GetWebhookDeliveries(ctx context.Context, subscriptionID int32, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	params := queries.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		RowLimit:       filter.Limit,
+	}
+	if filter.Status != "" {
+		params.Status = pgtype.Text{String: filter.Status, Valid: true}
+	}
+	rows, err := r.queries.ListWebhookDeliveries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	var deliveries []model.WebhookDelivery
+	for _, row := range rows {
+		d := model.WebhookDelivery{
+			ID:            row.ID,
+			EventID:       row.EventID,
+			EventType:     row.EventType,
+			Status:        row.Status,
+			Attempts:      uint32(row.Attempts),
+			NextAttemptAt: row.NextAttemptAt.Time,
+			LastError:     row.LastError.String,
+			CreatedAt:     row.CreatedAt.Time,
+		}
+		if row.LastStatusCode.Valid {
+			d.LastStatusCode = &row.LastStatusCode.Int32
+		}
+		if row.DeliveredAt.Valid {
+			d.DeliveredAt = &row.DeliveredAt.Time
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// RetryWebhookDelivery puts a dead-lettered delivery back into the queue and
+// resets its attempt counter.
+func (r *PgMerchRepository) RetryWebhookDelivery(ctx context.Context, id int64) error {
+	rows, err := r.queries.RetryWebhookDelivery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
+
+// LeaseWebhookDeliveries picks up to limit due deliveries and postpones them
+// by lease, so that concurrent dispatchers skip them and a crashed dispatcher
+// only delays them.
+func (r *PgMerchRepository) LeaseWebhookDeliveries(ctx context.Context, lease time.Duration, limit int32) ([]model.PendingWebhookDelivery, error) {
+	rows, err := r.queries.LeaseWebhookDeliveries(ctx, queries.LeaseWebhookDeliveriesParams{
+		LeaseSeconds: int32(lease.Seconds()),
+		BatchSize:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var pending []model.PendingWebhookDelivery
+	for _, row := range rows {
+		pending = append(pending, model.PendingWebhookDelivery{
+			ID:             row.ID,
+			Attempts:       uint32(row.Attempts),
+			URL:            row.Url,
+			Secret:         row.Secret,
+			EventID:        row.EventID,
+			EventType:      row.EventType,
+			Payload:        row.Payload,
+			EventCreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return pending, nil
+}
+
+func (r *PgMerchRepository) UpdateWebhookDelivery(ctx context.Context, id int64, attempt model.WebhookAttempt) error {
+	params := queries.UpdateWebhookDeliveryParams{
+		Status:        attempt.Status,
+		NextAttemptAt: pgtype.Timestamptz{Time: attempt.NextAttemptAt, Valid: true},
+		ID:            id,
+	}
+	if attempt.StatusCode != nil {
+		params.LastStatusCode = pgtype.Int4{Int32: *attempt.StatusCode, Valid: true}
+	}
+	if attempt.Error != "" {
+		params.LastError = pgtype.Text{String: attempt.Error, Valid: true}
+	}
+	return r.queries.UpdateWebhookDelivery(ctx, params)
+}
+
+func toWebhookSubscription(row queries.WebhookSubscription) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		ID:         row.ID,
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: row.EventTypes,
+		Active:     row.Active,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"merchshop/internal/db/queries"
+	"merchshop/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpdateRateLimitBucket runs fn on the bucket stored under key and saves the
+// result. Updates of a key are serialized with a transaction-scoped advisory
+// lock, which also covers buckets that do not exist yet.
+func (r *PgMerchRepository) UpdateRateLimitBucket(ctx context.Context, key string, fn func(b *model.RateLimitBucket)) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.LockRateLimitBucket(ctx, key); err != nil {
+		return err
+	}
+	var b model.RateLimitBucket
+	row, err := qtx.GetRateLimitBucket(ctx, key)
+	if err == nil {
+		b.Tokens = row.Tokens
+		b.UpdatedAt = row.UpdatedAt.Time
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	fn(&b)
+	err = qtx.SaveRateLimitBucket(ctx, queries.SaveRateLimitBucketParams{
+		Key:       key,
+		Tokens:    b.Tokens,
+		UpdatedAt: pgtype.Timestamptz{Time: b.UpdatedAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteIdleRateLimitBuckets drops buckets not updated for idle and returns
+// how many it dropped.
+func (r *PgMerchRepository) DeleteIdleRateLimitBuckets(ctx context.Context, idle time.Duration) (int64, error) {
+	return r.queries.DeleteIdleRateLimitBuckets(ctx, pgtype.Timestamptz{Time: time.Now().Add(-idle), Valid: true})
+}
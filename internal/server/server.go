@@ -1,8 +1,11 @@
 package server
 
 import (
+	"context"
 	"merchshop/internal/api"
 	"merchshop/internal/middleware"
+	"merchshop/internal/oidc"
+	"merchshop/internal/ratelimit"
 	"merchshop/internal/repository"
 	"merchshop/internal/service"
 	"net/http"
@@ -13,24 +16,88 @@ import (
 type Server struct {
 	addr         string
 	merchService *service.MerchService
+	listener     repository.EventListener
+	events       *service.EventHub
+	webhooks     *service.WebhookDispatcher
+	outbox       *service.OutboxRelay
+	auctions     *service.AuctionCloser
+	refunds      *service.GroupPurchaseRefunder
+	wishlists    *service.WishlistWatcher
+	auditChain   *service.AuditChainer
+	keyUses      *service.APIKeyUseRecorder
+	rateLimits   ratelimit.Store
+	limits       ratelimit.Config
+	oidc         *oidc.Provider
+	proxies      []string
 }
 
-func NewServer(addr string, repo repository.MerchRepository) *Server {
-	return &Server{
+// NewServer creates the HTTP server. Outbox events are relayed through relay;
+// when it is nil they are pruned from the outbox once no webhook needs them. Failed logins are tracked in
+// logins. Requests are rate limited with buckets kept in rateLimits, unless
+// it is nil. Passwords are checked by auth, or against the local hashes when
+// it is nil; new hashes are computed as configured by hashing. Single sign-on
+// through provider is enabled unless it is nil. The audit hash chain is
+// keyed with auditKey. Client addresses are taken from X-Forwarded-For only
+// for requests from the trustedProxies networks.
+func NewServer(addr string, repo repository.MerchRepository, listener repository.EventListener, relay service.EventRelay, logins repository.LoginAttemptStore, rateLimits ratelimit.Store, limits ratelimit.Config, hashing service.PasswordHashing, auth service.Authenticator, provider *oidc.Provider, auditKey []byte, trustedProxies []string) *Server {
+	s := &Server{
 		addr:         addr,
-		merchService: service.NewMerchService(repo),
+		merchService: service.NewMerchService(repo, logins, hashing, auth, auditKey),
+		listener:     listener,
+		events:       service.NewEventHub(),
+		webhooks:     service.NewWebhookDispatcher(repo),
+		rateLimits:   rateLimits,
+		limits:       limits,
+		oidc:         provider,
+		proxies:      trustedProxies,
 	}
+	s.auctions = service.NewAuctionCloser(s.merchService)
+	s.refunds = service.NewGroupPurchaseRefunder(s.merchService)
+	s.wishlists = service.NewWishlistWatcher(s.merchService)
+	s.auditChain = service.NewAuditChainer(s.merchService)
+	s.keyUses = service.NewAPIKeyUseRecorder(s.merchService)
+	s.outbox = service.NewOutboxRelay(repo, relay)
+	return s
 }
 
 func (s *Server) ListenAndServe() error {
-	apiServer := api.NewAPIServer(s.merchService)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.events.Run(ctx, s.listener)
+	go s.webhooks.Run(ctx)
+	go s.auctions.Run(ctx)
+	go s.refunds.Run(ctx)
+	go s.wishlists.Run(ctx)
+	go s.auditChain.Run(ctx)
+	go s.keyUses.Run(ctx)
+	if cleaner, ok := s.rateLimits.(interface{ Run(context.Context) }); ok {
+		go cleaner.Run(ctx)
+	}
+	go s.outbox.Run(ctx)
+
+	apiServer := api.NewAPIServer(s.merchService, s.events, s.oidc)
 
 	r := gin.Default()
+	if err := r.SetTrustedProxies(s.proxies); err != nil {
+		return err
+	}
 	r.Use(api.JSONErrorHandler)
-	r.Use(middleware.JWTMiddleware())
+	r.Use(middleware.RequestMetaMiddleware())
+	if s.rateLimits != nil {
+		r.Use(middleware.ClientRateLimitMiddleware(s.rateLimits, s.limits))
+	}
+	r.Use(middleware.JWTMiddleware(s.merchService))
+	if s.rateLimits != nil {
+		r.Use(middleware.RateLimitMiddleware(s.rateLimits, s.limits))
+	}
 
 	handler := api.NewStrictHandler(apiServer, nil)
 	api.RegisterHandlers(r, handler)
+	r.GET("/api/events", apiServer.StreamEvents)
+	if s.oidc != nil {
+		r.GET("/api/auth/oidc/login", apiServer.OIDCLogin)
+		r.GET("/api/auth/oidc/callback", apiServer.OIDCCallback)
+	}
 
 	httpServer := &http.Server{
 		Handler: r,
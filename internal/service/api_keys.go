@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+var apiKeyScopes = []string{
+	model.ScopeCoinsGrant,
+	model.ScopeInfoRead,
+}
+
+const (
+	apiKeyPrefix = "mk_"
+	// apiKeyPrefixLength is how much of a key is kept in clear to tell keys
+	// apart: "mk_" and the first 8 random characters.
+	apiKeyPrefixLength = len(apiKeyPrefix) + 8
+	// apiKeyUseInterval is how often the uses of keys are written out, and
+	// so how many audit entries a busy key adds at most per route.
+	apiKeyUseInterval     = time.Minute
+	apiKeyUseFlushTimeout = 10 * time.Second
+)
+
+func (s *MerchService) CreateServiceAccount(ctx context.Context, admin, name, description string) (*model.ServiceAccount, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, model.ErrInvalidServiceAccount
+	}
+	var account *model.ServiceAccount
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		account, err = r.CreateServiceAccount(ctx, model.ServiceAccount{
+			Name:        name,
+			Description: description,
+			CreatedBy:   admin,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create service account: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditServiceAccountCreated, name, nil,
+			map[string]any{"description": description})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *MerchService) ListServiceAccounts(ctx context.Context, admin string) ([]model.ServiceAccount, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	accounts, err := s.repo.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// CreateAPIKey issues a key for the service account limited to the given
+// scopes. Only the hash of the key is stored, so the returned key is the only
+// copy of it.
+func (s *MerchService) CreateAPIKey(ctx context.Context, admin, serviceAccount string, scopes []string, expiresAt *time.Time) (*model.APIKey, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 {
+		return nil, model.ErrInvalidAPIKeyScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(apiKeyScopes, scope) {
+			return nil, fmt.Errorf("%w: %s", model.ErrInvalidAPIKeyScopes, scope)
+		}
+	}
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, model.ErrInvalidAPIKeyExpiry
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	secret := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	var key *model.APIKey
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		key, err = r.CreateAPIKey(ctx, model.APIKey{
+			ServiceAccount: serviceAccount,
+			Prefix:         secret[:apiKeyPrefixLength],
+			Scopes:         scopes,
+			CreatedBy:      admin,
+			ExpiresAt:      expiresAt,
+		}, hashToken(secret))
+		if err != nil {
+			return fmt.Errorf("failed to create api key: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditAPIKeyCreated, serviceAccount, nil,
+			map[string]any{"id": key.ID, "prefix": key.Prefix, "scopes": scopes, "expiresAt": expiresAt})
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.Key = secret
+	return key, nil
+}
+
+// ListAPIKeys returns the keys of the service account, including revoked
+// and expired ones.
+func (s *MerchService) ListAPIKeys(ctx context.Context, admin, serviceAccount string) ([]model.APIKey, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	keys, err := s.repo.ListAPIKeys(ctx, serviceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *MerchService) RevokeAPIKey(ctx context.Context, admin string, id int32) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.RevokeAPIKey(ctx, id); err != nil {
+			return fmt.Errorf("failed to revoke api key: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditAPIKeyRevoked, fmt.Sprint(id), nil, nil)
+	})
+}
+
+// VerifyAPIKey returns the service account of an active key granting scope
+// and notes the use of the key for route, which RecordAPIKeyUses writes out
+// later so that requests do not wait on it. It returns
+// ErrInvalidAPIKey for unknown, revoked and expired keys and ErrAPIKeyScope
+// when the key lacks the scope.
+func (s *MerchService) VerifyAPIKey(ctx context.Context, secret, route, scope string) (string, error) {
+	key, err := s.repo.GetActiveAPIKey(ctx, hashToken(secret))
+	if errors.Is(err, model.ErrAPIKeyNotFound) {
+		return "", model.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get api key: %w", err)
+	}
+	if !slices.Contains(key.Scopes, scope) {
+		return "", model.ErrAPIKeyScope
+	}
+	now := time.Now()
+	s.keyUses.add(apiKeyUse{keyID: key.ID, serviceAccount: key.ServiceAccount, route: route, scope: scope},
+		apiKeyUseCount{count: 1, first: now, last: now, meta: requestMeta(ctx)})
+	return key.ServiceAccount, nil
+}
+
+// apiKeyUse is the use of a key for a route under a scope.
+type apiKeyUse struct {
+	keyID          int32
+	serviceAccount string
+	route          string
+	scope          string
+}
+
+// apiKeyUseCount sums the uses of a key since they were last written out,
+// with the request metadata of the first one.
+type apiKeyUseCount struct {
+	count       int
+	first, last time.Time
+	meta        model.RequestMeta
+}
+
+// apiKeyUses collects the uses of API keys in memory.
+type apiKeyUses struct {
+	mu   sync.Mutex
+	uses map[apiKeyUse]*apiKeyUseCount
+}
+
+func (u *apiKeyUses) add(use apiKeyUse, count apiKeyUseCount) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	c, ok := u.uses[use]
+	if !ok {
+		if u.uses == nil {
+			u.uses = make(map[apiKeyUse]*apiKeyUseCount)
+		}
+		u.uses[use] = &count
+		return
+	}
+	c.count += count.count
+	if count.first.Before(c.first) {
+		c.first, c.meta = count.first, count.meta
+	}
+	if count.last.After(c.last) {
+		c.last = count.last
+	}
+}
+
+// take returns the uses collected so far and starts collecting anew.
+func (u *apiKeyUses) take() map[apiKeyUse]*apiKeyUseCount {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	uses := u.uses
+	u.uses = nil
+	return uses
+}
+
+// RecordAPIKeyUses writes the key uses noted since the last call out in one
+// transaction: the last use time of each key, and an audit entry for each key
+// and route counting its uses. Uses that fail to be written are kept for the
+// next call.
+func (s *MerchService) RecordAPIKeyUses(ctx context.Context) error {
+	uses := s.keyUses.take()
+	if len(uses) == 0 {
+		return nil
+	}
+	lastUsed := make(map[int32]time.Time)
+	for use, c := range uses {
+		if c.last.After(lastUsed[use.keyID]) {
+			lastUsed[use.keyID] = c.last
+		}
+	}
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.TouchAPIKeys(ctx, lastUsed); err != nil {
+			return fmt.Errorf("failed to record api key use: %w", err)
+		}
+		for use, c := range uses {
+			actor := model.Actor{ServiceAccount: use.serviceAccount}
+			err := r.AppendAudit(ctx, model.AuditEntry{
+				Actor:  actor.String(),
+				Action: model.AuditAPIKeyUsed,
+				Target: use.route,
+				Meta:   c.meta,
+				After: map[string]any{
+					"keyId":       use.keyID,
+					"scope":       use.scope,
+					"uses":        c.count,
+					"firstUsedAt": c.first,
+					"lastUsedAt":  c.last,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to write audit log: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for use, c := range uses {
+			s.keyUses.add(use, *c)
+		}
+		return err
+	}
+	return nil
+}
+
+// APIKeyUseRecorder writes the uses of API keys out in the background.
+type APIKeyUseRecorder struct {
+	service *MerchService
+}
+
+func NewAPIKeyUseRecorder(service *MerchService) *APIKeyUseRecorder {
+	return &APIKeyUseRecorder{service: service}
+}
+
+// Run records key uses every apiKeyUseInterval until ctx is cancelled, and
+// once more then, so that the last uses are not lost on shutdown.
+func (u *APIKeyUseRecorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(apiKeyUseInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), apiKeyUseFlushTimeout)
+			defer cancel()
+			if err := u.service.RecordAPIKeyUses(ctx); err != nil {
+				log.Printf("failed to record api key uses: %v", err)
+			}
+			return
+		case <-ticker.C:
+		}
+		if err := u.service.RecordAPIKeyUses(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to record api key uses: %v", err)
+		}
+	}
+}
+
+// GrantCoins credits the user with new coins or points of another currency,
+// which expire after the currency's lifetime. Users need the admin role;
+// service accounts need an API key with the coins:grant scope, which is
+// checked when the request is authenticated.
+func (s *MerchService) GrantCoins(ctx context.Context, actor model.Actor, toUser, currency string, amount int32, reason string) error {
+	if actor.ServiceAccount == "" {
+		if err := s.RequireAdmin(ctx, actor.Username); err != nil {
+			return err
+		}
+	}
+	if amount <= 0 {
+		return model.ErrInvalidGrant
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := requireActive(ctx, r, toUser); err != nil {
+			return err
+		}
+		c, err := r.GetCurrency(ctx, currency)
+		if err != nil {
+			return fmt.Errorf("failed to get currency: %w", err)
+		}
+		if err := r.AddBalance(ctx, toUser, currency, amount, creditExpiry(c, time.Now())); err != nil {
+			return fmt.Errorf("failed to add coins: %w", err)
+		}
+		grant := model.CoinGrant{ToUser: toUser, Amount: amount, Currency: currency, Reason: reason, GrantedBy: actor.String()}
+		if err := r.InsertCoinGrant(ctx, grant); err != nil {
+			return fmt.Errorf("failed to log coin grant: %w", err)
+		}
+		err = r.CreateNotification(ctx, toUser, model.NotificationCoinsGranted, map[string]any{
+			"amount":   amount,
+			"currency": currency,
+			"reason":   reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		balance, err := balanceOf(ctx, r, toUser, currency)
+		if err != nil {
+			return err
+		}
+		field := balanceField("", currency)
+		return s.audit(ctx, r, actor.String(), model.AuditCoinsGranted, toUser,
+			map[string]any{field: balance - uint32(amount)},
+			map[string]any{field: balance, "amount": amount, "currency": currency, "reason": reason})
+	})
+}
+
+// GetUserInfo returns the info of any user to admins and to service
+// accounts with the info:read scope, and users their own info.
+func (s *MerchService) GetUserInfo(ctx context.Context, actor model.Actor, username string) (*model.Info, error) {
+	if actor.ServiceAccount == "" && actor.Username != username {
+		if err := s.RequireAdmin(ctx, actor.Username); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetInfo(ctx, username)
+}
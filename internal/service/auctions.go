@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	auctionPollInterval = 5 * time.Second
+	auctionCloseBatch   = 50
+)
+
+// CreateAuction opens an auction of the item that closes at endsAt.
+func (s *MerchService) CreateAuction(ctx context.Context, admin, item string, reservePrice uint32, endsAt time.Time) (*model.Auction, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if reservePrice == 0 || !endsAt.After(time.Now()) {
+		return nil, model.ErrInvalidAuction
+	}
+	var auction *model.Auction
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		auction, err = r.CreateAuction(ctx, model.Auction{
+			Item:         item,
+			ReservePrice: reservePrice,
+			EndsAt:       endsAt,
+			CreatedBy:    admin,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create auction: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditAuctionCreated, fmt.Sprint(auction.ID), nil,
+			map[string]any{"item": item, "reservePrice": reservePrice, "endsAt": endsAt})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// ListAuctions returns the open auctions, those ending first first.
+func (s *MerchService) ListAuctions(ctx context.Context) ([]model.Auction, error) {
+	auctions, err := s.repo.ListOpenAuctions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auctions: %w", err)
+	}
+	return auctions, nil
+}
+
+// GetAuction returns the auction together with its bids.
+func (s *MerchService) GetAuction(ctx context.Context, id int32) (*model.Auction, error) {
+	auction, err := s.repo.GetAuction(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auction: %w", err)
+	}
+	auction.Bids, err = s.repo.ListAuctionBids(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auction bids: %w", err)
+	}
+	return auction, nil
+}
+
+// PlaceBid bids amount coins on the auction. The coins are held right away
+// and the previous highest bid, the user's own included, is released with
+// its coins given back. The auction is locked meanwhile, so concurrent bids
+// are placed one after the other. The hold counts toward the bidder's
+// monthly purchase limit from the moment the bid is placed.
+func (s *MerchService) PlaceBid(ctx context.Context, username string, id int32, amount int32) (*model.Auction, error) {
+	if amount <= 0 {
+		return nil, model.ErrInvalidAmount
+	}
+	var auction *model.Auction
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		a, err := r.LockAuction(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get auction: %w", err)
+		}
+		if a.ClosedAt != nil || !time.Now().Before(a.EndsAt) {
+			return model.ErrAuctionClosed
+		}
+		if uint32(amount) < a.ReservePrice || uint32(amount) <= a.HighestBid {
+			return model.ErrBidTooLow
+		}
+		users := []string{username}
+		if a.HighestBidder != "" {
+			users = append(users, a.HighestBidder)
+		}
+		if err := r.LockUsers(ctx, users...); err != nil {
+			return fmt.Errorf("failed to lock users: %w", err)
+		}
+		if err := requireActive(ctx, r, username); err != nil {
+			return err
+		}
+		if a.HighestBidder != "" {
+			if err := r.ReleaseAuctionHold(ctx, id); err != nil {
+				return fmt.Errorf("failed to release hold: %w", err)
+			}
+			if err := r.AddCoins(ctx, a.HighestBidder, int32(a.HighestBid)); err != nil {
+				return fmt.Errorf("failed to give back held coins: %w", err)
+			}
+			if a.HighestBidder != username {
+				err := r.CreateNotification(ctx, a.HighestBidder, model.NotificationAuctionOutbid, map[string]any{
+					"auctionId": id,
+					"item":      a.Item,
+					"amount":    amount,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create notification: %w", err)
+				}
+			}
+		}
+		user, err := r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user.Coins < uint32(amount) {
+			return model.ErrInsufficientFunds
+		}
+		if err := checkPurchaseLimits(ctx, r, username, uint32(amount)); err != nil {
+			return err
+		}
+		if err := r.DeductCoins(ctx, username, amount); err != nil {
+			return fmt.Errorf("failed to hold coins: %w", err)
+		}
+		if err := r.InsertAuctionBid(ctx, id, username, amount); err != nil {
+			return fmt.Errorf("failed to record bid: %w", err)
+		}
+		err = s.audit(ctx, r, username, model.AuditAuctionBid, fmt.Sprint(id),
+			map[string]any{"highestBid": a.HighestBid, "highestBidder": a.HighestBidder},
+			map[string]any{"highestBid": amount, "highestBidder": username})
+		if err != nil {
+			return err
+		}
+		a.HighestBid = uint32(amount)
+		a.HighestBidder = username
+		auction = a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// CloseDueAuctions closes the auctions that have ended, each in a
+// transaction of its own, and returns how many it closed.
+func (s *MerchService) CloseDueAuctions(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListDueAuctions(ctx, auctionCloseBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due auctions: %w", err)
+	}
+	closed := 0
+	var errs []error
+	for _, id := range ids {
+		err := s.closeAuction(ctx, id)
+		switch {
+		case err == nil:
+			closed++
+		case !errors.Is(err, model.ErrAuctionClosed):
+			errs = append(errs, fmt.Errorf("failed to close auction %d: %w", id, err))
+		}
+	}
+	return closed, errors.Join(errs...)
+}
+
+// closeAuction closes the auction and converts the hold of the highest bid,
+// if any, into a purchase of the item by the bidder. The purchase limit was
+// checked and the hold counted against it when the bid was placed, so the
+// purchase is marked with the auction and does not count a second time.
+// A bidder deactivated since bidding does not win: the hold is given back
+// and the auction closes without a winner.
+func (s *MerchService) closeAuction(ctx context.Context, id int32) error {
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		a, err := r.LockAuction(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get auction: %w", err)
+		}
+		if a.ClosedAt != nil {
+			return model.ErrAuctionClosed
+		}
+		if a.HighestBidder != "" {
+			if err := r.LockUsers(ctx, a.HighestBidder); err != nil {
+				return fmt.Errorf("failed to lock users: %w", err)
+			}
+			err := requireActive(ctx, r, a.HighestBidder)
+			if errors.Is(err, model.ErrUserDeactivated) {
+				return s.refundAuctionHold(ctx, r, a)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := r.CloseAuction(ctx, id, a.HighestBidder); err != nil {
+			return err
+		}
+		if a.HighestBidder == "" {
+			return s.audit(ctx, r, model.AuditActorSystem, model.AuditAuctionClosed, fmt.Sprint(id), nil,
+				map[string]any{"item": a.Item})
+		}
+		order := model.PurchaseOrder{
+			Username:  a.HighestBidder,
+			Item:      a.Item,
+			Price:     a.HighestBid,
+			Currency:  model.DefaultCurrency,
+			AuctionID: &id,
+		}
+		if err := r.CreatePurchase(ctx, order); err != nil {
+			return fmt.Errorf("failed to create purchase record: %w", err)
+		}
+		err = r.CreateNotification(ctx, a.HighestBidder, model.NotificationAuctionWon, map[string]any{
+			"auctionId": id,
+			"item":      a.Item,
+			"price":     a.HighestBid,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		err = r.Publish(ctx, model.ItemPurchased{
+			Username: a.HighestBidder,
+			Item:     a.Item,
+			Price:    a.HighestBid,
+			Currency: model.DefaultCurrency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish purchase event: %w", err)
+		}
+		return s.audit(ctx, r, model.AuditActorSystem, model.AuditAuctionClosed, fmt.Sprint(id), nil,
+			map[string]any{"item": a.Item, "winner": a.HighestBidder, "price": a.HighestBid})
+	})
+}
+
+// refundAuctionHold closes the auction without a winner and gives the hold
+// of the highest bid back to the bidder.
+func (s *MerchService) refundAuctionHold(ctx context.Context, r repository.MerchRepository, a *model.Auction) error {
+	if err := r.CloseAuction(ctx, a.ID, ""); err != nil {
+		return err
+	}
+	if err := r.ReleaseAuctionHold(ctx, a.ID); err != nil {
+		return fmt.Errorf("failed to release hold: %w", err)
+	}
+	if err := r.AddCoins(ctx, a.HighestBidder, int32(a.HighestBid)); err != nil {
+		return fmt.Errorf("failed to give back held coins: %w", err)
+	}
+	return s.audit(ctx, r, model.AuditActorSystem, model.AuditAuctionClosed, fmt.Sprint(a.ID), nil,
+		map[string]any{"item": a.Item, "refunded": a.HighestBidder, "amount": a.HighestBid})
+}
+
+// AuctionCloser closes auctions in the background once they end.
+type AuctionCloser struct {
+	service *MerchService
+}
+
+func NewAuctionCloser(service *MerchService) *AuctionCloser {
+	return &AuctionCloser{service: service}
+}
+
+// Run polls for ended auctions until ctx is cancelled.
+func (c *AuctionCloser) Run(ctx context.Context) {
+	ticker := time.NewTicker(auctionPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := c.service.CloseDueAuctions(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to close auctions: %v", err)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func newAuctionRepo(users ...model.User) *fakeRepo {
+	r := newFakeRepo(users...)
+	r.auctions[1] = model.Auction{ID: 1, Item: "hoody", ReservePrice: 50, EndsAt: time.Now().Add(time.Hour)}
+	r.auctions[2] = model.Auction{ID: 2, Item: "umbrella", ReservePrice: 10, EndsAt: time.Now().Add(time.Hour)}
+	return r
+}
+
+func TestPlaceBidOutbids(t *testing.T) {
+	r := newAuctionRepo(model.User{Username: "alice", Coins: 500}, model.User{Username: "bob", Coins: 500})
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.PlaceBid(ctx, "bob", 1, 100); err != nil {
+		t.Fatalf("bob's bid: %v", err)
+	}
+	a, err := s.PlaceBid(ctx, "alice", 1, 150)
+	if err != nil {
+		t.Fatalf("alice's bid: %v", err)
+	}
+	if a.HighestBidder != "alice" || a.HighestBid != 150 {
+		t.Fatalf("highest bid = %s %d, want alice 150", a.HighestBidder, a.HighestBid)
+	}
+	if got := r.users["alice"].Coins; got != 350 {
+		t.Errorf("alice has %d coins, want 350", got)
+	}
+	if got := r.users["bob"].Coins; got != 500 {
+		t.Errorf("bob has %d coins, want the hold back and 500", got)
+	}
+	if n := r.notifications[len(r.notifications)-1]; n.username != "bob" || n.kind != model.NotificationAuctionOutbid {
+		t.Errorf("last notification = %+v, want bob outbid", n)
+	}
+	// Both balances change, so both rows are locked up front.
+	if lock := r.locks[len(r.locks)-1]; !slices.Contains(lock, "alice") || !slices.Contains(lock, "bob") {
+		t.Errorf("locked %v, want alice and bob", lock)
+	}
+
+	if _, err := s.PlaceBid(ctx, "bob", 1, 150); !errors.Is(err, model.ErrBidTooLow) {
+		t.Fatalf("matching bid = %v, want %v", err, model.ErrBidTooLow)
+	}
+}
+
+func TestPlaceBidPurchaseLimit(t *testing.T) {
+	r := newAuctionRepo(model.User{Username: "alice", Coins: 500}, model.User{Username: "bob", Coins: 500})
+	r.limits["alice"] = model.SpendingLimits{MonthlyPurchase: ptrUint32(100)}
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.PlaceBid(ctx, "alice", 1, 80); err != nil {
+		t.Fatalf("first bid: %v", err)
+	}
+	if _, err := s.PlaceBid(ctx, "bob", 2, 20); err != nil {
+		t.Fatalf("bob's bid: %v", err)
+	}
+	// The hold on the first auction counts, so only 20 coins are left.
+	_, err := s.PlaceBid(ctx, "alice", 2, 30)
+	checkLimitError(t, err, model.LimitMonthlyPurchase, 20)
+	a, err := r.LockAuction(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.HighestBidder != "bob" || r.users["bob"].Coins != 480 || r.users["alice"].Coins != 420 {
+		t.Fatalf("a bid over the limit changed the auction or balances")
+	}
+
+	// Raising one's own bid releases the old hold before the check.
+	if _, err := s.PlaceBid(ctx, "alice", 1, 100); err != nil {
+		t.Fatalf("raised bid: %v", err)
+	}
+	if got := r.users["alice"].Coins; got != 400 {
+		t.Errorf("alice has %d coins, want 400", got)
+	}
+}
+
+func TestCloseAuctionCountsHoldOnce(t *testing.T) {
+	r := newAuctionRepo(model.User{Username: "alice", Coins: 500})
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.PlaceBid(ctx, "alice", 1, 120); err != nil {
+		t.Fatalf("bid: %v", err)
+	}
+	a := r.auctions[1]
+	a.EndsAt = time.Now().Add(-time.Second)
+	r.auctions[1] = a
+	closed, err := s.CloseDueAuctions(ctx)
+	if err != nil || closed != 1 {
+		t.Fatalf("CloseDueAuctions = %d, %v, want 1 closed", closed, err)
+	}
+
+	if got := r.auctions[1]; got.ClosedAt == nil || got.Winner != "alice" {
+		t.Fatalf("auction = %+v, want closed and won by alice", got)
+	}
+	if len(r.purchases) != 1 || r.purchases[0].order.AuctionID == nil || *r.purchases[0].order.AuctionID != 1 {
+		t.Fatalf("purchases = %+v, want one marked with the auction", r.purchases)
+	}
+	if got := r.users["alice"].Coins; got != 380 {
+		t.Errorf("alice has %d coins, want 380", got)
+	}
+	_, month := limitPeriods(time.Now())
+	spent, err := r.GetPurchaseSpendSince(ctx, "alice", month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent != 120 {
+		t.Errorf("alice spent %d coins this month, want 120", spent)
+	}
+
+	if _, err := s.PlaceBid(ctx, "alice", 1, 200); !errors.Is(err, model.ErrAuctionClosed) {
+		t.Fatalf("bid on a closed auction = %v, want %v", err, model.ErrAuctionClosed)
+	}
+}
+
+func TestCloseAuctionWithoutBids(t *testing.T) {
+	r := newAuctionRepo()
+	a := r.auctions[2]
+	a.EndsAt = time.Now().Add(-time.Second)
+	r.auctions[2] = a
+	s := newTestService(r)
+
+	if err := s.closeAuction(context.Background(), 2); err != nil {
+		t.Fatalf("closeAuction: %v", err)
+	}
+	if got := r.auctions[2]; got.ClosedAt == nil || got.Winner != "" {
+		t.Fatalf("auction = %+v, want closed without a winner", got)
+	}
+	if len(r.purchases) != 0 {
+		t.Fatalf("purchases = %+v, want none", r.purchases)
+	}
+	if err := s.closeAuction(context.Background(), 2); !errors.Is(err, model.ErrAuctionClosed) {
+		t.Fatalf("closing again = %v, want %v", err, model.ErrAuctionClosed)
+	}
+}
+
+func TestCloseAuctionRefundsDeactivatedWinner(t *testing.T) {
+	r := newAuctionRepo(model.User{Username: "alice", Coins: 500})
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.PlaceBid(ctx, "alice", 1, 120); err != nil {
+		t.Fatalf("bid: %v", err)
+	}
+	alice := r.users["alice"]
+	deactivatedAt := time.Now()
+	alice.DeactivatedAt = &deactivatedAt
+	r.users["alice"] = alice
+
+	if err := s.closeAuction(ctx, 1); err != nil {
+		t.Fatalf("closeAuction: %v", err)
+	}
+	if got := r.auctions[1]; got.ClosedAt == nil || got.Winner != "" {
+		t.Fatalf("auction = %+v, want closed without a winner", got)
+	}
+	if len(r.purchases) != 0 {
+		t.Fatalf("purchases = %+v, want none", r.purchases)
+	}
+	if got := r.users["alice"].Coins; got != 500 {
+		t.Errorf("alice has %d coins, want the hold back and 500", got)
+	}
+	_, month := limitPeriods(time.Now())
+	spent, err := r.GetPurchaseSpendSince(ctx, "alice", month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent != 0 {
+		t.Errorf("alice spent %d coins this month, want 0", spent)
+	}
+}
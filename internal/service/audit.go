@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// requestMeta returns the request metadata stored by the request middleware,
+// or empty metadata for actions not coming from HTTP.
+func requestMeta(ctx context.Context) model.RequestMeta {
+	meta, _ := ctx.Value("requestMeta").(model.RequestMeta)
+	return meta
+}
+
+// audit appends an entry to the audit log through r, which should be the
+// transaction repository of the audited change.
+func (s *MerchService) audit(ctx context.Context, r repository.MerchRepository, actor, action, target string, before, after map[string]any) error {
+	err := r.AppendAudit(ctx, model.AuditEntry{
+		Actor:  actor,
+		Action: action,
+		Target: target,
+		Meta:   requestMeta(ctx),
+		Before: before,
+		After:  after,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// auditAlone records an action that changes nothing else in the database.
+func (s *MerchService) auditAlone(ctx context.Context, actor, action, target string, after map[string]any) error {
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		return s.audit(ctx, r, actor, action, target, nil, after)
+	})
+}
+
+// GetAuditLog returns audit entries matching the filter, newest first.
+func (s *MerchService) GetAuditLog(ctx context.Context, auditor string, filter model.AuditFilter) ([]model.AuditEntry, error) {
+	if err := s.requireRole(ctx, auditor, model.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if err := validatePeriod(filter.Period); err != nil {
+		return nil, err
+	}
+	entries, err := s.repo.GetAuditLog(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// VerifyAuditLog checks the audit hash chain for tampering.
+func (s *MerchService) VerifyAuditLog(ctx context.Context, auditor string) (*model.AuditVerification, error) {
+	if err := s.requireRole(ctx, auditor, model.RoleAuditor); err != nil {
+		return nil, err
+	}
+	result, err := s.repo.VerifyAuditChain(ctx, s.auditKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify audit log: %w", err)
+	}
+	return result, nil
+}
+
+// AuditReportExport records that actor exported a report.
+func (s *MerchService) AuditReportExport(ctx context.Context, actor string, kind model.ReportKind, format model.ReportFormat, period model.Period) error {
+	after := map[string]any{
+		"kind":   kind,
+		"format": format,
+	}
+	if period.From != nil {
+		after["from"] = period.From
+	}
+	if period.To != nil {
+		after["to"] = period.To
+	}
+	return s.auditAlone(ctx, actor, model.AuditReportExported, string(kind), after)
+}
+
+const (
+	auditChainInterval  = time.Second
+	auditChainBatchSize = 500
+)
+
+// ChainAuditLog links a batch of newly appended audit entries to the hash
+// chain and returns how many it linked.
+func (s *MerchService) ChainAuditLog(ctx context.Context) (int, error) {
+	var chained int
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		chained, err = r.ChainAuditEntries(ctx, s.auditKey, auditChainBatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to chain audit log: %w", err)
+	}
+	return chained, nil
+}
+
+// AuditChainer links audit entries to the hash chain in the background, off
+// the path of the requests that append them.
+type AuditChainer struct {
+	service *MerchService
+}
+
+func NewAuditChainer(service *MerchService) *AuditChainer {
+	return &AuditChainer{service: service}
+}
+
+// Run links new entries until ctx is cancelled.
+func (c *AuditChainer) Run(ctx context.Context) {
+	ticker := time.NewTicker(auditChainInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			chained, err := c.service.ChainAuditLog(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("failed to chain audit log: %v", err)
+				}
+				break
+			}
+			if chained < auditChainBatchSize {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"merchshop/internal/ldap"
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// Authenticator checks the password of a user signing in through /api/auth.
+// It returns model.ErrInvalidPassword for wrong credentials and
+// model.ErrUserNotFound for users it knows nothing about, who are then
+// registered with the given password. Users accepted by an authenticator
+// without a merch account get one; their balances are always kept in the
+// merch database.
+type Authenticator interface {
+	Authenticate(ctx context.Context, username, password string) error
+	// CanonicalUsername returns the form of username the merch account is
+	// kept under, so that spellings the authenticator treats as the same
+	// user share one account.
+	CanonicalUsername(username string) string
+	// ManagesPasswords reports whether passwords are stored in the merch
+	// database and can be changed and reset there.
+	ManagesPasswords() bool
+}
+
+// LocalAuthenticator checks passwords against the hashes in the merch
+// database, upgrading outdated hashes on success.
+type LocalAuthenticator struct {
+	repo    repository.MerchRepository
+	hashing PasswordHashing
+}
+
+func NewLocalAuthenticator(repo repository.MerchRepository, hashing PasswordHashing) *LocalAuthenticator {
+	return &LocalAuthenticator{repo: repo, hashing: hashing}
+}
+
+func (a *LocalAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	user, err := a.repo.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if !checkPasswordHash(password, user.PasswordHash) {
+		return model.ErrInvalidPassword
+	}
+	rehashPassword(ctx, a.repo, user, password, a.hashing)
+	return nil
+}
+
+func (a *LocalAuthenticator) CanonicalUsername(username string) string {
+	return username
+}
+
+func (a *LocalAuthenticator) ManagesPasswords() bool {
+	return true
+}
+
+// LDAPAuthenticator checks passwords by binding to a directory as the user.
+// Users unknown to the directory are rejected like wrong passwords, so no
+// one can register on their own.
+type LDAPAuthenticator struct {
+	client *ldap.Client
+}
+
+func NewLDAPAuthenticator(cfg ldap.Config) (*LDAPAuthenticator, error) {
+	client, err := ldap.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &LDAPAuthenticator{client: client}, nil
+}
+
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	err := a.client.Authenticate(ctx, username, password)
+	if errors.Is(err, ldap.ErrInvalidCredentials) {
+		return model.ErrInvalidPassword
+	}
+	return err
+}
+
+// CanonicalUsername lowercases the username: directories match names
+// case-insensitively, so Alice and alice bind as the same entry.
+func (a *LDAPAuthenticator) CanonicalUsername(username string) string {
+	return strings.ToLower(username)
+}
+
+func (a *LDAPAuthenticator) ManagesPasswords() bool {
+	return false
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// SetProductVariant adds a variant to the item, or updates the item's variant
+// with the same SKU, replacing its size, colour, stock and price. A nil stock
+// is unlimited. Making the variant the default takes the mark off the item's
+// previous default variant.
+func (s *MerchService) SetProductVariant(ctx context.Context, admin string, variant model.ProductVariant) (*model.ProductVariant, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if variant.SKU == "" || (variant.Price != nil && *variant.Price == 0) {
+		return nil, model.ErrInvalidVariant
+	}
+	var updated *model.ProductVariant
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		updated, err = r.SetProductVariant(ctx, variant)
+		if err != nil {
+			return fmt.Errorf("failed to set product variant: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditVariantSet, variant.SKU, nil, map[string]any{
+			"item":    variant.Item,
+			"size":    variant.Size,
+			"color":   variant.Color,
+			"stock":   variant.Stock,
+			"price":   variant.Price,
+			"default": variant.Default,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// productVariant returns the variant of the item with the given SKU or, when
+// no SKU is given, the item's default variant. It returns nil for items
+// without variants, and ErrVariantRequired for items that have variants but
+// no default one.
+func productVariant(ctx context.Context, r repository.MerchRepository, item, sku string) (*model.ProductVariant, error) {
+	if sku != "" {
+		v, err := r.GetProductVariant(ctx, item, sku)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product variant: %w", err)
+		}
+		return v, nil
+	}
+	v, err := r.GetDefaultProductVariant(ctx, item)
+	if err == nil {
+		return v, nil
+	}
+	if !errors.Is(err, model.ErrVariantNotFound) {
+		return nil, fmt.Errorf("failed to get default product variant: %w", err)
+	}
+	hasVariants, err := r.HasProductVariants(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check product variants: %w", err)
+	}
+	if hasVariants {
+		return nil, model.ErrVariantRequired
+	}
+	return nil, nil
+}
+
+// SetProductCurrency changes the currency the item is priced in; the price
+// itself is kept. Group purchases are only started for items priced in
+// coins.
+func (s *MerchService) SetProductCurrency(ctx context.Context, admin, item, currency string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		product, err := r.GetProduct(ctx, item)
+		if err != nil {
+			return fmt.Errorf("failed to get product: %w", err)
+		}
+		if err := r.SetProductCurrency(ctx, item, currency); err != nil {
+			return fmt.Errorf("failed to set product currency: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditProductCurrencySet, item,
+			map[string]any{"currency": product.Currency}, map[string]any{"currency": currency})
+	})
+}
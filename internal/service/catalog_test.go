@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"merchshop/internal/model"
+)
+
+func TestSetProductCurrency(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "admin", Role: model.RoleAdmin}, model.User{Username: "alice", Role: model.RoleUser})
+	r.products["cup"] = model.Product{Item: "cup", Price: 20, Currency: model.DefaultCurrency, Active: true}
+	r.currencies["points"] = model.Currency{Code: "points", Name: "Баллы"}
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if err := s.SetProductCurrency(ctx, "alice", "cup", "points"); !errors.Is(err, model.ErrForbidden) {
+		t.Fatalf("SetProductCurrency by a user = %v, want %v", err, model.ErrForbidden)
+	}
+	if err := s.SetProductCurrency(ctx, "admin", "cup", "bonus"); !errors.Is(err, model.ErrCurrencyNotFound) {
+		t.Fatalf("SetProductCurrency to an unknown currency = %v, want %v", err, model.ErrCurrencyNotFound)
+	}
+	if len(r.audit) != 0 {
+		t.Fatalf("failed changes wrote %d audit entries", len(r.audit))
+	}
+
+	if err := s.SetProductCurrency(ctx, "admin", "cup", "points"); err != nil {
+		t.Fatalf("SetProductCurrency: %v", err)
+	}
+	if got := r.products["cup"]; got.Currency != "points" || got.Price != 20 {
+		t.Fatalf("cup = %+v, want priced at 20 points", got)
+	}
+	if len(r.audit) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(r.audit))
+	}
+	entry := r.audit[0]
+	if entry.Action != model.AuditProductCurrencySet || entry.Target != "cup" ||
+		entry.Before["currency"] != model.DefaultCurrency || entry.After["currency"] != "points" {
+		t.Fatalf("audit entry = %+v", entry)
+	}
+}
+
+func TestProductVariant(t *testing.T) {
+	r := newFakeRepo()
+	r.variants = []model.ProductVariant{
+		{ID: 1, Item: "hoody", SKU: "hoody-classic", Default: true},
+		{ID: 2, Item: "hoody", SKU: "hoody-pink", Color: "pink"},
+		{ID: 3, Item: "t-shirt", SKU: "t-shirt-m", Size: "M"},
+	}
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		item, sku string
+		wantID    int32
+		wantErr   error
+	}{
+		{item: "hoody", sku: "hoody-pink", wantID: 2},
+		{item: "hoody", wantID: 1},
+		{item: "t-shirt", wantErr: model.ErrVariantRequired},
+		{item: "t-shirt", sku: "hoody-pink", wantErr: model.ErrVariantNotFound},
+		{item: "cup"},
+	} {
+		v, err := productVariant(ctx, r, tt.item, tt.sku)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("productVariant(%q, %q) = %v, want %v", tt.item, tt.sku, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("productVariant(%q, %q): %v", tt.item, tt.sku, err)
+			continue
+		}
+		if tt.wantID == 0 && v != nil || tt.wantID != 0 && (v == nil || v.ID != tt.wantID) {
+			t.Errorf("productVariant(%q, %q) = %+v, want variant %d", tt.item, tt.sku, v, tt.wantID)
+		}
+	}
+}
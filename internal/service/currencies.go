@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// CreateCurrency adds a currency users can be granted and charged in.
+func (s *MerchService) CreateCurrency(ctx context.Context, admin string, currency model.Currency) (*model.Currency, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if currency.Code == "" || currency.Name == "" {
+		return nil, model.ErrInvalidCurrency
+	}
+	if currency.LifetimeDays != nil && *currency.LifetimeDays == 0 {
+		return nil, model.ErrInvalidCurrencyLifetime
+	}
+	var created *model.Currency
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		created, err = r.CreateCurrency(ctx, currency)
+		if err != nil {
+			return fmt.Errorf("failed to create currency: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditCurrencyCreated, currency.Code, nil, map[string]any{
+			"name":         currency.Name,
+			"transferable": currency.Transferable,
+			"lifetimeDays": currency.LifetimeDays,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *MerchService) ListCurrencies(ctx context.Context) ([]model.Currency, error) {
+	currencies, err := s.repo.ListCurrencies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list currencies: %w", err)
+	}
+	return currencies, nil
+}
+
+// AdjustBalance corrects the user's balance in the currency by amount, which
+// takes coins away when negative. Credits expire like any other credit of
+// the currency. The user is notified of the adjustment and its reason.
+func (s *MerchService) AdjustBalance(ctx context.Context, admin, username, currency string, amount int32, reason string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if amount == 0 {
+		return model.ErrInvalidAdjustment
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := requireActive(ctx, r, username); err != nil {
+			return err
+		}
+		c, err := r.GetCurrency(ctx, currency)
+		if err != nil {
+			return fmt.Errorf("failed to get currency: %w", err)
+		}
+		before, err := balanceOf(ctx, r, username, currency)
+		if err != nil {
+			return err
+		}
+		if amount > 0 {
+			err = r.AddBalance(ctx, username, currency, amount, creditExpiry(c, time.Now()))
+		} else {
+			_, err = r.DeductBalance(ctx, username, currency, -amount)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to adjust balance: %w", err)
+		}
+		adjustment := model.BalanceAdjustment{Username: username, Amount: amount, Currency: currency, Reason: reason, AdjustedBy: admin}
+		if err := r.InsertBalanceAdjustment(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to log balance adjustment: %w", err)
+		}
+		err = r.CreateNotification(ctx, username, model.NotificationBalanceAdjusted, map[string]any{
+			"amount":   amount,
+			"currency": currency,
+			"reason":   reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		after, err := balanceOf(ctx, r, username, currency)
+		if err != nil {
+			return err
+		}
+		field := balanceField("", currency)
+		return s.audit(ctx, r, admin, model.AuditBalanceAdjusted, username,
+			map[string]any{field: before},
+			map[string]any{field: after, "amount": amount, "currency": currency, "reason": reason})
+	})
+}
+
+// creditExpiry returns when a credit of the currency made at now expires,
+// or nil when the currency does not expire.
+func creditExpiry(currency *model.Currency, now time.Time) *time.Time {
+	if currency.LifetimeDays == nil {
+		return nil
+	}
+	expiresAt := now.AddDate(0, 0, int(*currency.LifetimeDays))
+	return &expiresAt
+}
+
+// balanceField names a balance in audit entries, prefixed with prefix:
+// coins, as they always were, or balance for other currencies, whose code is
+// recorded next to it.
+func balanceField(prefix, currency string) string {
+	name := "balance"
+	if currency == model.DefaultCurrency {
+		name = "coins"
+	}
+	if prefix == "" {
+		return name
+	}
+	return prefix + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// userBalances returns the user's balances, coins first, then the other
+// currencies the user holds.
+func userBalances(ctx context.Context, r repository.MerchRepository, user *model.User) ([]model.Balance, error) {
+	others, err := r.GetBalances(ctx, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+	balances := []model.Balance{{Currency: model.DefaultCurrency, Amount: user.Coins}}
+	return append(balances, others...), nil
+}
+
+// balanceOf returns the user's balance in the currency.
+func balanceOf(ctx context.Context, r repository.MerchRepository, username, currency string) (uint32, error) {
+	user, err := r.GetUser(ctx, username)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	balances, err := userBalances(ctx, r, user)
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range balances {
+		if b.Currency == currency {
+			return b.Amount, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func TestSendCoinKeepsLotExpiry(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "alice"}, model.User{Username: "bob"})
+	lifetime := uint32(30)
+	r.currencies["points"] = model.Currency{Code: "points", Name: "Баллы", Transferable: true, LifetimeDays: &lifetime}
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(48 * time.Hour)
+	r.lots = []fakeLot{
+		{username: "alice", currency: "points", amount: 30, expiresAt: &later},
+		{username: "alice", currency: "points", amount: 50, expiresAt: &soon},
+	}
+	s := newTestService(r)
+
+	if err := s.SendCoin(context.Background(), "alice", "bob", "points", 60); err != nil {
+		t.Fatalf("SendCoin: %v", err)
+	}
+	var received []fakeLot
+	for _, l := range r.lots {
+		if l.username == "bob" {
+			received = append(received, l)
+		}
+	}
+	want := []fakeLot{
+		{username: "bob", currency: "points", amount: 50, expiresAt: &soon},
+		{username: "bob", currency: "points", amount: 10, expiresAt: &later},
+	}
+	if len(received) != len(want) {
+		t.Fatalf("bob got %d lots, want %d", len(received), len(want))
+	}
+	for i, l := range received {
+		if l.amount != want[i].amount || !l.expiresAt.Equal(*want[i].expiresAt) {
+			t.Errorf("lot %d = %d expiring %v, want %d expiring %v", i, l.amount, *l.expiresAt, want[i].amount, *want[i].expiresAt)
+		}
+	}
+
+	entry := r.audit[len(r.audit)-1]
+	if entry.After["fromBalance"] != uint32(20) || entry.After["toBalance"] != uint32(60) {
+		t.Errorf("audit after = %v, want fromBalance 20 and toBalance 60", entry.After)
+	}
+	if _, ok := entry.After["fromCoins"]; ok {
+		t.Errorf("audit after = %v names a points balance coins", entry.After)
+	}
+}
+
+func TestSendCoinAuditNamesCoins(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "alice", Coins: 100}, model.User{Username: "bob", Coins: 10})
+	s := newTestService(r)
+
+	if err := s.SendCoin(context.Background(), "alice", "bob", model.DefaultCurrency, 40); err != nil {
+		t.Fatalf("SendCoin: %v", err)
+	}
+	entry := r.audit[len(r.audit)-1]
+	if entry.Before["fromCoins"] != uint32(100) || entry.Before["toCoins"] != uint32(10) {
+		t.Errorf("audit before = %v, want fromCoins 100 and toCoins 10", entry.Before)
+	}
+	if entry.After["fromCoins"] != uint32(60) || entry.After["toCoins"] != uint32(50) {
+		t.Errorf("audit after = %v, want fromCoins 60 and toCoins 50", entry.After)
+	}
+}
+
+func TestSendCoinInsufficientBalance(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "alice"}, model.User{Username: "bob"})
+	r.currencies["points"] = model.Currency{Code: "points", Name: "Баллы", Transferable: true}
+	past := time.Now().Add(-time.Hour)
+	r.lots = []fakeLot{
+		{username: "alice", currency: "points", amount: 10},
+		{username: "alice", currency: "points", amount: 50, expiresAt: &past},
+	}
+	s := newTestService(r)
+
+	err := s.SendCoin(context.Background(), "alice", "bob", "points", 20)
+	if !errors.Is(err, model.ErrInsufficientFunds) {
+		t.Fatalf("SendCoin = %v, want %v", err, model.ErrInsufficientFunds)
+	}
+	if r.lots[0].amount != 10 {
+		t.Errorf("alice has %d points left, want 10", r.lots[0].amount)
+	}
+}
+
+func TestAdjustBalanceNotifies(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "admin", Role: model.RoleAdmin}, model.User{Username: "alice", Coins: 100})
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if err := s.AdjustBalance(ctx, "admin", "alice", model.DefaultCurrency, 0, ""); !errors.Is(err, model.ErrInvalidAdjustment) {
+		t.Fatalf("AdjustBalance by 0 = %v, want %v", err, model.ErrInvalidAdjustment)
+	}
+	if err := s.AdjustBalance(ctx, "admin", "alice", model.DefaultCurrency, -101, ""); !errors.Is(err, model.ErrInsufficientFunds) {
+		t.Fatalf("AdjustBalance below zero = %v, want %v", err, model.ErrInsufficientFunds)
+	}
+	if err := s.AdjustBalance(ctx, "admin", "alice", model.DefaultCurrency, 50, "bonus"); err != nil {
+		t.Fatalf("AdjustBalance: %v", err)
+	}
+	if err := s.AdjustBalance(ctx, "admin", "alice", model.DefaultCurrency, -30, "correction"); err != nil {
+		t.Fatalf("AdjustBalance: %v", err)
+	}
+	if got := r.users["alice"].Coins; got != 120 {
+		t.Fatalf("alice has %d coins, want 120", got)
+	}
+	if len(r.adjustments) != 2 || r.adjustments[1].Amount != -30 || r.adjustments[1].AdjustedBy != "admin" {
+		t.Fatalf("adjustments = %+v, want both logged", r.adjustments)
+	}
+	if len(r.notifications) != 2 {
+		t.Fatalf("got %d notifications, want one per adjustment", len(r.notifications))
+	}
+	n := r.notifications[1]
+	if n.username != "alice" || n.kind != model.NotificationBalanceAdjusted ||
+		n.data["amount"] != int32(-30) || n.data["reason"] != "correction" {
+		t.Fatalf("notification = %+v, want alice's adjustment by -30", n)
+	}
+	entry := r.audit[len(r.audit)-1]
+	if entry.Action != model.AuditBalanceAdjusted || entry.Before["coins"] != uint32(150) || entry.After["coins"] != uint32(120) {
+		t.Fatalf("audit entry = %+v", entry)
+	}
+}
+
+func TestGetWishlistMissingInItemCurrency(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "alice", Coins: 100})
+	r.currencies["points"] = model.Currency{Code: "points", Name: "Баллы"}
+	r.lots = []fakeLot{{username: "alice", currency: "points", amount: 30}}
+	r.wishlist = []model.WishlistItem{
+		{Item: "hoody", Price: 150, Currency: model.DefaultCurrency},
+		{Item: "cup", Price: 50, Currency: "points"},
+		{Item: "pen", Price: 20, Currency: "points"},
+	}
+	s := newTestService(r)
+
+	wishlist, err := s.GetWishlist(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("GetWishlist: %v", err)
+	}
+	want := map[string]uint32{"hoody": 50, "cup": 20, "pen": 0}
+	for _, w := range wishlist {
+		if w.Missing != want[w.Item] {
+			t.Errorf("%s misses %d %s, want %d", w.Item, w.Missing, w.Currency, want[w.Item])
+		}
+	}
+}
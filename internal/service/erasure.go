@@ -0,0 +1,122 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	// erasedUserPrefix starts the pseudonyms erased users are replaced with.
+	erasedUserPrefix   = "erased-"
+	exportAuditPageLen = 500
+)
+
+// ExportUserData bundles what is stored about the user: the profile and
+// balances, transfers, purchases and the audit events the user made or was
+// the target of.
+func (s *MerchService) ExportUserData(ctx context.Context, username string) (*model.DataExport, error) {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	transfers, err := s.repo.ExportCoinTransfers(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export transfers: %w", err)
+	}
+	balances, err := userBalances(ctx, s.repo, user)
+	if err != nil {
+		return nil, err
+	}
+	purchases, err := s.repo.GetPurchases(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get purchases: %w", err)
+	}
+	byActor, err := s.allAuditEntries(ctx, model.AuditFilter{Actor: username})
+	if err != nil {
+		return nil, err
+	}
+	byTarget, err := s.allAuditEntries(ctx, model.AuditFilter{Target: username})
+	if err != nil {
+		return nil, err
+	}
+	events := append(byActor, byTarget...)
+	slices.SortFunc(events, func(a, b model.AuditEntry) int { return cmp.Compare(a.Seq, b.Seq) })
+	events = slices.CompactFunc(events, func(a, b model.AuditEntry) bool { return a.Seq == b.Seq })
+
+	user.PasswordHash = ""
+	export := &model.DataExport{
+		ExportedAt:  time.Now(),
+		User:        *user,
+		Transfers:   transfers,
+		Balances:    balances,
+		Purchases:   purchases,
+		AuditEvents: events,
+	}
+	if err := s.auditAlone(ctx, username, model.AuditDataExported, username, nil); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// allAuditEntries pages through the audit entries matching filter.
+func (s *MerchService) allAuditEntries(ctx context.Context, filter model.AuditFilter) ([]model.AuditEntry, error) {
+	filter.Limit = exportAuditPageLen
+	var entries []model.AuditEntry
+	for {
+		page, err := s.repo.GetAuditLog(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audit log: %w", err)
+		}
+		entries = append(entries, page...)
+		if len(page) < exportAuditPageLen {
+			return entries, nil
+		}
+		filter.BeforeSeq = &page[len(page)-1].Seq
+	}
+}
+
+// EraseUser removes a user on their request. Transfers, purchases, audit
+// entries and other history shared with other users are kept under a
+// pseudonym instead of the username; personal data is deleted. The username
+// cannot be registered again. It returns the pseudonym, which is not written
+// to the audit log along with the username.
+func (s *MerchService) EraseUser(ctx context.Context, admin, username string) (string, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return "", err
+	}
+	if admin == username {
+		return "", model.ErrEraseSelf
+	}
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate pseudonym: %w", err)
+	}
+	pseudonym := erasedUserPrefix + hex.EncodeToString(raw)
+
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		user, err := r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if err := r.EraseUser(ctx, s.auditKey, username, pseudonym); err != nil {
+			return fmt.Errorf("failed to erase user: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditUserErased, pseudonym,
+			map[string]any{"coins": user.Coins}, nil)
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := s.logins.ResetLoginFailures(ctx, userLoginPolicy.prefix+username); err != nil {
+		return "", fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return pseudonym, nil
+}
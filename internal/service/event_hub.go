@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	subscriberBufferSize = 16
+	listenRetryDelay     = time.Second
+	maxListenRetryDelay  = 30 * time.Second
+)
+
+// EventHub fans out user events received from the repository listener to the
+// streams subscribed on this replica.
+type EventHub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan model.UserEvent]struct{}
+}
+
+func NewEventHub() *EventHub {
+	return &EventHub{subscribers: make(map[string]map[chan model.UserEvent]struct{})}
+}
+
+// Subscribe registers a stream for the user's events. The returned function
+// must be called to release the subscription.
+func (h *EventHub) Subscribe(username string) (<-chan model.UserEvent, func()) {
+	ch := make(chan model.UserEvent, subscriberBufferSize)
+
+	h.mu.Lock()
+	if h.subscribers[username] == nil {
+		h.subscribers[username] = make(map[chan model.UserEvent]struct{})
+	}
+	h.subscribers[username][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subscribers[username], ch)
+		if len(h.subscribers[username]) == 0 {
+			delete(h.subscribers, username)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Publish delivers the event to the user's subscribers. Slow subscribers miss
+// events instead of blocking the listener.
+func (h *EventHub) Publish(e model.UserEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers[e.Username] {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// Run listens for events until ctx is cancelled, reconnecting with backoff
+// when the listener fails.
+func (h *EventHub) Run(ctx context.Context, listener repository.EventListener) {
+	delay := listenRetryDelay
+	for {
+		start := time.Now()
+		err := listener.ListenEvents(ctx, h.Publish)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > maxListenRetryDelay {
+			delay = listenRetryDelay
+		}
+		log.Printf("event listener stopped: %v; retrying in %s", err, delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxListenRetryDelay)
+	}
+}
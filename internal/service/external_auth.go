@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// noPasswordHash is stored for users provisioned by an identity provider or
+// a directory. No password matches it, so such users cannot sign in with a
+// local password until an admin issues them a password reset.
+const noPasswordHash = "!"
+
+const (
+	// identityLinkPurpose marks link tokens, which the API does not accept
+	// for access.
+	identityLinkPurpose = "identity_link"
+	identityLinkTTL     = 10 * time.Minute
+)
+
+// AuthenticateExternal signs in a user authenticated by an external identity
+// provider and returns the same result as Authenticate. On the first login
+// the identity is linked to a new user named identity.Username. An existing
+// local user of that name is only considered when the name is the identity's
+// verified email, otherwise ErrIdentityConflict is returned. Users without a
+// password are linked right away; users with one get an IdentityLinkError
+// and have to confirm the link with ConfirmIdentityLink. The username and
+// email are compared in the authenticator's canonical form, so that the
+// provider's spelling of a name finds the account a password login would.
+func (s *MerchService) AuthenticateExternal(ctx context.Context, identity model.ExternalIdentity) (*model.AuthResult, error) {
+	identity.Username = s.auth.CanonicalUsername(identity.Username)
+	username, err := s.repo.TouchUserIdentity(ctx, identity)
+	if errors.Is(err, model.ErrIdentityNotFound) {
+		username, err = s.linkIdentity(ctx, identity)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve external identity: %w", err)
+	}
+	return s.signIn(ctx, username, map[string]any{"issuer": identity.Issuer})
+}
+
+func (s *MerchService) linkIdentity(ctx context.Context, identity model.ExternalIdentity) (string, error) {
+	if identity.Username == "" {
+		return "", model.ErrInvalidIdentity
+	}
+	username := identity.Username
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		user, err := r.GetUser(ctx, username)
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			if err := s.registerUser(ctx, r, username, noPasswordHash); err != nil {
+				return err
+			}
+		case err != nil:
+			return fmt.Errorf("failed to get user: %w", err)
+		case !identity.EmailVerified || s.auth.CanonicalUsername(identity.Email) != username:
+			return model.ErrIdentityConflict
+		case s.hasPassword(user):
+			return identityLinkRequired(identity)
+		}
+		return s.createIdentityLink(ctx, r, identity)
+	})
+	if err != nil {
+		return "", err
+	}
+	return username, nil
+}
+
+// hasPassword reports whether the user can sign in with a password, local or
+// in the directory.
+func (s *MerchService) hasPassword(user *model.User) bool {
+	return !s.auth.ManagesPasswords() || user.PasswordHash != noPasswordHash
+}
+
+func (s *MerchService) createIdentityLink(ctx context.Context, r repository.MerchRepository, identity model.ExternalIdentity) error {
+	if err := r.CreateUserIdentity(ctx, identity, identity.Username); err != nil {
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+	return s.audit(ctx, r, identity.Username, model.AuditIdentityLinked, identity.Username, nil,
+		map[string]any{"issuer": identity.Issuer, "subject": identity.Subject})
+}
+
+// identityLinkRequired returns an IdentityLinkError with a signed token
+// carrying the identity to link.
+func identityLinkRequired(identity model.ExternalIdentity) error {
+	expiresAt := time.Now().Add(identityLinkTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":     identity.Username,
+		"exp":     expiresAt.Unix(),
+		"purpose": identityLinkPurpose,
+		"idp":     identity.Issuer,
+		"idp_sub": identity.Subject,
+		"email":   identity.Email,
+	})
+	signed, err := token.SignedString([]byte(jwtSecretKey))
+	if err != nil {
+		return fmt.Errorf("failed to sign link token: %w", err)
+	}
+	return &model.IdentityLinkError{Username: identity.Username, Token: signed, ExpiresAt: expiresAt}
+}
+
+// parseIdentityLink returns the identity a link token was issued for.
+func parseIdentityLink(link string) (model.ExternalIdentity, error) {
+	token, err := jwt.Parse(link, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecretKey), nil
+	})
+	if err != nil || !token.Valid {
+		return model.ExternalIdentity{}, model.ErrInvalidLinkToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != identityLinkPurpose {
+		return model.ExternalIdentity{}, model.ErrInvalidLinkToken
+	}
+	identity := model.ExternalIdentity{EmailVerified: true}
+	identity.Username, _ = claims["sub"].(string)
+	identity.Issuer, _ = claims["idp"].(string)
+	identity.Subject, _ = claims["idp_sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	if identity.Username == "" || identity.Issuer == "" || identity.Subject == "" {
+		return model.ExternalIdentity{}, model.ErrInvalidLinkToken
+	}
+	return identity, nil
+}
+
+// ConfirmIdentityLink links the external identity carried by a link token to
+// its user once the user's password is confirmed, and signs the user in.
+// Wrong passwords count as failed logins.
+func (s *MerchService) ConfirmIdentityLink(ctx context.Context, link, password string) (*model.AuthResult, error) {
+	identity, err := parseIdentityLink(link)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.claimLoginAttempt(ctx, identity.Username); err != nil {
+		return nil, err
+	}
+	err = s.auth.Authenticate(ctx, identity.Username, password)
+	if errors.Is(err, model.ErrInvalidPassword) || errors.Is(err, model.ErrUserNotFound) {
+		if err := s.auditAlone(ctx, identity.Username, model.AuditLoginFailed, identity.Username, nil); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrInvalidPassword
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+	if err := s.releaseLoginAttempt(ctx); err != nil {
+		return nil, err
+	}
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		return s.createIdentityLink(ctx, r, identity)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.signIn(ctx, identity.Username, map[string]any{"issuer": identity.Issuer})
+}
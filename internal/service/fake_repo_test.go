@@ -0,0 +1,542 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// fakeRepo keeps the state the tests need in memory. Methods the tests do
+// not need are left to the embedded nil interface and panic when called.
+// Atomic rolls the state back when fn fails, as a transaction would.
+type fakeRepo struct {
+	repository.MerchRepository
+	*fakeState
+}
+
+type fakeState struct {
+	users         map[string]model.User
+	limits        map[string]model.SpendingLimits
+	products      map[string]model.Product
+	variants      []model.ProductVariant
+	currencies    map[string]model.Currency
+	lots          []fakeLot
+	transfers     []fakeTransfer
+	purchases     []fakePurchase
+	auctions      map[int32]model.Auction
+	bids          []fakeBid
+	groups        map[int32]model.GroupPurchase
+	pledges       []fakePledge
+	teamTxs       []model.TeamTransaction
+	wishlist      []model.WishlistItem
+	locks         [][]string
+	mfaSteps      map[string]int64
+	notifications []fakeNotification
+	events        []model.DomainEvent
+	audit         []model.AuditEntry
+	adjustments   []model.BalanceAdjustment
+}
+
+type fakeLot struct {
+	username  string
+	currency  string
+	amount    int32
+	expiresAt *time.Time
+}
+
+type fakeBid struct {
+	auctionID int32
+	username  string
+	amount    int32
+	createdAt time.Time
+	released  bool
+}
+
+type fakePledge struct {
+	groupID   int32
+	username  string
+	amount    int32
+	createdAt time.Time
+}
+
+type fakeNotification struct {
+	username string
+	kind     string
+	data     map[string]any
+}
+
+type fakeTransfer struct {
+	from, to  string
+	currency  string
+	amount    int32
+	createdAt time.Time
+}
+
+type fakePurchase struct {
+	order     model.PurchaseOrder
+	createdAt time.Time
+}
+
+func newFakeRepo(users ...model.User) *fakeRepo {
+	r := &fakeRepo{fakeState: &fakeState{
+		users:    make(map[string]model.User),
+		limits:   make(map[string]model.SpendingLimits),
+		products: make(map[string]model.Product),
+		currencies: map[string]model.Currency{
+			model.DefaultCurrency: {Code: model.DefaultCurrency, Name: "Монеты", Transferable: true},
+		},
+		auctions: make(map[int32]model.Auction),
+		groups:   make(map[int32]model.GroupPurchase),
+		mfaSteps: make(map[string]int64),
+	}}
+	for _, u := range users {
+		r.users[u.Username] = u
+	}
+	return r
+}
+
+func (s *fakeState) clone() *fakeState {
+	c := *s
+	c.users = maps.Clone(s.users)
+	c.limits = maps.Clone(s.limits)
+	c.products = maps.Clone(s.products)
+	c.variants = append([]model.ProductVariant(nil), s.variants...)
+	c.currencies = maps.Clone(s.currencies)
+	c.lots = append([]fakeLot(nil), s.lots...)
+	c.transfers = append([]fakeTransfer(nil), s.transfers...)
+	c.purchases = append([]fakePurchase(nil), s.purchases...)
+	c.auctions = maps.Clone(s.auctions)
+	c.bids = append([]fakeBid(nil), s.bids...)
+	c.groups = maps.Clone(s.groups)
+	c.pledges = append([]fakePledge(nil), s.pledges...)
+	c.teamTxs = append([]model.TeamTransaction(nil), s.teamTxs...)
+	c.wishlist = append([]model.WishlistItem(nil), s.wishlist...)
+	c.locks = append([][]string(nil), s.locks...)
+	c.mfaSteps = maps.Clone(s.mfaSteps)
+	c.notifications = append([]fakeNotification(nil), s.notifications...)
+	c.events = append([]model.DomainEvent(nil), s.events...)
+	c.audit = append([]model.AuditEntry(nil), s.audit...)
+	c.adjustments = append([]model.BalanceAdjustment(nil), s.adjustments...)
+	return &c
+}
+
+func (r *fakeRepo) Atomic(ctx context.Context, fn func(r repository.MerchRepository) error) error {
+	saved := r.fakeState.clone()
+	if err := fn(r); err != nil {
+		*r.fakeState = *saved
+		return err
+	}
+	return nil
+}
+
+func (r *fakeRepo) GetUser(ctx context.Context, username string) (*model.User, error) {
+	u, ok := r.users[username]
+	if !ok {
+		return nil, model.ErrUserNotFound
+	}
+	return &u, nil
+}
+
+// LockUsers records the users locked; the order the rows are locked in is
+// up to the query.
+func (r *fakeRepo) LockUsers(ctx context.Context, usernames ...string) error {
+	for _, u := range usernames {
+		if _, ok := r.users[u]; !ok {
+			return model.ErrUserNotFound
+		}
+	}
+	r.locks = append(r.locks, slices.Clone(usernames))
+	return nil
+}
+
+func (r *fakeRepo) AddCoins(ctx context.Context, username string, amount int32) error {
+	u, ok := r.users[username]
+	if !ok {
+		return model.ErrUserNotFound
+	}
+	u.Coins += uint32(amount)
+	r.users[username] = u
+	return nil
+}
+
+func (r *fakeRepo) DeductCoins(ctx context.Context, username string, amount int32) error {
+	u, ok := r.users[username]
+	if !ok {
+		return model.ErrUserNotFound
+	}
+	if u.Coins < uint32(amount) {
+		return model.ErrInsufficientFunds
+	}
+	u.Coins -= uint32(amount)
+	r.users[username] = u
+	return nil
+}
+
+func (r *fakeRepo) GetProduct(ctx context.Context, item string) (*model.Product, error) {
+	p, ok := r.products[item]
+	if !ok {
+		return nil, model.ErrItemNotFound
+	}
+	return &p, nil
+}
+
+func (r *fakeRepo) GetProductVariant(ctx context.Context, item, sku string) (*model.ProductVariant, error) {
+	for _, v := range r.variants {
+		if v.Item == item && v.SKU == sku {
+			return &v, nil
+		}
+	}
+	return nil, model.ErrVariantNotFound
+}
+
+func (r *fakeRepo) GetDefaultProductVariant(ctx context.Context, item string) (*model.ProductVariant, error) {
+	for _, v := range r.variants {
+		if v.Item == item && v.Default {
+			return &v, nil
+		}
+	}
+	return nil, model.ErrVariantNotFound
+}
+
+func (r *fakeRepo) HasProductVariants(ctx context.Context, item string) (bool, error) {
+	return slices.ContainsFunc(r.variants, func(v model.ProductVariant) bool { return v.Item == item }), nil
+}
+
+// DecrementVariantStock replaces the stock rather than changing it in place,
+// as clones of the state share the pointers.
+func (r *fakeRepo) DecrementVariantStock(ctx context.Context, variantID int32) error {
+	for i, v := range r.variants {
+		if v.ID != variantID {
+			continue
+		}
+		if v.Stock == nil {
+			return nil
+		}
+		if *v.Stock == 0 {
+			return model.ErrOutOfStock
+		}
+		r.variants[i].Stock = ptrUint32(*v.Stock - 1)
+		return nil
+	}
+	return model.ErrVariantNotFound
+}
+
+func (r *fakeRepo) SetProductCurrency(ctx context.Context, item, currency string) error {
+	p, ok := r.products[item]
+	if !ok {
+		return model.ErrItemNotFound
+	}
+	if _, ok := r.currencies[currency]; !ok {
+		return model.ErrCurrencyNotFound
+	}
+	p.Currency = currency
+	r.products[item] = p
+	return nil
+}
+
+func (r *fakeRepo) GetCurrency(ctx context.Context, code string) (*model.Currency, error) {
+	c, ok := r.currencies[code]
+	if !ok {
+		return nil, model.ErrCurrencyNotFound
+	}
+	return &c, nil
+}
+
+func (r *fakeRepo) AddBalance(ctx context.Context, username, currency string, amount int32, expiresAt *time.Time) error {
+	if currency == model.DefaultCurrency {
+		return r.AddCoins(ctx, username, amount)
+	}
+	r.lots = append(r.lots, fakeLot{username: username, currency: currency, amount: amount, expiresAt: expiresAt})
+	return nil
+}
+
+// usableLots returns the indexes of the user's unexpired lots of the
+// currency, those expiring first first.
+func (r *fakeRepo) usableLots(username, currency string) []int {
+	var usable []int
+	for i, l := range r.lots {
+		if l.username == username && l.currency == currency && l.amount > 0 &&
+			(l.expiresAt == nil || l.expiresAt.After(time.Now())) {
+			usable = append(usable, i)
+		}
+	}
+	slices.SortStableFunc(usable, func(a, b int) int {
+		x, y := r.lots[a].expiresAt, r.lots[b].expiresAt
+		switch {
+		case x == nil && y == nil:
+			return 0
+		case x == nil:
+			return 1
+		case y == nil:
+			return -1
+		}
+		return x.Compare(*y)
+	})
+	return usable
+}
+
+func (r *fakeRepo) DeductBalance(ctx context.Context, username, currency string, amount int32) ([]model.BalanceLot, error) {
+	if currency == model.DefaultCurrency {
+		if err := r.DeductCoins(ctx, username, amount); err != nil {
+			return nil, err
+		}
+		return []model.BalanceLot{{Amount: amount}}, nil
+	}
+	usable := r.usableLots(username, currency)
+	var total int32
+	for _, i := range usable {
+		total += r.lots[i].amount
+	}
+	if total < amount {
+		return nil, model.ErrInsufficientFunds
+	}
+	var taken []model.BalanceLot
+	for _, i := range usable {
+		if amount == 0 {
+			break
+		}
+		take := min(amount, r.lots[i].amount)
+		r.lots[i].amount -= take
+		taken = append(taken, model.BalanceLot{Amount: take, ExpiresAt: r.lots[i].expiresAt})
+		amount -= take
+	}
+	return taken, nil
+}
+
+func (r *fakeRepo) GetBalances(ctx context.Context, username string) ([]model.Balance, error) {
+	sums := make(map[string]*model.Balance)
+	for _, l := range r.lots {
+		if l.username != username || l.amount == 0 || (l.expiresAt != nil && !l.expiresAt.After(time.Now())) {
+			continue
+		}
+		b, ok := sums[l.currency]
+		if !ok {
+			b = &model.Balance{Currency: l.currency}
+			sums[l.currency] = b
+		}
+		b.Amount += uint32(l.amount)
+		if l.expiresAt != nil && (b.NextExpiresAt == nil || l.expiresAt.Before(*b.NextExpiresAt)) {
+			b.NextExpiresAt = l.expiresAt
+		}
+	}
+	var balances []model.Balance
+	for _, b := range sums {
+		balances = append(balances, *b)
+	}
+	slices.SortFunc(balances, func(a, b model.Balance) int { return cmp.Compare(a.Currency, b.Currency) })
+	return balances, nil
+}
+
+func (r *fakeRepo) InsertCoinTransfer(ctx context.Context, fromUsername, toUsername, currency string, amount int32) error {
+	r.transfers = append(r.transfers, fakeTransfer{
+		from:      fromUsername,
+		to:        toUsername,
+		currency:  currency,
+		amount:    amount,
+		createdAt: time.Now(),
+	})
+	return nil
+}
+
+func (r *fakeRepo) CreatePurchase(ctx context.Context, order model.PurchaseOrder) error {
+	r.purchases = append(r.purchases, fakePurchase{order: order, createdAt: time.Now()})
+	return nil
+}
+
+func (r *fakeRepo) GetUserSpendingLimits(ctx context.Context, username string) (model.SpendingLimits, error) {
+	return r.limits[username], nil
+}
+
+func (r *fakeRepo) GetCoinsSentSince(ctx context.Context, username string, since time.Time) (uint32, error) {
+	var total uint32
+	for _, t := range r.transfers {
+		if t.from == username && t.currency == model.DefaultCurrency && !t.createdAt.Before(since) {
+			total += uint32(t.amount)
+		}
+	}
+	for _, t := range r.teamTxs {
+		if t.Kind == model.TeamTransactionTransfer && t.Actor == username && !t.CreatedAt.Before(since) {
+			total += t.Amount
+		}
+	}
+	for _, p := range r.pledges {
+		if p.username == username && r.groups[p.groupID].Status != model.GroupPurchaseRefunded && !p.createdAt.Before(since) {
+			total += uint32(p.amount)
+		}
+	}
+	return total, nil
+}
+
+func (r *fakeRepo) GetPurchaseSpendSince(ctx context.Context, username string, since time.Time) (uint32, error) {
+	var total uint32
+	for _, p := range r.purchases {
+		if p.order.Username == username && p.order.Currency == model.DefaultCurrency && p.order.AuctionID == nil &&
+			p.order.GroupPurchaseID == nil && !p.createdAt.Before(since) {
+			total += p.order.Price
+		}
+	}
+	for _, b := range r.bids {
+		if b.username == username && !b.released && !b.createdAt.Before(since) {
+			total += uint32(b.amount)
+		}
+	}
+	return total, nil
+}
+
+func (r *fakeRepo) LockAuction(ctx context.Context, id int32) (*model.Auction, error) {
+	a, ok := r.auctions[id]
+	if !ok {
+		return nil, model.ErrAuctionNotFound
+	}
+	for _, b := range r.bids {
+		if b.auctionID == id && !b.released {
+			a.HighestBid = uint32(b.amount)
+			a.HighestBidder = b.username
+		}
+	}
+	return &a, nil
+}
+
+func (r *fakeRepo) ListDueAuctions(ctx context.Context, limit int32) ([]int32, error) {
+	var ids []int32
+	for id, a := range r.auctions {
+		if a.ClosedAt == nil && !a.EndsAt.After(time.Now()) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids[:min(len(ids), int(limit))], nil
+}
+
+func (r *fakeRepo) InsertAuctionBid(ctx context.Context, id int32, username string, amount int32) error {
+	r.bids = append(r.bids, fakeBid{auctionID: id, username: username, amount: amount, createdAt: time.Now()})
+	return nil
+}
+
+func (r *fakeRepo) ReleaseAuctionHold(ctx context.Context, id int32) error {
+	for i, b := range r.bids {
+		if b.auctionID == id && !b.released {
+			r.bids[i].released = true
+			return nil
+		}
+	}
+	return model.ErrAuctionNotFound
+}
+
+func (r *fakeRepo) CloseAuction(ctx context.Context, id int32, winner string) error {
+	a := r.auctions[id]
+	if a.ClosedAt != nil {
+		return model.ErrAuctionClosed
+	}
+	now := time.Now()
+	a.ClosedAt = &now
+	a.Winner = winner
+	r.auctions[id] = a
+	return nil
+}
+
+// GetWishlist returns the wished items; the fake keeps a single wishlist.
+func (r *fakeRepo) GetWishlist(ctx context.Context, username string) ([]model.WishlistItem, error) {
+	return append([]model.WishlistItem(nil), r.wishlist...), nil
+}
+
+func (r *fakeRepo) InsertTeamTransaction(ctx context.Context, tx model.TeamTransaction) error {
+	if tx.CreatedAt.IsZero() {
+		tx.CreatedAt = time.Now()
+	}
+	r.teamTxs = append(r.teamTxs, tx)
+	return nil
+}
+
+func (r *fakeRepo) InsertBalanceAdjustment(ctx context.Context, adjustment model.BalanceAdjustment) error {
+	r.adjustments = append(r.adjustments, adjustment)
+	return nil
+}
+
+func (r *fakeRepo) UseMFAStep(ctx context.Context, username string, step int64) error {
+	if step <= r.mfaSteps[username] {
+		return model.ErrInvalidMFACode
+	}
+	r.mfaSteps[username] = step
+	return nil
+}
+
+func (r *fakeRepo) CreateNotification(ctx context.Context, username, kind string, data map[string]any) error {
+	r.notifications = append(r.notifications, fakeNotification{username: username, kind: kind, data: data})
+	return nil
+}
+
+func (r *fakeRepo) Publish(ctx context.Context, event model.DomainEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *fakeRepo) AppendAudit(ctx context.Context, entry model.AuditEntry) error {
+	r.audit = append(r.audit, entry)
+	return nil
+}
+
+func (r *fakeRepo) LockGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error) {
+	g, ok := r.groups[id]
+	if !ok {
+		return nil, model.ErrGroupPurchaseNotFound
+	}
+	g.Pledged = 0
+	for _, p := range r.pledges {
+		if p.groupID == id {
+			g.Pledged += uint32(p.amount)
+		}
+	}
+	return &g, nil
+}
+
+func (r *fakeRepo) ListExpiredGroupPurchases(ctx context.Context, limit int32) ([]int32, error) {
+	var ids []int32
+	for id, g := range r.groups {
+		if g.Status == model.GroupPurchaseOpen && !g.Deadline.After(time.Now()) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids[:min(len(ids), int(limit))], nil
+}
+
+func (r *fakeRepo) ListPledges(ctx context.Context, id int32) ([]model.Pledge, error) {
+	var pledges []model.Pledge
+	for _, p := range r.pledges {
+		if p.groupID == id {
+			pledges = append(pledges, model.Pledge{Username: p.username, Amount: uint32(p.amount), CreatedAt: p.createdAt})
+		}
+	}
+	return pledges, nil
+}
+
+func (r *fakeRepo) InsertPledge(ctx context.Context, id int32, username string, amount int32) error {
+	r.pledges = append(r.pledges, fakePledge{groupID: id, username: username, amount: amount, createdAt: time.Now()})
+	return nil
+}
+
+func (r *fakeRepo) CloseGroupPurchase(ctx context.Context, id int32, status string) error {
+	g := r.groups[id]
+	if g.Status != model.GroupPurchaseOpen {
+		return model.ErrGroupPurchaseClosed
+	}
+	now := time.Now()
+	g.Status = status
+	g.ClosedAt = &now
+	r.groups[id] = g
+	return nil
+}
+
+func newTestService(r *fakeRepo) *MerchService {
+	return NewMerchService(r, nil, DefaultPasswordHashing, nil, []byte("test audit key"))
+}
+
+func ptrUint32(v uint32) *uint32 {
+	return &v
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	groupPurchasePollInterval = 30 * time.Second
+	groupPurchaseRefundBatch  = 50
+)
+
+// CreateGroupPurchase starts collecting pledges to buy the item, or its
+// variant, for the recipient at the regular price. Anyone but the recipient
+// can start one.
+func (s *MerchService) CreateGroupPurchase(ctx context.Context, username, item, variant, recipient string, deadline time.Time) (*model.GroupPurchase, error) {
+	if !deadline.After(time.Now()) {
+		return nil, model.ErrInvalidGroupPurchase
+	}
+	if username == recipient {
+		return nil, model.ErrGroupPurchaseForSelf
+	}
+	var purchase *model.GroupPurchase
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		product, err := r.GetProduct(ctx, item)
+		if err != nil {
+			return fmt.Errorf("failed to get product: %w", err)
+		}
+		if !product.Active {
+			return model.ErrItemInactive
+		}
+		if product.Currency != model.DefaultCurrency {
+			return model.ErrInvalidGroupPurchase
+		}
+		g := model.GroupPurchase{
+			Item:      item,
+			Recipient: recipient,
+			Price:     product.Price,
+			Deadline:  deadline,
+			CreatedBy: username,
+		}
+		v, err := productVariant(ctx, r, item, variant)
+		if err != nil {
+			return err
+		}
+		if v != nil {
+			if v.Price != nil {
+				g.Price = *v.Price
+			}
+			g.VariantID = &v.ID
+		}
+		if err := requireActive(ctx, r, recipient); err != nil {
+			return err
+		}
+		purchase, err = r.CreateGroupPurchase(ctx, g)
+		if err != nil {
+			return fmt.Errorf("failed to create group purchase: %w", err)
+		}
+		return s.audit(ctx, r, username, model.AuditGroupPurchaseCreated, fmt.Sprint(purchase.ID), nil, map[string]any{
+			"item":      item,
+			"variant":   variant,
+			"recipient": recipient,
+			"price":     purchase.Price,
+			"deadline":  deadline,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purchase, nil
+}
+
+// ListGroupPurchases returns the group purchases still collecting pledges,
+// those with the nearest deadline first.
+func (s *MerchService) ListGroupPurchases(ctx context.Context) ([]model.GroupPurchase, error) {
+	purchases, err := s.repo.ListOpenGroupPurchases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group purchases: %w", err)
+	}
+	return purchases, nil
+}
+
+// GetGroupPurchase returns the group purchase together with its pledges.
+func (s *MerchService) GetGroupPurchase(ctx context.Context, id int32) (*model.GroupPurchase, error) {
+	purchase, err := s.repo.GetGroupPurchase(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group purchase: %w", err)
+	}
+	purchase.Pledges, err = s.repo.ListPledges(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pledges: %w", err)
+	}
+	return purchase, nil
+}
+
+// Pledge holds amount of the user's coins for the group purchase. The pledge
+// completing the price buys the item for the recipient in the same
+// transaction. The group purchase is locked meanwhile, so concurrent pledges
+// cannot fund it beyond its price. Pledges are gifts to the recipient and
+// count toward the pledger's transfer limits; the recipient cannot pledge.
+// If the item cannot be bought when the price is complete, because the
+// variant ran out of stock or the recipient was deactivated, the group
+// purchase is cancelled right away, every pledge is given back and the
+// reason is returned.
+func (s *MerchService) Pledge(ctx context.Context, username string, id int32, amount int32) (*model.GroupPurchase, error) {
+	if amount <= 0 {
+		return nil, model.ErrInvalidAmount
+	}
+	var purchase *model.GroupPurchase
+	var cancelled error
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		g, err := r.LockGroupPurchase(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get group purchase: %w", err)
+		}
+		if g.Status != model.GroupPurchaseOpen || !time.Now().Before(g.Deadline) {
+			return model.ErrGroupPurchaseClosed
+		}
+		if uint32(amount) > g.Price-g.Pledged {
+			return model.ErrPledgeTooHigh
+		}
+		if username == g.Recipient {
+			return model.ErrGroupPurchaseForSelf
+		}
+		if err := r.LockUsers(ctx, username); err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+		user, err := r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user.DeactivatedAt != nil {
+			return model.ErrUserDeactivated
+		}
+		if user.Coins < uint32(amount) {
+			return model.ErrInsufficientFunds
+		}
+		if err := checkTransferLimits(ctx, r, username, uint32(amount)); err != nil {
+			return err
+		}
+		if err := r.DeductCoins(ctx, username, amount); err != nil {
+			return fmt.Errorf("failed to hold coins: %w", err)
+		}
+		if err := r.InsertPledge(ctx, id, username, amount); err != nil {
+			return fmt.Errorf("failed to record pledge: %w", err)
+		}
+		err = s.audit(ctx, r, username, model.AuditGroupPurchasePledge, fmt.Sprint(id),
+			map[string]any{"pledged": g.Pledged},
+			map[string]any{"pledged": g.Pledged + uint32(amount), "amount": amount})
+		if err != nil {
+			return err
+		}
+		g.Pledged += uint32(amount)
+		if g.Pledged == g.Price {
+			err := s.completeGroupPurchase(ctx, r, username, g)
+			if errors.Is(err, model.ErrOutOfStock) || errors.Is(err, model.ErrUserDeactivated) {
+				cancelled = err
+				return s.refundPledges(ctx, r, g, err.Error())
+			}
+			if err != nil {
+				return err
+			}
+		}
+		purchase = g
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cancelled != nil {
+		return nil, cancelled
+	}
+	return purchase, nil
+}
+
+// completeGroupPurchase buys the item of the fully funded group purchase for
+// the recipient, paid with the held pledges. The purchase is marked with the
+// group purchase, so that it counts toward neither the recipient's purchase
+// limit nor their spending stats. The recipient has to be active still; it
+// returns before changing anything if they are not or the variant is out of
+// stock.
+func (s *MerchService) completeGroupPurchase(ctx context.Context, r repository.MerchRepository, actor string, g *model.GroupPurchase) error {
+	if err := requireActive(ctx, r, g.Recipient); err != nil {
+		return err
+	}
+	if g.VariantID != nil {
+		if err := r.DecrementVariantStock(ctx, *g.VariantID); err != nil {
+			return fmt.Errorf("failed to reserve variant stock: %w", err)
+		}
+	}
+	if err := r.CloseGroupPurchase(ctx, g.ID, model.GroupPurchaseFunded); err != nil {
+		return fmt.Errorf("failed to close group purchase: %w", err)
+	}
+	order := model.PurchaseOrder{
+		Username:        g.Recipient,
+		Item:            g.Item,
+		VariantID:       g.VariantID,
+		Price:           g.Price,
+		Currency:        model.DefaultCurrency,
+		GroupPurchaseID: &g.ID,
+	}
+	if err := r.CreatePurchase(ctx, order); err != nil {
+		return fmt.Errorf("failed to create purchase record: %w", err)
+	}
+	pledges, err := r.ListPledges(ctx, g.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pledges: %w", err)
+	}
+	pledgers := pledgedAmounts(pledges)
+	from := make([]string, 0, len(pledgers))
+	for _, p := range pledgers {
+		from = append(from, p.Username)
+	}
+	err = r.CreateNotification(ctx, g.Recipient, model.NotificationGroupGiftReceived, map[string]any{
+		"groupPurchaseId": g.ID,
+		"item":            g.Item,
+		"variant":         g.Variant,
+		"from":            from,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	for _, p := range pledgers {
+		err := r.CreateNotification(ctx, p.Username, model.NotificationGroupFunded, map[string]any{
+			"groupPurchaseId": g.ID,
+			"item":            g.Item,
+			"recipient":       g.Recipient,
+			"amount":          p.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+	err = r.Publish(ctx, model.ItemPurchased{
+		Username: g.Recipient,
+		Item:     g.Item,
+		Variant:  g.Variant,
+		Price:    g.Price,
+		Currency: model.DefaultCurrency,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish purchase event: %w", err)
+	}
+	g.Status = model.GroupPurchaseFunded
+	return s.audit(ctx, r, actor, model.AuditGroupPurchaseFunded, fmt.Sprint(g.ID), nil,
+		map[string]any{"item": g.Item, "recipient": g.Recipient, "price": g.Price, "pledgers": from})
+}
+
+// RefundExpiredGroupPurchases gives the pledges of the group purchases past
+// their deadline back, each in a transaction of its own, and returns how
+// many it refunded.
+func (s *MerchService) RefundExpiredGroupPurchases(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListExpiredGroupPurchases(ctx, groupPurchaseRefundBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired group purchases: %w", err)
+	}
+	refunded := 0
+	var errs []error
+	for _, id := range ids {
+		err := s.refundGroupPurchase(ctx, id)
+		switch {
+		case err == nil:
+			refunded++
+		case !errors.Is(err, model.ErrGroupPurchaseClosed):
+			errs = append(errs, fmt.Errorf("failed to refund group purchase %d: %w", id, err))
+		}
+	}
+	return refunded, errors.Join(errs...)
+}
+
+func (s *MerchService) refundGroupPurchase(ctx context.Context, id int32) error {
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		g, err := r.LockGroupPurchase(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get group purchase: %w", err)
+		}
+		if g.Status != model.GroupPurchaseOpen {
+			return model.ErrGroupPurchaseClosed
+		}
+		return s.refundPledges(ctx, r, g, "deadline passed")
+	})
+}
+
+// refundPledges closes the locked group purchase as refunded and gives every
+// pledger their pledges back, telling them why.
+func (s *MerchService) refundPledges(ctx context.Context, r repository.MerchRepository, g *model.GroupPurchase, reason string) error {
+	if err := r.CloseGroupPurchase(ctx, g.ID, model.GroupPurchaseRefunded); err != nil {
+		return fmt.Errorf("failed to close group purchase: %w", err)
+	}
+	pledges, err := r.ListPledges(ctx, g.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pledges: %w", err)
+	}
+	for _, p := range pledgedAmounts(pledges) {
+		if err := r.AddCoins(ctx, p.Username, int32(p.Amount)); err != nil {
+			return fmt.Errorf("failed to refund pledge: %w", err)
+		}
+		err := r.CreateNotification(ctx, p.Username, model.NotificationGroupRefunded, map[string]any{
+			"groupPurchaseId": g.ID,
+			"item":            g.Item,
+			"recipient":       g.Recipient,
+			"amount":          p.Amount,
+			"reason":          reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+	g.Status = model.GroupPurchaseRefunded
+	return s.audit(ctx, r, model.AuditActorSystem, model.AuditGroupPurchaseRefunded, fmt.Sprint(g.ID), nil,
+		map[string]any{"pledged": g.Pledged, "price": g.Price, "reason": reason})
+}
+
+// pledgedAmounts sums the pledges per user, in the order users first
+// pledged.
+func pledgedAmounts(pledges []model.Pledge) []model.Pledge {
+	var sums []model.Pledge
+	index := make(map[string]int)
+	for _, p := range pledges {
+		i, ok := index[p.Username]
+		if !ok {
+			i = len(sums)
+			index[p.Username] = i
+			sums = append(sums, model.Pledge{Username: p.Username, CreatedAt: p.CreatedAt})
+		}
+		sums[i].Amount += p.Amount
+	}
+	return sums
+}
+
+// GroupPurchaseRefunder refunds group purchases in the background once
+// their deadline passes.
+type GroupPurchaseRefunder struct {
+	service *MerchService
+}
+
+func NewGroupPurchaseRefunder(service *MerchService) *GroupPurchaseRefunder {
+	return &GroupPurchaseRefunder{service: service}
+}
+
+// Run polls for expired group purchases until ctx is cancelled.
+func (g *GroupPurchaseRefunder) Run(ctx context.Context) {
+	ticker := time.NewTicker(groupPurchasePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := g.service.RefundExpiredGroupPurchases(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to refund group purchases: %v", err)
+		}
+	}
+}
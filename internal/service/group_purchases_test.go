@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func newGroupPurchaseRepo(users ...model.User) *fakeRepo {
+	r := newFakeRepo(users...)
+	r.groups[1] = model.GroupPurchase{
+		ID:        1,
+		Item:      "hoody",
+		Recipient: "carol",
+		Price:     100,
+		Deadline:  time.Now().Add(time.Hour),
+		CreatedBy: "alice",
+		Status:    model.GroupPurchaseOpen,
+	}
+	return r
+}
+
+func TestCreateGroupPurchaseForSelf(t *testing.T) {
+	s := newTestService(newFakeRepo(model.User{Username: "carol"}))
+
+	_, err := s.CreateGroupPurchase(context.Background(), "carol", "hoody", "", "carol", time.Now().Add(time.Hour))
+	if !errors.Is(err, model.ErrGroupPurchaseForSelf) {
+		t.Fatalf("CreateGroupPurchase = %v, want %v", err, model.ErrGroupPurchaseForSelf)
+	}
+}
+
+func TestPledgeByRecipient(t *testing.T) {
+	r := newGroupPurchaseRepo(model.User{Username: "carol", Coins: 500})
+	s := newTestService(r)
+
+	if _, err := s.Pledge(context.Background(), "carol", 1, 100); !errors.Is(err, model.ErrGroupPurchaseForSelf) {
+		t.Fatalf("Pledge = %v, want %v", err, model.ErrGroupPurchaseForSelf)
+	}
+	if got := r.users["carol"].Coins; got != 500 || len(r.pledges) != 0 || len(r.purchases) != 0 {
+		t.Fatalf("a rejected pledge left %d coins, %d pledges and %d purchases", got, len(r.pledges), len(r.purchases))
+	}
+}
+
+func TestPledgeTransferLimits(t *testing.T) {
+	r := newGroupPurchaseRepo(model.User{Username: "alice", Coins: 500}, model.User{Username: "carol"})
+	r.limits["alice"] = model.SpendingLimits{DailyTransfer: ptrUint32(50)}
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.Pledge(ctx, "alice", 1, 30); err != nil {
+		t.Fatalf("first pledge: %v", err)
+	}
+	if lock := r.locks[len(r.locks)-1]; !slices.Equal(lock, []string{"alice"}) {
+		t.Errorf("locked %v, want alice", lock)
+	}
+	_, err := s.Pledge(ctx, "alice", 1, 30)
+	checkLimitError(t, err, model.LimitDailyTransfer, 20)
+	if got := r.users["alice"].Coins; got != 470 {
+		t.Errorf("alice has %d coins, want 470", got)
+	}
+
+	// Pledges count toward the limit of later transfers as well.
+	checkLimitError(t, checkTransferLimits(ctx, r, "alice", 21), model.LimitDailyTransfer, 20)
+}
+
+func TestPledgeCompletesGroupPurchase(t *testing.T) {
+	r := newGroupPurchaseRepo(
+		model.User{Username: "alice", Coins: 500},
+		model.User{Username: "bob", Coins: 500},
+		model.User{Username: "carol", Coins: 500},
+	)
+	r.limits["carol"] = model.SpendingLimits{MonthlyPurchase: ptrUint32(50)}
+	s := newTestService(r)
+	ctx := context.Background()
+
+	if _, err := s.Pledge(ctx, "alice", 1, 60); err != nil {
+		t.Fatalf("alice's pledge: %v", err)
+	}
+	if _, err := s.Pledge(ctx, "bob", 1, 50); !errors.Is(err, model.ErrPledgeTooHigh) {
+		t.Fatalf("pledge over the price = %v, want %v", err, model.ErrPledgeTooHigh)
+	}
+	g, err := s.Pledge(ctx, "bob", 1, 40)
+	if err != nil {
+		t.Fatalf("bob's pledge: %v", err)
+	}
+	if g.Status != model.GroupPurchaseFunded || g.Pledged != 100 {
+		t.Fatalf("group purchase = %+v, want funded with 100 pledged", g)
+	}
+
+	if len(r.purchases) != 1 {
+		t.Fatalf("got %d purchases, want 1", len(r.purchases))
+	}
+	order := r.purchases[0].order
+	if order.Username != "carol" || order.GroupPurchaseID == nil || *order.GroupPurchaseID != 1 {
+		t.Fatalf("purchase = %+v, want carol's marked with the group purchase", order)
+	}
+	if got := r.users["carol"].Coins; got != 500 {
+		t.Errorf("carol has %d coins, want 500", got)
+	}
+	_, month := limitPeriods(time.Now())
+	spent, err := r.GetPurchaseSpendSince(ctx, "carol", month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent != 0 {
+		t.Errorf("the gift counts %d coins toward carol's purchases, want 0", spent)
+	}
+	if err := checkPurchaseLimits(ctx, r, "carol", 50); err != nil {
+		t.Errorf("carol's purchase limit after the gift: %v", err)
+	}
+}
+
+func TestRefundExpiredGroupPurchases(t *testing.T) {
+	r := newGroupPurchaseRepo(
+		model.User{Username: "alice", Coins: 500},
+		model.User{Username: "bob", Coins: 500},
+		model.User{Username: "carol"},
+	)
+	r.limits["alice"] = model.SpendingLimits{DailyTransfer: ptrUint32(50)}
+	s := newTestService(r)
+	ctx := context.Background()
+
+	for _, p := range []struct {
+		username string
+		amount   int32
+	}{{"alice", 20}, {"bob", 30}, {"alice", 10}} {
+		if _, err := s.Pledge(ctx, p.username, 1, p.amount); err != nil {
+			t.Fatalf("%s's pledge: %v", p.username, err)
+		}
+	}
+	g := r.groups[1]
+	g.Deadline = time.Now().Add(-time.Second)
+	r.groups[1] = g
+
+	refunded, err := s.RefundExpiredGroupPurchases(ctx)
+	if err != nil || refunded != 1 {
+		t.Fatalf("RefundExpiredGroupPurchases = %d, %v, want 1 refunded", refunded, err)
+	}
+	if got := r.groups[1].Status; got != model.GroupPurchaseRefunded {
+		t.Fatalf("status = %s, want %s", got, model.GroupPurchaseRefunded)
+	}
+	if a, b := r.users["alice"].Coins, r.users["bob"].Coins; a != 500 || b != 500 {
+		t.Fatalf("alice and bob have %d and %d coins, want their pledges back and 500 each", a, b)
+	}
+	var refunds []fakeNotification
+	for _, n := range r.notifications {
+		if n.kind == model.NotificationGroupRefunded {
+			refunds = append(refunds, n)
+		}
+	}
+	if len(refunds) != 2 || refunds[0].username != "alice" || refunds[0].data["amount"] != uint32(30) {
+		t.Fatalf("refund notifications = %+v, want one per pledger, alice's for 30", refunds)
+	}
+	// Refunded pledges no longer count toward the transfer limit.
+	if err := checkTransferLimits(ctx, r, "alice", 50); err != nil {
+		t.Fatalf("alice's transfer limit after the refund: %v", err)
+	}
+	if _, err := s.Pledge(ctx, "alice", 1, 10); !errors.Is(err, model.ErrGroupPurchaseClosed) {
+		t.Fatalf("pledge to a refunded group purchase = %v, want %v", err, model.ErrGroupPurchaseClosed)
+	}
+}
+
+func TestPledgeCancelsGroupPurchaseThatCannotComplete(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(r *fakeRepo)
+		want    error
+	}{
+		{
+			name: "variant out of stock",
+			prepare: func(r *fakeRepo) {
+				r.variants = []model.ProductVariant{{ID: 7, Item: "hoody", SKU: "hoody-xl", Stock: ptrUint32(0)}}
+				g := r.groups[1]
+				g.VariantID = &r.variants[0].ID
+				r.groups[1] = g
+			},
+			want: model.ErrOutOfStock,
+		},
+		{
+			name: "recipient deactivated",
+			prepare: func(r *fakeRepo) {
+				carol := r.users["carol"]
+				deactivatedAt := time.Now()
+				carol.DeactivatedAt = &deactivatedAt
+				r.users["carol"] = carol
+			},
+			want: model.ErrUserDeactivated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newGroupPurchaseRepo(
+				model.User{Username: "alice", Coins: 500},
+				model.User{Username: "bob", Coins: 500},
+				model.User{Username: "carol"},
+			)
+			s := newTestService(r)
+			ctx := context.Background()
+			if _, err := s.Pledge(ctx, "alice", 1, 60); err != nil {
+				t.Fatalf("alice's pledge: %v", err)
+			}
+			tt.prepare(r)
+
+			if _, err := s.Pledge(ctx, "bob", 1, 40); !errors.Is(err, tt.want) {
+				t.Fatalf("completing pledge = %v, want %v", err, tt.want)
+			}
+			if got := r.groups[1].Status; got != model.GroupPurchaseRefunded {
+				t.Fatalf("status = %s, want %s", got, model.GroupPurchaseRefunded)
+			}
+			if len(r.purchases) != 0 {
+				t.Fatalf("purchases = %+v, want none", r.purchases)
+			}
+			if a, b := r.users["alice"].Coins, r.users["bob"].Coins; a != 500 || b != 500 {
+				t.Fatalf("alice and bob have %d and %d coins, want their pledges back and 500 each", a, b)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// limitPeriods returns the start of the current UTC day and month.
+func limitPeriods(now time.Time) (day, month time.Time) {
+	now = now.UTC()
+	day = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return day, month
+}
+
+func newAllowance(limit, used uint32) *model.Allowance {
+	return &model.Allowance{Limit: limit, Remaining: limit - min(used, limit)}
+}
+
+// spendingAllowance sums the user's transfers, including team wallet payouts
+// they made as lead and pledges to group purchases, and purchases of the
+// current periods against their limits.
+func spendingAllowance(ctx context.Context, r repository.MerchRepository, username string) (model.SpendingAllowance, error) {
+	limits, err := r.GetUserSpendingLimits(ctx, username)
+	if err != nil {
+		return model.SpendingAllowance{}, fmt.Errorf("failed to get spending limits: %w", err)
+	}
+	day, month := limitPeriods(time.Now())
+	allowance := model.SpendingAllowance{MaxTransfer: limits.MaxTransfer}
+	if limits.DailyTransfer != nil {
+		sent, err := r.GetCoinsSentSince(ctx, username, day)
+		if err != nil {
+			return model.SpendingAllowance{}, fmt.Errorf("failed to sum sent coins: %w", err)
+		}
+		allowance.DailyTransfer = newAllowance(*limits.DailyTransfer, sent)
+	}
+	if limits.MonthlyPurchase != nil {
+		spent, err := r.GetPurchaseSpendSince(ctx, username, month)
+		if err != nil {
+			return model.SpendingAllowance{}, fmt.Errorf("failed to sum purchases: %w", err)
+		}
+		allowance.MonthlyPurchase = newAllowance(*limits.MonthlyPurchase, spent)
+	}
+	return allowance, nil
+}
+
+// checkTransferLimits returns a LimitExceededError if sending amount more
+// coins would exceed the sender's limits. It has to run after the sender's
+// balance row is locked, so that concurrent transfers are summed in turn.
+func checkTransferLimits(ctx context.Context, r repository.MerchRepository, username string, amount uint32) error {
+	allowance, err := spendingAllowance(ctx, r, username)
+	if err != nil {
+		return err
+	}
+	if limit := allowance.MaxTransfer; limit != nil && amount > *limit {
+		return &model.LimitExceededError{Limit: model.LimitMaxTransfer, Max: *limit, Remaining: *limit}
+	}
+	if a := allowance.DailyTransfer; a != nil && amount > a.Remaining {
+		return &model.LimitExceededError{Limit: model.LimitDailyTransfer, Max: a.Limit, Remaining: a.Remaining}
+	}
+	return nil
+}
+
+// checkPurchaseLimits is checkTransferLimits for purchases.
+func checkPurchaseLimits(ctx context.Context, r repository.MerchRepository, username string, price uint32) error {
+	allowance, err := spendingAllowance(ctx, r, username)
+	if err != nil {
+		return err
+	}
+	if a := allowance.MonthlyPurchase; a != nil && price > a.Remaining {
+		return &model.LimitExceededError{Limit: model.LimitMonthlyPurchase, Max: a.Limit, Remaining: a.Remaining}
+	}
+	return nil
+}
+
+func (s *MerchService) ListSpendingLimits(ctx context.Context, admin string) ([]model.SpendingLimitRule, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	rules, err := s.repo.ListSpendingLimits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spending limits: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *MerchService) validateLimitSubject(ctx context.Context, scope, subject string) error {
+	switch scope {
+	case model.LimitScopeUser:
+		if _, err := s.repo.GetUser(ctx, subject); err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+	case model.LimitScopeRole:
+		if subject != model.RoleUser && subject != model.RoleAdmin && subject != model.RoleAuditor {
+			return fmt.Errorf("%w: unknown role %q", model.ErrInvalidLimitScope, subject)
+		}
+	default:
+		return model.ErrInvalidLimitScope
+	}
+	return nil
+}
+
+// SetSpendingLimits replaces the limits of a user or a role.
+func (s *MerchService) SetSpendingLimits(ctx context.Context, admin string, rule model.SpendingLimitRule) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if err := s.validateLimitSubject(ctx, rule.Scope, rule.Subject); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.SetSpendingLimits(ctx, rule); err != nil {
+			return fmt.Errorf("failed to set spending limits: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditLimitsChanged, rule.Scope+":"+rule.Subject, nil, map[string]any{
+			"dailyTransfer":   rule.Limits.DailyTransfer,
+			"maxTransfer":     rule.Limits.MaxTransfer,
+			"monthlyPurchase": rule.Limits.MonthlyPurchase,
+		})
+	})
+}
+
+// DeleteSpendingLimits removes the limits of a user or a role, so that a
+// user falls back to their role's limits and a role to no limits.
+func (s *MerchService) DeleteSpendingLimits(ctx context.Context, admin, scope, subject string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if scope != model.LimitScopeUser && scope != model.LimitScopeRole {
+		return model.ErrInvalidLimitScope
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.DeleteSpendingLimits(ctx, scope, subject); err != nil {
+			return fmt.Errorf("failed to delete spending limits: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditLimitsChanged, scope+":"+subject, nil, nil)
+	})
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+func TestCheckTransferLimits(t *testing.T) {
+	day, _ := limitPeriods(time.Now())
+	tests := []struct {
+		name      string
+		limits    model.SpendingLimits
+		sent      []fakeTransfer
+		teamTxs   []model.TeamTransaction
+		pledges   []fakePledge
+		groups    map[int32]model.GroupPurchase
+		amount    uint32
+		wantLimit string
+		remaining uint32
+	}{
+		{
+			name:   "no limits",
+			amount: 1000,
+		},
+		{
+			name:      "single transfer over the cap",
+			limits:    model.SpendingLimits{MaxTransfer: ptrUint32(50)},
+			amount:    51,
+			wantLimit: model.LimitMaxTransfer,
+			remaining: 50,
+		},
+		{
+			name:   "single transfer at the cap",
+			limits: model.SpendingLimits{MaxTransfer: ptrUint32(50)},
+			amount: 50,
+		},
+		{
+			name:   "daily limit with room left",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			sent: []fakeTransfer{
+				{from: "alice", to: "bob", currency: model.DefaultCurrency, amount: 60, createdAt: day.Add(time.Second)},
+			},
+			amount: 40,
+		},
+		{
+			name:   "daily limit used up today",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			sent: []fakeTransfer{
+				{from: "alice", to: "bob", currency: model.DefaultCurrency, amount: 60, createdAt: day.Add(time.Second)},
+			},
+			amount:    41,
+			wantLimit: model.LimitDailyTransfer,
+			remaining: 40,
+		},
+		{
+			name:   "transfers of earlier days do not count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			sent: []fakeTransfer{
+				{from: "alice", to: "bob", currency: model.DefaultCurrency, amount: 100, createdAt: day.Add(-time.Second)},
+			},
+			amount: 100,
+		},
+		{
+			name:   "received coins do not count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			sent: []fakeTransfer{
+				{from: "bob", to: "alice", currency: model.DefaultCurrency, amount: 100, createdAt: day.Add(time.Second)},
+			},
+			amount: 100,
+		},
+		{
+			name:   "team payouts by the lead count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			teamTxs: []model.TeamTransaction{
+				{Team: "platform", Kind: model.TeamTransactionTransfer, Username: "bob", Amount: 70, Actor: "alice", CreatedAt: day.Add(time.Second)},
+				{Team: "platform", Kind: model.TeamTransactionDeposit, Amount: 500, Actor: "admin", CreatedAt: day.Add(time.Second)},
+			},
+			amount:    31,
+			wantLimit: model.LimitDailyTransfer,
+			remaining: 30,
+		},
+		{
+			name:   "team payouts received do not count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			teamTxs: []model.TeamTransaction{
+				{Team: "platform", Kind: model.TeamTransactionTransfer, Username: "alice", Amount: 70, Actor: "bob", CreatedAt: day.Add(time.Second)},
+			},
+			amount: 100,
+		},
+		{
+			name:   "pledges count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			pledges: []fakePledge{
+				{groupID: 1, username: "alice", amount: 80, createdAt: day.Add(time.Second)},
+			},
+			groups:    map[int32]model.GroupPurchase{1: {ID: 1, Status: model.GroupPurchaseOpen}},
+			amount:    21,
+			wantLimit: model.LimitDailyTransfer,
+			remaining: 20,
+		},
+		{
+			name:   "refunded pledges do not count",
+			limits: model.SpendingLimits{DailyTransfer: ptrUint32(100)},
+			pledges: []fakePledge{
+				{groupID: 1, username: "alice", amount: 80, createdAt: day.Add(time.Second)},
+			},
+			groups: map[int32]model.GroupPurchase{1: {ID: 1, Status: model.GroupPurchaseRefunded}},
+			amount: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFakeRepo(model.User{Username: "alice"}, model.User{Username: "bob"})
+			r.limits["alice"] = tt.limits
+			r.transfers = tt.sent
+			r.teamTxs = tt.teamTxs
+			r.pledges = tt.pledges
+			if tt.groups != nil {
+				r.groups = tt.groups
+			}
+			err := checkTransferLimits(context.Background(), r, "alice", tt.amount)
+			checkLimitError(t, err, tt.wantLimit, tt.remaining)
+		})
+	}
+}
+
+func TestCheckPurchaseLimits(t *testing.T) {
+	_, month := limitPeriods(time.Now())
+	r := newFakeRepo(model.User{Username: "alice"})
+	r.limits["alice"] = model.SpendingLimits{MonthlyPurchase: ptrUint32(100)}
+	r.purchases = []fakePurchase{
+		{order: model.PurchaseOrder{Username: "alice", Item: "cup", Price: 20, Currency: model.DefaultCurrency}, createdAt: month.Add(-time.Second)},
+		{order: model.PurchaseOrder{Username: "alice", Item: "book", Price: 50, Currency: model.DefaultCurrency}, createdAt: month.Add(time.Second)},
+	}
+	ctx := context.Background()
+	if err := checkPurchaseLimits(ctx, r, "alice", 50); err != nil {
+		t.Fatalf("checkPurchaseLimits(50) = %v, want nil", err)
+	}
+	checkLimitError(t, checkPurchaseLimits(ctx, r, "alice", 51), model.LimitMonthlyPurchase, 50)
+}
+
+// checkLimitError fails the test unless err is a LimitExceededError of the
+// given limit, or nil if limit is empty.
+func checkLimitError(t *testing.T, err error, limit string, remaining uint32) {
+	t.Helper()
+	if limit == "" {
+		if err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		return
+	}
+	var exceeded *model.LimitExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("got error %v, want a %s limit error", err, limit)
+	}
+	if exceeded.Limit != limit || exceeded.Remaining != remaining {
+		t.Fatalf("got %s limit with %d remaining, want %s with %d", exceeded.Limit, exceeded.Remaining, limit, remaining)
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const loginFailureWindow = 15 * time.Minute
+
+// loginPolicy describes how failed logins for one kind of key are throttled:
+// from delayAfter failures on, every next attempt has to wait twice as long,
+// up to maxDelay, and from lockoutAfter failures on, logins are locked out.
+type loginPolicy struct {
+	prefix       string
+	delayAfter   uint32
+	lockoutAfter uint32
+}
+
+const (
+	loginBaseDelay = time.Second
+	loginMaxDelay  = 30 * time.Second
+	loginLockout   = 15 * time.Minute
+)
+
+var (
+	userLoginPolicy = loginPolicy{prefix: "user:", delayAfter: 3, lockoutAfter: 10}
+	ipLoginPolicy   = loginPolicy{prefix: "ip:", delayAfter: 10, lockoutAfter: 100}
+)
+
+func (p loginPolicy) delay(failures uint32) time.Duration {
+	switch {
+	case failures >= p.lockoutAfter:
+		return loginLockout
+	case failures < p.delayAfter:
+		return 0
+	}
+	delay := loginBaseDelay
+	for i := p.delayAfter; i < failures && delay < loginMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, loginMaxDelay)
+}
+
+// wait returns how long the next attempt after failures has to wait at now.
+func (p loginPolicy) wait(f model.LoginFailures, now time.Time) time.Duration {
+	return max(f.LastAt.Add(p.delay(f.Count)).Sub(now), 0)
+}
+
+// loginKeys returns the throttling keys of a login attempt with their
+// policies. Attempts not coming from HTTP are only throttled per user.
+func loginKeys(ctx context.Context, username string) map[string]loginPolicy {
+	keys := map[string]loginPolicy{userLoginPolicy.prefix + username: userLoginPolicy}
+	if ip := requestMeta(ctx).IP; ip != "" {
+		keys[ipLoginPolicy.prefix+ip] = ipLoginPolicy
+	}
+	return keys
+}
+
+// claimLoginAttempt counts a login attempt as failed before its credentials
+// are checked, or returns a LoginBlockedError if the user or the client
+// address has to wait before the next attempt. Every key is checked before
+// any is counted, so that an attempt blocked for one key, say a locked out
+// address, does not run up the failures of another, such as the user's.
+// Counting happens atomically per key and checks again, which keeps
+// concurrent guesses from all passing the check before any of them is
+// recorded; an attempt that loses such a race takes back the keys it has
+// already counted. Blocked attempts cost no bcrypt work.
+func (s *MerchService) claimLoginAttempt(ctx context.Context, username string) error {
+	keys := loginKeys(ctx, username)
+	var wait time.Duration
+	for key, policy := range keys {
+		f, err := s.logins.GetLoginFailures(ctx, key, loginFailureWindow)
+		if err != nil {
+			return fmt.Errorf("failed to get login attempts: %w", err)
+		}
+		wait = max(wait, policy.wait(f, time.Now()))
+	}
+	if wait > 0 {
+		return &model.LoginBlockedError{RetryAfter: wait}
+	}
+
+	var counted []string
+	for key, policy := range keys {
+		err := s.logins.UpdateLoginFailures(ctx, key, loginFailureWindow, func(f *model.LoginFailures) {
+			now := time.Now()
+			if w := policy.wait(*f, now); w > 0 {
+				wait = max(wait, w)
+				return
+			}
+			f.Count++
+			f.LastAt = now
+			counted = append(counted, key)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record login attempt: %w", err)
+		}
+		if wait > 0 {
+			break
+		}
+	}
+	if wait == 0 {
+		return nil
+	}
+	for _, key := range counted {
+		err := s.logins.UpdateLoginFailures(ctx, key, loginFailureWindow, func(f *model.LoginFailures) {
+			if f.Count > 0 {
+				f.Count--
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to release login attempt: %w", err)
+		}
+	}
+	return &model.LoginBlockedError{RetryAfter: wait}
+}
+
+// releaseLoginAttempt takes back the address failure counted by
+// claimLoginAttempt once the credentials turned out to be valid, so that
+// colleagues signing in from behind the same address do not throttle each
+// other.
+func (s *MerchService) releaseLoginAttempt(ctx context.Context) error {
+	ip := requestMeta(ctx).IP
+	if ip == "" {
+		return nil
+	}
+	err := s.logins.UpdateLoginFailures(ctx, ipLoginPolicy.prefix+ip, loginFailureWindow, func(f *model.LoginFailures) {
+		if f.Count > 0 {
+			f.Count--
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release login attempt: %w", err)
+	}
+	return nil
+}
+
+// recordLoginSuccess clears the user's failures. Address failures are kept,
+// so that logging in to an own account does not reset guessing at others.
+func (s *MerchService) recordLoginSuccess(ctx context.Context, username string) error {
+	if err := s.logins.ResetLoginFailures(ctx, userLoginPolicy.prefix+username); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
+
+// UnlockUser lifts the login lockout of a user.
+func (s *MerchService) UnlockUser(ctx context.Context, admin, username string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetUser(ctx, username); err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := s.logins.ResetLoginFailures(ctx, userLoginPolicy.prefix+username); err != nil {
+			return fmt.Errorf("failed to reset login attempts: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditLoginUnlocked, username, nil, nil)
+	})
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+func TestClaimLoginAttemptChecksEveryKeyFirst(t *testing.T) {
+	logins := repository.NewMemoryLoginAttemptStore()
+	s := NewMerchService(newFakeRepo(), logins, DefaultPasswordHashing, nil, []byte("test audit key"))
+	ctx := context.WithValue(context.Background(), "requestMeta", model.RequestMeta{IP: "192.0.2.1"})
+	key := ipLoginPolicy.prefix + "192.0.2.1"
+	err := logins.UpdateLoginFailures(ctx, key, loginFailureWindow, func(f *model.LoginFailures) {
+		f.Count = ipLoginPolicy.lockoutAfter
+		f.LastAt = time.Now()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blocked *model.LoginBlockedError
+	if err := s.claimLoginAttempt(ctx, "alice"); !errors.As(err, &blocked) {
+		t.Fatalf("claimLoginAttempt from a locked out address = %v, want a LoginBlockedError", err)
+	}
+	f, err := logins.GetLoginFailures(ctx, userLoginPolicy.prefix+"alice", loginFailureWindow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Count != 0 {
+		t.Fatalf("the blocked attempt counted %d failures against alice, want 0", f.Count)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,7 +10,6 @@ import (
 	"merchshop/internal/repository"
 
 	"github.com/golang-jwt/jwt/v4"
-	"golang.org/x/crypto/bcrypt"
 )
 
 const (
@@ -17,53 +17,98 @@ const (
 	jwtExpiration = 12 * time.Hour
 )
 
-func hashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-	return string(bytes), nil
+type MerchService struct {
+	repo     repository.MerchRepository
+	logins   repository.LoginAttemptStore
+	hashing  PasswordHashing
+	auth     Authenticator
+	auditKey []byte
+	keyUses  apiKeyUses
 }
 
-func checkPasswordHash(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+// NewMerchService creates the service. Passwords are checked by auth, or
+// against the local hashes when it is nil. The audit hash chain is keyed
+// with auditKey; tools that only append audit entries may pass nil.
+func NewMerchService(repo repository.MerchRepository, logins repository.LoginAttemptStore, hashing PasswordHashing, auth Authenticator, auditKey []byte) *MerchService {
+	if auth == nil {
+		auth = NewLocalAuthenticator(repo, hashing)
+	}
+	return &MerchService{repo: repo, logins: logins, hashing: hashing, auth: auth, auditKey: auditKey}
 }
 
-type MerchService struct {
-	repo repository.MerchRepository
+// Authenticate checks the password and returns the token, or a challenge
+// for users who have to pass two-factor authentication as well. Unknown
+// users are registered on their first login.
+func (s *MerchService) Authenticate(ctx context.Context, username, password string) (*model.AuthResult, error) {
+	username = s.auth.CanonicalUsername(username)
+	if err := s.claimLoginAttempt(ctx, username); err != nil {
+		return nil, err
+	}
+	err := s.auth.Authenticate(ctx, username, password)
+	switch {
+	case errors.Is(err, model.ErrUserNotFound):
+		hashed, err := hashPassword(password, s.hashing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		if err := s.createUser(ctx, username, hashed); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, model.ErrInvalidPassword):
+		if err := s.auditAlone(ctx, username, model.AuditLoginFailed, username, nil); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrInvalidPassword
+	case err != nil:
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	default:
+		// Directory users get a merch account on their first login.
+		_, err := s.repo.GetUser(ctx, username)
+		if errors.Is(err, model.ErrUserNotFound) {
+			if err := s.createUser(ctx, username, noPasswordHash); err != nil {
+				return nil, err
+			}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+	}
+	if err := s.releaseLoginAttempt(ctx); err != nil {
+		return nil, err
+	}
+	return s.signIn(ctx, username, nil)
 }
 
-func NewMerchService(repo repository.MerchRepository) *MerchService {
-	return &MerchService{repo: repo}
+// createUser registers a user with the given password hash.
+func (s *MerchService) createUser(ctx context.Context, username, hash string) error {
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		return s.registerUser(ctx, r, username, hash)
+	})
 }
 
-func (s *MerchService) Authenticate(ctx context.Context, username, password string) (string, error) {
-	user, err := s.repo.GetUser(ctx, username)
+// registerUser creates the user, unless the name belonged to an erased user.
+func (s *MerchService) registerUser(ctx context.Context, r repository.MerchRepository, username, hash string) error {
+	erased, err := r.IsUsernameErased(ctx, username)
 	if err != nil {
-		if err == model.ErrUserNotFound {
-			hashed, err := hashPassword(password)
-			if err != nil {
-				return "", fmt.Errorf("failed to hash password: %w", err)
-			}
-			if err := s.repo.CreateUser(ctx, username, hashed); err != nil {
-				return "", fmt.Errorf("failed to create user: %w", err)
-			}
-			user, err = s.repo.GetUser(ctx, username)
-			if err != nil {
-				return "", fmt.Errorf("failed to retrieve created user: %w", err)
-			}
-		} else {
-			return "", fmt.Errorf("failed to get user: %w", err)
-		}
+		return fmt.Errorf("failed to check erased usernames: %w", err)
 	}
-
-	if !checkPasswordHash(password, user.PasswordHash) {
-		return "", model.ErrInvalidPassword
+	if erased {
+		return model.ErrUsernameErased
 	}
+	if err := r.CreateUser(ctx, username, hash); err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	if err := r.Publish(ctx, model.UserRegistered{Username: username}); err != nil {
+		return fmt.Errorf("failed to publish registration event: %w", err)
+	}
+	return s.audit(ctx, r, username, model.AuditUserRegistered, username, nil, nil)
+}
 
+// issueToken signs the JWT the API is accessed with. It carries the user's
+// token version, so that bumping the version revokes it.
+func issueToken(username string, version uint32) (string, error) {
 	claims := jwt.MapClaims{
-		"sub": user.Username,
+		"sub": username,
+		"ver": version,
 		"exp": time.Now().Add(jwtExpiration).Unix(),
 	}
 
@@ -77,28 +122,125 @@ func (s *MerchService) Authenticate(ctx context.Context, username, password stri
 	return tokenString, nil
 }
 
-func (s *MerchService) BuyItem(ctx context.Context, username, item string) error {
-	price, err := s.repo.GetProductPrice(ctx, item)
+// VerifyToken checks that a token issued to the user at version has not been
+// revoked since, as it is when the password changes, and that the user has
+// not been deactivated.
+func (s *MerchService) VerifyToken(ctx context.Context, username string, version uint32) error {
+	user, err := s.repo.GetUser(ctx, username)
+	if errors.Is(err, model.ErrUserNotFound) {
+		return model.ErrTokenRevoked
+	}
 	if err != nil {
-		return fmt.Errorf("failed to get product price: %w", err)
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.TokenVersion != version || user.DeactivatedAt != nil {
+		return model.ErrTokenRevoked
 	}
+	return nil
+}
+
+// BuyItem purchases an item for the user. Items that come in several variants
+// (sizes, colours) are bought by variant SKU, or as their default variant
+// when no SKU is given; the variant price, when set, overrides the product
+// price. The best active sale is applied first, then
+// the promo code, if given. The price is charged in the product's currency;
+// spending limits only apply to coins.
+func (s *MerchService) BuyItem(ctx context.Context, username, item, variant, promoCode string) error {
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		product, err := r.GetProduct(ctx, item)
+		if err != nil {
+			return fmt.Errorf("failed to get product: %w", err)
+		}
+		if !product.Active {
+			return model.ErrItemInactive
+		}
+		order := model.PurchaseOrder{
+			Username: username,
+			Item:     item,
+			Price:    product.Price,
+			Currency: product.Currency,
+		}
 
-	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
-		if err := r.DeductCoins(ctx, username, int32(price)); err != nil {
+		v, err := productVariant(ctx, r, item, variant)
+		if err != nil {
+			return err
+		}
+		if v != nil {
+			if err := r.DecrementVariantStock(ctx, v.ID); err != nil {
+				return fmt.Errorf("failed to reserve variant stock: %w", err)
+			}
+			if v.Price != nil {
+				order.Price = *v.Price
+			}
+			order.VariantID = &v.ID
+		}
+
+		sales, err := r.GetActiveSales(ctx, item)
+		if err != nil {
+			return fmt.Errorf("failed to get active sales: %w", err)
+		}
+		if sale := bestSale(order.Price, sales); sale != nil {
+			order.Price = applyDiscount(order.Price, sale.Discount)
+			order.SaleID = &sale.ID
+		}
+
+		if promoCode != "" {
+			promo, err := redeemPromoCode(ctx, r, promoCode, username, product)
+			if err != nil {
+				return fmt.Errorf("failed to redeem promo code: %w", err)
+			}
+			order.Price = applyDiscount(order.Price, promo.Discount)
+			order.PromoCode = promo.Code
+		}
+
+		if _, err := r.DeductBalance(ctx, username, order.Currency, int32(order.Price)); err != nil {
 			return fmt.Errorf("failed to deduct coins: %w", err)
 		}
-		if cpRepo, ok := r.(interface {
-			CreatePurchase(ctx context.Context, username string, item string, price int32) error
-		}); ok {
-			if err := cpRepo.CreatePurchase(ctx, username, item, int32(price)); err != nil {
-				return fmt.Errorf("failed to create purchase record: %w", err)
+		if order.Currency == model.DefaultCurrency {
+			if err := checkPurchaseLimits(ctx, r, username, order.Price); err != nil {
+				return err
 			}
-		} else {
-			fmt.Println("warning: CreatePurchase not implemented in repository")
 		}
-		return nil
+		if err := r.CreatePurchase(ctx, order); err != nil {
+			return fmt.Errorf("failed to create purchase record: %w", err)
+		}
+		err = r.CreateNotification(ctx, username, model.NotificationPurchaseCompleted, map[string]any{
+			"item":     item,
+			"variant":  variant,
+			"price":    order.Price,
+			"currency": order.Currency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		err = r.Publish(ctx, model.ItemPurchased{
+			Username:  username,
+			Item:      item,
+			Variant:   variant,
+			Price:     order.Price,
+			Currency:  order.Currency,
+			PromoCode: order.PromoCode,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish purchase event: %w", err)
+		}
+		balance, err := balanceOf(ctx, r, username, order.Currency)
+		if err != nil {
+			return err
+		}
+		field := balanceField("", order.Currency)
+		return s.audit(ctx, r, username, model.AuditItemPurchased, item,
+			map[string]any{field: balance + order.Price},
+			map[string]any{field: balance, "variant": variant, "price": order.Price, "currency": order.Currency})
 	})
-	return err
+}
+
+func (s *MerchService) GetBalance(ctx context.Context, username string) (uint32, error) {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.Coins, nil
 }
 
 func (s *MerchService) GetInfo(ctx context.Context, username string) (*model.Info, error) {
@@ -118,8 +260,18 @@ func (s *MerchService) GetInfo(ctx context.Context, username string) (*model.Inf
 	if err != nil {
 		return nil, fmt.Errorf("failed to get received coin history: %w", err)
 	}
+	allowance, err := spendingAllowance(ctx, s.repo, username)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := userBalances(ctx, s.repo, user)
+	if err != nil {
+		return nil, err
+	}
 	info := &model.Info{
 		Coins:     user.Coins,
+		Balances:  balances,
+		Allowance: allowance,
 		Inventory: inv,
 		CoinHistory: model.CoinHistory{
 			Sent:     sent,
@@ -129,18 +281,228 @@ func (s *MerchService) GetInfo(ctx context.Context, username string) (*model.Inf
 	return info, nil
 }
 
-// SendCoin transfers coins from one user to another atomically.
-func (s *MerchService) SendCoin(ctx context.Context, fromUsername, toUsername string, amount int32) error {
+// ListCatalog returns the active products matching the filter together with
+// their images and variants.
+func (s *MerchService) ListCatalog(ctx context.Context, filter model.CatalogFilter) ([]model.CatalogItem, error) {
+	items, err := s.repo.ListCatalog(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list catalog: %w", err)
+	}
+	return items, nil
+}
+
+func (s *MerchService) ListCategories(ctx context.Context) ([]model.Category, error) {
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+// GetPurchases returns the user's purchase history, oldest first.
+func (s *MerchService) GetPurchases(ctx context.Context, username string) ([]model.Purchase, error) {
+	purchases, err := s.repo.GetPurchases(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get purchases: %w", err)
+	}
+	return purchases, nil
+}
+
+func validatePeriod(period model.Period) error {
+	if period.From != nil && period.To != nil && !period.From.Before(*period.To) {
+		return model.ErrInvalidPeriod
+	}
+	return nil
+}
+
+// GetLeaderboard returns the users who received the most coins over the
+// period, or who sent the most when bySender is set.
+func (s *MerchService) GetLeaderboard(ctx context.Context, period model.Period, bySender bool, limit int32) ([]model.LeaderboardEntry, error) {
+	if err := validatePeriod(period); err != nil {
+		return nil, err
+	}
+	var entries []model.LeaderboardEntry
+	var err error
+	if bySender {
+		entries, err = s.repo.GetTopSenders(ctx, period, limit)
+	} else {
+		entries, err = s.repo.GetTopReceivers(ctx, period, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	return entries, nil
+}
+
+// GetTopItems returns the most bought items over the period.
+func (s *MerchService) GetTopItems(ctx context.Context, period model.Period, limit int32) ([]model.ItemRanking, error) {
+	if err := validatePeriod(period); err != nil {
+		return nil, err
+	}
+	items, err := s.repo.GetTopItems(ctx, period, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top items: %w", err)
+	}
+	return items, nil
+}
+
+func (s *MerchService) GetUserStats(ctx context.Context, username string, period model.Period) (*model.UserStats, error) {
+	if err := validatePeriod(period); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetUser(ctx, username); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	stats, err := s.repo.GetUserStats(ctx, username, period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+	return stats, nil
+}
+
+func (s *MerchService) AddToWishlist(ctx context.Context, username, item string) error {
+	if err := s.repo.AddWishlistItem(ctx, username, item); err != nil {
+		return fmt.Errorf("failed to add item to wishlist: %w", err)
+	}
+	return nil
+}
+
+func (s *MerchService) RemoveFromWishlist(ctx context.Context, username, item string) error {
+	if err := s.repo.RemoveWishlistItem(ctx, username, item); err != nil {
+		return fmt.Errorf("failed to remove item from wishlist: %w", err)
+	}
+	return nil
+}
+
+// GetWishlist returns the user's wishlist along with how much is still
+// missing for each item given the balance in the currency it is priced in.
+// Items are priced at their cheapest variant after the best running sale.
+func (s *MerchService) GetWishlist(ctx context.Context, username string) ([]model.WishlistItem, error) {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	wishlist, err := s.repo.GetWishlist(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+	balances, err := userBalances(ctx, s.repo, user)
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]uint32, len(balances))
+	for _, b := range balances {
+		have[b.Currency] = b.Amount
+	}
+	for i := range wishlist {
+		if balance := have[wishlist[i].Currency]; wishlist[i].Price > balance {
+			wishlist[i].Missing = wishlist[i].Price - balance
+		}
+	}
+	return wishlist, nil
+}
+
+func (s *MerchService) GetNotifications(ctx context.Context, username string, filter model.NotificationFilter) ([]model.Notification, error) {
+	notifications, err := s.repo.GetNotifications(ctx, username, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// MarkNotificationsRead marks the given notifications as read, or the whole
+// inbox when no ids are given.
+func (s *MerchService) MarkNotificationsRead(ctx context.Context, username string, ids []int64) error {
+	if len(ids) == 0 {
+		if err := s.repo.MarkAllNotificationsRead(ctx, username); err != nil {
+			return fmt.Errorf("failed to mark notifications read: %w", err)
+		}
+		return nil
+	}
+	if err := s.repo.MarkNotificationsRead(ctx, username, ids); err != nil {
+		return fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return nil
+}
+
+func (s *MerchService) CountUnreadNotifications(ctx context.Context, username string) (uint32, error) {
+	count, err := s.repo.CountUnreadNotifications(ctx, username)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// SendCoin transfers an amount of the currency from one user to another
+// atomically. Deducting the balance locks it, so the spending limits, which
+// only apply to coins, are checked right after it. Expiring currencies
+// keep the expiry of the sender's lots they were taken from, so passing
+// them on does not extend their lifetime.
+// Deactivated users cannot receive coins.
+func (s *MerchService) SendCoin(ctx context.Context, fromUsername, toUsername, currency string, amount int32) error {
 	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
-		if err := r.DeductCoins(ctx, fromUsername, amount); err != nil {
+		c, err := r.GetCurrency(ctx, currency)
+		if err != nil {
+			return fmt.Errorf("failed to get currency: %w", err)
+		}
+		if !c.Transferable {
+			return model.ErrCurrencyNotTransferable
+		}
+		lots, err := r.DeductBalance(ctx, fromUsername, currency, amount)
+		if err != nil {
 			return fmt.Errorf("failed to deduct coins from sender: %w", err)
 		}
-		if err := r.AddCoins(ctx, toUsername, amount); err != nil {
-			return fmt.Errorf("failed to add coins to receiver: %w", err)
+		if currency == model.DefaultCurrency {
+			if err := checkTransferLimits(ctx, r, fromUsername, uint32(amount)); err != nil {
+				return err
+			}
 		}
-		if err := r.InsertCoinTransfer(ctx, fromUsername, toUsername, amount); err != nil {
+		if err := requireActive(ctx, r, toUsername); err != nil {
+			return err
+		}
+		for _, lot := range lots {
+			if err := r.AddBalance(ctx, toUsername, currency, lot.Amount, lot.ExpiresAt); err != nil {
+				return fmt.Errorf("failed to add coins to receiver: %w", err)
+			}
+		}
+		if err := r.InsertCoinTransfer(ctx, fromUsername, toUsername, currency, amount); err != nil {
 			return fmt.Errorf("failed to log coin transfer: %w", err)
 		}
-		return nil
+		err = r.CreateNotification(ctx, toUsername, model.NotificationCoinsReceived, map[string]any{
+			"fromUser": fromUsername,
+			"amount":   amount,
+			"currency": currency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		err = r.Publish(ctx, model.CoinsTransferred{
+			FromUser: fromUsername,
+			ToUser:   toUsername,
+			Amount:   uint32(amount),
+			Currency: currency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish transfer event: %w", err)
+		}
+		sender, err := balanceOf(ctx, r, fromUsername, currency)
+		if err != nil {
+			return err
+		}
+		receiver, err := balanceOf(ctx, r, toUsername, currency)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, r, fromUsername, model.AuditCoinsTransferred, toUsername,
+			map[string]any{
+				balanceField("from", currency): sender + uint32(amount),
+				balanceField("to", currency):   receiver - uint32(amount),
+			},
+			map[string]any{
+				balanceField("from", currency): sender,
+				balanceField("to", currency):   receiver,
+				"amount":                       amount,
+				"currency":                     currency,
+			})
 	})
 }
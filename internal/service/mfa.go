@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+	"merchshop/internal/totp"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	mfaIssuer = "MerchShop"
+	// mfaChallengePurpose marks challenge tokens, which the API does not
+	// accept in place of a real token.
+	mfaChallengePurpose = "mfa"
+	mfaChallengeTTL     = 5 * time.Minute
+
+	recoveryCodeCount = 10
+)
+
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// signIn issues the token of an active user who passed the first factor, or
+// a challenge if the user has to pass TOTP as well. meta is recorded with the
+// login in the audit log.
+func (s *MerchService) signIn(ctx context.Context, username string, meta map[string]any) (*model.AuthResult, error) {
+	if err := requireActive(ctx, s.repo, username); err != nil {
+		return nil, err
+	}
+	challenge, err := s.mfaChallenge(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &model.AuthResult{Challenge: challenge}, nil
+	}
+	token, err := s.finishLogin(ctx, username, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AuthResult{Token: token}, nil
+}
+
+func (s *MerchService) finishLogin(ctx context.Context, username string, meta map[string]any) (string, error) {
+	if err := s.recordLoginSuccess(ctx, username); err != nil {
+		return "", err
+	}
+	if err := s.auditAlone(ctx, username, model.AuditLogin, username, meta); err != nil {
+		return "", err
+	}
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	return issueToken(username, user.TokenVersion)
+}
+
+// mfaChallenge returns a challenge for users with TOTP and for admins, who
+// have to set it up if they have not yet, and nil for everyone else.
+func (s *MerchService) mfaChallenge(ctx context.Context, username string) (*model.MFAChallenge, error) {
+	mfa, err := s.repo.GetUserMFA(ctx, username)
+	if err != nil && !errors.Is(err, model.ErrMFANotEnrolled) {
+		return nil, fmt.Errorf("failed to get two-factor enrollment: %w", err)
+	}
+	enrolled := err == nil && mfa.Confirmed
+	if !enrolled {
+		user, err := s.repo.GetUser(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if user.Role != model.RoleAdmin {
+			return nil, nil
+		}
+	}
+	expiresAt := time.Now().Add(mfaChallengeTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":     username,
+		"exp":     expiresAt.Unix(),
+		"purpose": mfaChallengePurpose,
+	})
+	signed, err := token.SignedString([]byte(jwtSecretKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign challenge: %w", err)
+	}
+	return &model.MFAChallenge{Token: signed, EnrollmentRequired: !enrolled, ExpiresAt: expiresAt}, nil
+}
+
+// parseChallenge returns the user a challenge token was issued to.
+func parseChallenge(challenge string) (string, error) {
+	token, err := jwt.Parse(challenge, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecretKey), nil
+	})
+	if err != nil || !token.Valid {
+		return "", model.ErrInvalidMFAChallenge
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != mfaChallengePurpose {
+		return "", model.ErrInvalidMFAChallenge
+	}
+	username, ok := claims["sub"].(string)
+	if !ok || username == "" {
+		return "", model.ErrInvalidMFAChallenge
+	}
+	return username, nil
+}
+
+// CompleteMFALogin issues the token of a challenged user given a TOTP code
+// or an unused recovery code. Admins enrolling while signing in confirm
+// their enrollment with the code and get their recovery codes back. Wrong
+// codes count as failed logins.
+func (s *MerchService) CompleteMFALogin(ctx context.Context, challenge, code string) (*model.AuthResult, error) {
+	username, err := parseChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.claimLoginAttempt(ctx, username); err != nil {
+		return nil, err
+	}
+	if err := requireActive(ctx, s.repo, username); err != nil {
+		return nil, err
+	}
+	mfa, err := s.repo.GetUserMFA(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor enrollment: %w", err)
+	}
+	result := &model.AuthResult{}
+	var method string
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		method, err = verifySecondFactor(ctx, r, mfa, code)
+		if err != nil || mfa.Confirmed {
+			return err
+		}
+		result.RecoveryCodes, err = s.enableMFA(ctx, r, username)
+		return err
+	})
+	if errors.Is(err, model.ErrInvalidMFACode) {
+		if err := s.auditAlone(ctx, username, model.AuditMFAFailed, username, nil); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrInvalidMFACode
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.releaseLoginAttempt(ctx); err != nil {
+		return nil, err
+	}
+	result.Token, err = s.finishLogin(ctx, username, map[string]any{"mfa": method})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EnrollMFA starts setting up TOTP for the user, replacing a pending
+// enrollment. It takes effect once ConfirmMFA accepts a code.
+func (s *MerchService) EnrollMFA(ctx context.Context, username string) (*model.MFAEnrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	if err := s.repo.SetPendingMFASecret(ctx, username, secret); err != nil {
+		return nil, fmt.Errorf("failed to start two-factor enrollment: %w", err)
+	}
+	return &model.MFAEnrollment{Secret: secret, URI: totp.URI(mfaIssuer, username, secret)}, nil
+}
+
+// EnrollMFAWithChallenge lets an admin who has to set up TOTP before
+// signing in enroll with the login challenge.
+func (s *MerchService) EnrollMFAWithChallenge(ctx context.Context, challenge string) (*model.MFAEnrollment, error) {
+	username, err := parseChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	return s.EnrollMFA(ctx, username)
+}
+
+// ConfirmMFA enables the pending TOTP enrollment once code matches it and
+// returns the recovery codes, which are shown only this once.
+func (s *MerchService) ConfirmMFA(ctx context.Context, username, code string) ([]string, error) {
+	mfa, err := s.repo.GetUserMFA(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor enrollment: %w", err)
+	}
+	if mfa.Confirmed {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+	var codes []string
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		if err = useTOTP(ctx, r, mfa, code); err != nil {
+			return err
+		}
+		codes, err = s.enableMFA(ctx, r, username)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *MerchService) enableMFA(ctx context.Context, r repository.MerchRepository, username string) ([]string, error) {
+	if err := r.ConfirmUserMFA(ctx, username); err != nil {
+		return nil, fmt.Errorf("failed to confirm two-factor enrollment: %w", err)
+	}
+	codes, err := replaceRecoveryCodes(ctx, r, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.audit(ctx, r, username, model.AuditMFAEnabled, username, nil, nil); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RenewRecoveryCodes replaces the user's recovery codes with new ones,
+// given a TOTP code.
+func (s *MerchService) RenewRecoveryCodes(ctx context.Context, username, code string) ([]string, error) {
+	mfa, err := s.confirmedMFA(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var codes []string
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		if err = useTOTP(ctx, r, mfa, code); err != nil {
+			return err
+		}
+		codes, err = replaceRecoveryCodes(ctx, r, username)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, r, username, model.AuditRecoveryCodesRenewed, username, nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableMFA turns TOTP off given a TOTP or recovery code. Admins cannot
+// turn it off.
+func (s *MerchService) DisableMFA(ctx context.Context, username, code string) error {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Role == model.RoleAdmin {
+		return model.ErrMFARequired
+	}
+	mfa, err := s.confirmedMFA(ctx, username)
+	if err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if _, err := verifySecondFactor(ctx, r, mfa, code); err != nil {
+			return err
+		}
+		if err := r.DeleteUserMFA(ctx, username); err != nil {
+			return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+		}
+		return s.audit(ctx, r, username, model.AuditMFADisabled, username, nil, nil)
+	})
+}
+
+// ResetUserMFA removes the TOTP enrollment of a user who lost access to it.
+// Admins reset this way have to enroll again on their next login.
+func (s *MerchService) ResetUserMFA(ctx context.Context, admin, username string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.DeleteUserMFA(ctx, username); err != nil {
+			return fmt.Errorf("failed to reset two-factor authentication: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditMFADisabled, username, nil, nil)
+	})
+}
+
+func (s *MerchService) GetMFAStatus(ctx context.Context, username string) (*model.MFAStatus, error) {
+	mfa, err := s.repo.GetUserMFA(ctx, username)
+	if errors.Is(err, model.ErrMFANotEnrolled) || (err == nil && !mfa.Confirmed) {
+		return &model.MFAStatus{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor enrollment: %w", err)
+	}
+	left, err := s.repo.CountRecoveryCodes(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return &model.MFAStatus{Enabled: true, RecoveryCodesLeft: left}, nil
+}
+
+func (s *MerchService) confirmedMFA(ctx context.Context, username string) (*model.UserMFA, error) {
+	mfa, err := s.repo.GetUserMFA(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor enrollment: %w", err)
+	}
+	if !mfa.Confirmed {
+		return nil, model.ErrMFANotEnrolled
+	}
+	return mfa, nil
+}
+
+// verifySecondFactor accepts a TOTP code or an unused recovery code and
+// returns which one it was.
+func verifySecondFactor(ctx context.Context, r repository.MerchRepository, mfa *model.UserMFA, code string) (string, error) {
+	if isTOTPCode(code) {
+		return "totp", useTOTP(ctx, r, mfa, code)
+	}
+	if err := r.UseRecoveryCode(ctx, mfa.Username, hashRecoveryCode(code)); err != nil {
+		return "", fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	return "recovery_code", nil
+}
+
+// useTOTP accepts a code that matches the secret and is newer than the last
+// accepted one.
+func useTOTP(ctx context.Context, r repository.MerchRepository, mfa *model.UserMFA, code string) error {
+	step, ok := totp.Validate(mfa.Secret, code, time.Now())
+	if !ok || step <= mfa.LastUsedStep {
+		return model.ErrInvalidMFACode
+	}
+	if err := r.UseMFAStep(ctx, mfa.Username, step); err != nil {
+		return fmt.Errorf("failed to record totp code: %w", err)
+	}
+	return nil
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// replaceRecoveryCodes stores new recovery codes, formatted as xxxxx-xxxxx,
+// by their hashes and returns them.
+func replaceRecoveryCodes(ctx context.Context, r repository.MerchRepository, username string) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 6)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := recoveryCodeEncoding.EncodeToString(raw)
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	if err := r.ReplaceRecoveryCodes(ctx, username, hashes); err != nil {
+		return nil, fmt.Errorf("failed to store recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode ignores case, dashes and spaces in the code.
+func hashRecoveryCode(code string) string {
+	code = strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+	return hashToken(code)
+}
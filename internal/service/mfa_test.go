@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/totp"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func totpCode(t *testing.T, step int64) string {
+	t.Helper()
+	code, err := totp.Code(testTOTPSecret, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestUseTOTP(t *testing.T) {
+	now := totp.Step(time.Now())
+	tests := []struct {
+		name     string
+		lastUsed int64
+		step     int64
+		wantErr  error
+	}{
+		{"fresh code", now - 5, now, nil},
+		{"code of the previous step", now - 5, now - 1, nil},
+		{"replayed code", now, now, model.ErrInvalidMFACode},
+		{"code older than the last accepted one", now, now - 1, model.ErrInvalidMFACode},
+		{"code outside the accepted window", now - 5, now - 3, model.ErrInvalidMFACode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFakeRepo(model.User{Username: "alice"})
+			r.mfaSteps["alice"] = tt.lastUsed
+			mfa := &model.UserMFA{Username: "alice", Secret: testTOTPSecret, Confirmed: true, LastUsedStep: tt.lastUsed}
+			err := useTOTP(context.Background(), r, mfa, totpCode(t, tt.step))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("useTOTP = %v, want %v", err, tt.wantErr)
+			}
+			want := tt.lastUsed
+			if tt.wantErr == nil {
+				want = tt.step
+			}
+			if got := r.mfaSteps["alice"]; got != want {
+				t.Fatalf("last used step = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestUseTOTPSameCodeTwice(t *testing.T) {
+	r := newFakeRepo(model.User{Username: "alice"})
+	ctx := context.Background()
+	step := totp.Step(time.Now())
+	code := totpCode(t, step)
+	mfa := &model.UserMFA{Username: "alice", Secret: testTOTPSecret, Confirmed: true}
+	if err := useTOTP(ctx, r, mfa, code); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	// A concurrent login read the enrollment before the step was recorded;
+	// the repository still rejects the second use.
+	if err := useTOTP(ctx, r, mfa, code); !errors.Is(err, model.ErrInvalidMFACode) {
+		t.Fatalf("second use = %v, want %v", err, model.ErrInvalidMFACode)
+	}
+}
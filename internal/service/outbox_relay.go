@@ -0,0 +1,205 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	outboxPollInterval = time.Second
+	outboxBatchSize    = 100
+	relayHTTPTimeout   = 10 * time.Second
+	// outboxLease outlasts a batch in which every publish times out.
+	outboxLease = outboxBatchSize*relayHTTPTimeout + time.Minute
+	// Without a relay, events are kept for outboxRetention before they are
+	// pruned, checking every outboxPruneInterval.
+	outboxRetention     = time.Hour
+	outboxPruneInterval = time.Minute
+	outboxPruneBatch    = 1000
+)
+
+// EventRelay forwards outbox events to a downstream consumer. Relays must be
+// idempotent on the consumer side: an event is relayed at least once and may
+// be repeated if marking it relayed fails.
+type EventRelay interface {
+	Relay(ctx context.Context, event model.OutboxEvent) error
+}
+
+// eventEnvelope is the JSON representation of an outbox event sent to relays
+// and webhook subscribers.
+type eventEnvelope struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func encodeEvent(event model.OutboxEvent) ([]byte, error) {
+	return json.Marshal(eventEnvelope{
+		ID:        event.ID,
+		Type:      event.Type,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Payload,
+	})
+}
+
+// OutboxRelay moves committed outbox events to an EventRelay. A single relay
+// publishes events in id order, but replicas work on separate batches at the
+// same time and failed events are retried later, so consumers that care
+// about ordering must order by the event id. Without an EventRelay, events
+// no webhook delivery refers to are pruned instead, so that the outbox does
+// not grow without bound.
+type OutboxRelay struct {
+	repo  repository.MerchRepository
+	relay EventRelay
+}
+
+func NewOutboxRelay(repo repository.MerchRepository, relay EventRelay) *OutboxRelay {
+	return &OutboxRelay{repo: repo, relay: relay}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (o *OutboxRelay) Run(ctx context.Context) {
+	interval, step := outboxPollInterval, o.relayBatch
+	if o.relay == nil {
+		interval, step = outboxPruneInterval, o.pruneBatch
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := step(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to process outbox events: %v", err)
+		}
+	}
+}
+
+// pruneBatch deletes unrelayed events older than outboxRetention that no
+// webhook delivery refers to.
+func (o *OutboxRelay) pruneBatch(ctx context.Context) error {
+	if _, err := o.repo.PruneOutboxEvents(ctx, time.Now().Add(-outboxRetention), outboxPruneBatch); err != nil {
+		return fmt.Errorf("failed to prune outbox events: %w", err)
+	}
+	return nil
+}
+
+// relayBatch claims pending events and relays them until the first failure,
+// then marks the relayed ones and releases the rest for the next poll.
+func (o *OutboxRelay) relayBatch(ctx context.Context) error {
+	events, err := o.repo.ClaimOutboxEvents(ctx, outboxLease, outboxBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	var relayed, failed []int64
+	for i, event := range events {
+		if err := o.relay.Relay(ctx, event); err != nil {
+			log.Printf("failed to relay %s event %d: %v", event.Type, event.ID, err)
+			for _, e := range events[i:] {
+				failed = append(failed, e.ID)
+			}
+			break
+		}
+		relayed = append(relayed, event.ID)
+	}
+	if len(relayed) > 0 {
+		if err := o.repo.MarkOutboxEventsRelayed(ctx, relayed); err != nil {
+			return fmt.Errorf("failed to mark outbox events relayed: %w", err)
+		}
+	}
+	if len(failed) > 0 {
+		if err := o.repo.ReleaseOutboxEvents(ctx, failed); err != nil {
+			return fmt.Errorf("failed to release outbox events: %w", err)
+		}
+	}
+	return nil
+}
+
+// LogRelay writes events to the standard logger. It is meant for local
+// debugging only, as every event payload ends up in the logs.
+type LogRelay struct{}
+
+func (LogRelay) Relay(ctx context.Context, event model.OutboxEvent) error {
+	body, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	log.Printf("event %s", body)
+	return nil
+}
+
+// HTTPRelay POSTs each event as JSON to a collector endpoint. The event id is
+// sent in the Idempotency-Key header for deduplication.
+type HTTPRelay struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPRelay(url string) *HTTPRelay {
+	return &HTTPRelay{
+		url:    url,
+		client: &http.Client{Timeout: relayHTTPTimeout},
+	}
+}
+
+func (h *HTTPRelay) Relay(ctx context.Context, event model.OutboxEvent) error {
+	body, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", strconv.FormatInt(event.ID, 10))
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// MessageBroker is the minimal producer API needed to relay events to a
+// message broker. It is satisfied by nats.Publisher, and by a thin wrapper
+// around a Kafka writer (topic = subject, message key = key).
+type MessageBroker interface {
+	Publish(ctx context.Context, subject string, key string, data []byte) error
+}
+
+// BrokerRelay publishes each event to <prefix><event type>, keyed by the
+// event id.
+type BrokerRelay struct {
+	broker MessageBroker
+	prefix string
+}
+
+func NewBrokerRelay(broker MessageBroker, prefix string) *BrokerRelay {
+	return &BrokerRelay{broker: broker, prefix: prefix}
+}
+
+func (b *BrokerRelay) Relay(ctx context.Context, event model.OutboxEvent) error {
+	body, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	return b.broker.Publish(ctx, b.prefix+event.Type, strconv.FormatInt(event.ID, 10), body)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	PasswordHashBcrypt   = "bcrypt"
+	PasswordHashArgon2id = "argon2id"
+)
+
+const (
+	minPasswordLength = 8
+	passwordResetTTL  = 24 * time.Hour
+
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// PasswordHashing selects how new password hashes are computed. Stored
+// hashes using another algorithm or other parameters are still accepted and
+// are replaced on the next successful login.
+type PasswordHashing struct {
+	Algorithm  string
+	BcryptCost int
+	// Argon2 parameters: passes over memory, memory in KiB and lanes.
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+var DefaultPasswordHashing = PasswordHashing{
+	Algorithm:     PasswordHashBcrypt,
+	BcryptCost:    bcrypt.DefaultCost,
+	Argon2Time:    1,
+	Argon2Memory:  64 * 1024,
+	Argon2Threads: 4,
+}
+
+func (h PasswordHashing) Validate() error {
+	switch h.Algorithm {
+	case PasswordHashBcrypt:
+		if h.BcryptCost < bcrypt.MinCost || h.BcryptCost > bcrypt.MaxCost {
+			return fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case PasswordHashArgon2id:
+		if h.Argon2Time == 0 || h.Argon2Memory == 0 || h.Argon2Threads == 0 {
+			return fmt.Errorf("argon2id time, memory and threads must be positive")
+		}
+	default:
+		return fmt.Errorf("unknown password hashing algorithm %q", h.Algorithm)
+	}
+	return nil
+}
+
+// argon2Hash is a hash in the PHC string format:
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>.
+type argon2Hash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (a argon2Hash) String() string {
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, a.memory, a.time, a.threads,
+		base64.RawStdEncoding.EncodeToString(a.salt), base64.RawStdEncoding.EncodeToString(a.key))
+}
+
+func parseArgon2Hash(hash string) (argon2Hash, bool) {
+	var a argon2Hash
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != PasswordHashArgon2id {
+		return a, false
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return a, false
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &a.memory, &a.time, &a.threads); err != nil {
+		return a, false
+	}
+	var err error
+	if a.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return a, false
+	}
+	if a.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(a.key) == 0 {
+		return a, false
+	}
+	return a, true
+}
+
+func hashPassword(password string, h PasswordHashing) (string, error) {
+	if h.Algorithm == PasswordHashArgon2id {
+		a := argon2Hash{memory: h.Argon2Memory, time: h.Argon2Time, threads: h.Argon2Threads}
+		a.salt = make([]byte, argon2SaltLength)
+		if _, err := rand.Read(a.salt); err != nil {
+			return "", err
+		}
+		a.key = argon2.IDKey([]byte(password), a.salt, a.time, a.memory, a.threads, argon2KeyLength)
+		return a.String(), nil
+	}
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// checkPasswordHash accepts both bcrypt and argon2id hashes.
+func checkPasswordHash(password, hash string) bool {
+	if strings.HasPrefix(hash, "$"+PasswordHashArgon2id+"$") {
+		a, ok := parseArgon2Hash(hash)
+		if !ok {
+			return false
+		}
+		key := argon2.IDKey([]byte(password), a.salt, a.time, a.memory, a.threads, uint32(len(a.key)))
+		return subtle.ConstantTimeCompare(key, a.key) == 1
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	return err == nil
+}
+
+// needsRehash reports whether hash was computed with another algorithm or
+// other parameters than h.
+func needsRehash(hash string, h PasswordHashing) bool {
+	if a, ok := parseArgon2Hash(hash); ok {
+		return h.Algorithm != PasswordHashArgon2id ||
+			a.memory != h.Argon2Memory || a.time != h.Argon2Time || a.threads != h.Argon2Threads
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || h.Algorithm != PasswordHashBcrypt || cost != h.BcryptCost
+}
+
+// rehashPassword replaces an outdated hash after a successful login. A
+// failure is only logged, as the login itself succeeded.
+func rehashPassword(ctx context.Context, repo repository.MerchRepository, user *model.User, password string, h PasswordHashing) {
+	if !needsRehash(user.PasswordHash, h) {
+		return
+	}
+	hashed, err := hashPassword(password, h)
+	if err == nil {
+		err = repo.UpdatePasswordHash(ctx, user.Username, hashed)
+	}
+	if err != nil {
+		log.Printf("failed to rehash password of %s: %v", user.Username, err)
+	}
+}
+
+func validatePassword(password string) error {
+	if len([]rune(password)) < minPasswordLength {
+		return model.ErrWeakPassword
+	}
+	return nil
+}
+
+// hashToken returns the hex SHA-256 of a random token, which is stored
+// instead of the token itself.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ChangePassword sets a new password after checking the current one and
+// revokes the tokens issued so far. Wrong current passwords count as failed
+// logins.
+func (s *MerchService) ChangePassword(ctx context.Context, username, current, password string) error {
+	if !s.auth.ManagesPasswords() {
+		return model.ErrExternalPasswords
+	}
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if err := s.claimLoginAttempt(ctx, username); err != nil {
+		return err
+	}
+	if !checkPasswordHash(current, user.PasswordHash) {
+		return model.ErrInvalidPassword
+	}
+	if err := s.releaseLoginAttempt(ctx); err != nil {
+		return err
+	}
+	hashed, err := hashPassword(password, s.hashing)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.UpdatePasswordHash(ctx, username, hashed); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		if err := r.RevokeTokens(ctx, username); err != nil {
+			return fmt.Errorf("failed to revoke tokens: %w", err)
+		}
+		return s.audit(ctx, r, username, model.AuditPasswordChanged, username, nil, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return s.recordLoginSuccess(ctx, username)
+}
+
+// IssuePasswordReset creates a one-time token the user can set a new
+// password with, revoking the user's earlier unused tokens. Only the hash of
+// the token is stored, so it is returned once and has to be handed over to
+// the user by the admin.
+func (s *MerchService) IssuePasswordReset(ctx context.Context, admin, username string) (*model.PasswordResetToken, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if !s.auth.ManagesPasswords() {
+		return nil, model.ErrExternalPasswords
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate reset token: %w", err)
+	}
+	reset := &model.PasswordResetToken{
+		Username:  username,
+		Token:     base64.RawURLEncoding.EncodeToString(raw),
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	}
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.CreatePasswordResetToken(ctx, username, hashToken(reset.Token), admin, reset.ExpiresAt); err != nil {
+			return fmt.Errorf("failed to create reset token: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditPasswordResetIssued, username, nil,
+			map[string]any{"expiresAt": reset.ExpiresAt})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reset, nil
+}
+
+// ResetPassword sets a new password with a reset token, revokes the tokens
+// issued so far and lifts the user's login lockout.
+func (s *MerchService) ResetPassword(ctx context.Context, token, password string) error {
+	if !s.auth.ManagesPasswords() {
+		return model.ErrExternalPasswords
+	}
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+	hashed, err := hashPassword(password, s.hashing)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	var username string
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		username, err = r.ConsumePasswordResetToken(ctx, hashToken(token))
+		if err != nil {
+			return fmt.Errorf("failed to consume reset token: %w", err)
+		}
+		if err := r.UpdatePasswordHash(ctx, username, hashed); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		if err := r.RevokeTokens(ctx, username); err != nil {
+			return fmt.Errorf("failed to revoke tokens: %w", err)
+		}
+		return s.audit(ctx, r, username, model.AuditPasswordReset, username, nil, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return s.recordLoginSuccess(ctx, username)
+}
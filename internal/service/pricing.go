@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// applyDiscount returns the price after the discount, never going below zero.
+func applyDiscount(price uint32, d model.Discount) uint32 {
+	off := d.AmountOff
+	if d.PercentOff > 0 {
+		off = price * d.PercentOff / 100
+	}
+	if off >= price {
+		return 0
+	}
+	return price - off
+}
+
+// bestSale picks the sale giving the lowest price, or nil if none applies.
+func bestSale(price uint32, sales []model.Sale) *model.Sale {
+	var best *model.Sale
+	bestPrice := price
+	for i := range sales {
+		if p := applyDiscount(price, sales[i].Discount); p < bestPrice {
+			best = &sales[i]
+			bestPrice = p
+		}
+	}
+	return best
+}
+
+// redeemPromoCode validates the promo code for the user and product and
+// consumes one use of it. Must be called inside a transaction: the code row
+// stays locked until commit so concurrent redemptions are serialized.
+func redeemPromoCode(ctx context.Context, r repository.MerchRepository, code, username string, product *model.Product) (*model.PromoCode, error) {
+	promo, err := r.GetPromoCodeForUpdate(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if (promo.StartsAt != nil && now.Before(*promo.StartsAt)) || (promo.EndsAt != nil && !now.Before(*promo.EndsAt)) {
+		return nil, model.ErrPromoCodeInactive
+	}
+	if (promo.Item != "" && promo.Item != product.Item) || (promo.Category != "" && promo.Category != product.Category) {
+		return nil, model.ErrPromoCodeNotApplicable
+	}
+	used, err := r.CountUserPromoCodeUses(ctx, code, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count promo code uses: %w", err)
+	}
+	if used >= promo.PerUserLimit {
+		return nil, model.ErrPromoCodeLimitReached
+	}
+	if err := r.IncrementPromoCodeUses(ctx, code); err != nil {
+		return nil, err
+	}
+	return promo, nil
+}
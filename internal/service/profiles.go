@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const maxProfileFieldLength = 200
+
+func (s *MerchService) GetProfile(ctx context.Context, username string) (*model.User, error) {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// UpdateProfile changes the given fields of the user's profile. Empty
+// strings clear a field.
+func (s *MerchService) UpdateProfile(ctx context.Context, username string, update model.ProfileUpdate) (*model.User, error) {
+	var user *model.User
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		user, err = r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		before := user.Profile
+		applyProfileUpdate(&user.Profile, update)
+		if err := validateProfile(user.Profile); err != nil {
+			return err
+		}
+		if err := r.UpdateUserProfile(ctx, username, user.Profile); err != nil {
+			return fmt.Errorf("failed to update profile: %w", err)
+		}
+		return s.audit(ctx, r, username, model.AuditProfileUpdated, username,
+			profileAudit(before), profileAudit(user.Profile))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func applyProfileUpdate(p *model.Profile, update model.ProfileUpdate) {
+	if update.DisplayName != nil {
+		p.DisplayName = *update.DisplayName
+	}
+	if update.Email != nil {
+		p.Email = *update.Email
+	}
+	if update.Department != nil {
+		p.Department = *update.Department
+	}
+	if update.AvatarURL != nil {
+		p.AvatarURL = *update.AvatarURL
+	}
+}
+
+func validateProfile(p model.Profile) error {
+	for _, field := range []string{p.DisplayName, p.Email, p.Department, p.AvatarURL} {
+		if len([]rune(field)) > maxProfileFieldLength {
+			return model.ErrProfileTooLong
+		}
+	}
+	if p.Email != "" {
+		addr, err := mail.ParseAddress(p.Email)
+		if err != nil || addr.Address != p.Email {
+			return model.ErrInvalidEmail
+		}
+	}
+	if p.AvatarURL != "" {
+		u, err := url.Parse(p.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return model.ErrInvalidAvatarURL
+		}
+	}
+	return nil
+}
+
+func profileAudit(p model.Profile) map[string]any {
+	return map[string]any{
+		"displayName": p.DisplayName,
+		"email":       p.Email,
+		"department":  p.Department,
+		"avatarUrl":   p.AvatarURL,
+	}
+}
+
+// SearchUsers looks up active users by username, display name or
+// department, for picking transfer recipients.
+func (s *MerchService) SearchUsers(ctx context.Context, query string, limit int32) ([]model.DirectoryEntry, error) {
+	entries, err := s.repo.SearchUsers(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	return entries, nil
+}
+
+// DeactivateUser stops the user from signing in and receiving coins,
+// keeping their history. Tokens issued before stay valid until they expire.
+func (s *MerchService) DeactivateUser(ctx context.Context, admin, username string) error {
+	return s.setUserDeactivated(ctx, admin, username, true)
+}
+
+func (s *MerchService) ReactivateUser(ctx context.Context, admin, username string) error {
+	return s.setUserDeactivated(ctx, admin, username, false)
+}
+
+func (s *MerchService) setUserDeactivated(ctx context.Context, admin, username string, deactivated bool) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	action := model.AuditUserReactivated
+	if deactivated {
+		action = model.AuditUserDeactivated
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		user, err := r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if err := r.SetUserDeactivated(ctx, username, deactivated); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		// Tokens issued before the deactivation stay revoked once the user
+		// is reactivated.
+		if deactivated {
+			if err := r.RevokeTokens(ctx, username); err != nil {
+				return fmt.Errorf("failed to revoke tokens: %w", err)
+			}
+		}
+		return s.audit(ctx, r, admin, action, username,
+			map[string]any{"active": user.DeactivatedAt == nil}, map[string]any{"active": !deactivated})
+	})
+}
+
+// requireActive returns ErrUserDeactivated for deactivated users.
+func requireActive(ctx context.Context, r repository.MerchRepository, username string) error {
+	user, err := r.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.DeactivatedAt != nil {
+		return model.ErrUserDeactivated
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+// validDiscount reports whether the discount is either a percentage or an
+// amount off, but not both.
+func validDiscount(d model.Discount) bool {
+	if d.PercentOff > 0 {
+		return d.PercentOff <= 100 && d.AmountOff == 0
+	}
+	return d.AmountOff > 0
+}
+
+// CreateSale schedules a sale of the item or of every item in the category.
+func (s *MerchService) CreateSale(ctx context.Context, admin string, sale model.Sale) (*model.Sale, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if sale.Name == "" || (sale.Item == "") == (sale.Category == "") || !sale.StartsAt.Before(sale.EndsAt) {
+		return nil, model.ErrInvalidSale
+	}
+	if !validDiscount(sale.Discount) {
+		return nil, model.ErrInvalidDiscount
+	}
+	var created *model.Sale
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		created, err = r.CreateSale(ctx, sale)
+		if err != nil {
+			return fmt.Errorf("failed to create sale: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditSaleCreated, fmt.Sprint(created.ID), nil, map[string]any{
+			"name":       sale.Name,
+			"item":       sale.Item,
+			"category":   sale.Category,
+			"percentOff": sale.Discount.PercentOff,
+			"amountOff":  sale.Discount.AmountOff,
+			"startsAt":   sale.StartsAt,
+			"endsAt":     sale.EndsAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *MerchService) ListSales(ctx context.Context, admin string) ([]model.Sale, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	sales, err := s.repo.ListSales(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sales: %w", err)
+	}
+	return sales, nil
+}
+
+func (s *MerchService) DeleteSale(ctx context.Context, admin string, id int32) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.DeleteSale(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete sale: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditSaleDeleted, fmt.Sprint(id), nil, nil)
+	})
+}
+
+// CreatePromoCode adds a promo code, restricted to the item or the category
+// when one is set.
+func (s *MerchService) CreatePromoCode(ctx context.Context, admin string, promo model.PromoCode) (*model.PromoCode, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if promo.Code == "" || promo.PerUserLimit == 0 || (promo.Item != "" && promo.Category != "") ||
+		(promo.MaxUses != nil && *promo.MaxUses == 0) {
+		return nil, model.ErrInvalidPromoCode
+	}
+	if promo.StartsAt != nil && promo.EndsAt != nil && !promo.StartsAt.Before(*promo.EndsAt) {
+		return nil, model.ErrInvalidPeriod
+	}
+	if !validDiscount(promo.Discount) {
+		return nil, model.ErrInvalidDiscount
+	}
+	var created *model.PromoCode
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		created, err = r.CreatePromoCode(ctx, promo)
+		if err != nil {
+			return fmt.Errorf("failed to create promo code: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditPromoCodeCreated, promo.Code, nil, map[string]any{
+			"item":         promo.Item,
+			"category":     promo.Category,
+			"percentOff":   promo.Discount.PercentOff,
+			"amountOff":    promo.Discount.AmountOff,
+			"maxUses":      promo.MaxUses,
+			"perUserLimit": promo.PerUserLimit,
+			"startsAt":     promo.StartsAt,
+			"endsAt":       promo.EndsAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *MerchService) ListPromoCodes(ctx context.Context, admin string) ([]model.PromoCode, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	promos, err := s.repo.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+	return promos, nil
+}
+
+func (s *MerchService) DeletePromoCode(ctx context.Context, admin, code string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.DeletePromoCode(ctx, code); err != nil {
+			return fmt.Errorf("failed to delete promo code: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditPromoCodeDeleted, code, nil, nil)
+	})
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"merchshop/internal/model"
+)
+
+var reportHeaders = map[model.ReportKind][]string{
+	model.ReportTransfers: {"id", "created_at", "from_user", "to_user", "amount", "currency"},
+	model.ReportPurchases: {"created_at", "username", "item", "variant", "price", "currency", "sale", "promo_code"},
+	model.ReportBalances:  {"username", "opening", "received", "sent", "spent", "closing"},
+}
+
+// ValidateReport checks the report parameters, so that callers can reject a
+// request before they start streaming the response.
+func (s *MerchService) ValidateReport(kind model.ReportKind, format model.ReportFormat, period model.Period) error {
+	if _, ok := reportHeaders[kind]; !ok {
+		return model.ErrUnknownReport
+	}
+	switch format {
+	case model.ReportFormatCSV, model.ReportFormatJSONL, model.ReportFormatXLSX:
+	default:
+		return model.ErrUnknownReportFormat
+	}
+	return validatePeriod(period)
+}
+
+// ExportReport streams the report for the period to w as rows are read from
+// the database. The caller is responsible for authorization.
+func (s *MerchService) ExportReport(ctx context.Context, w io.Writer, kind model.ReportKind, format model.ReportFormat, period model.Period) error {
+	if err := s.ValidateReport(kind, format, period); err != nil {
+		return err
+	}
+	rw, err := newReportWriter(w, format, reportHeaders[kind])
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case model.ReportTransfers:
+		err = s.repo.StreamTransfers(ctx, period, func(t model.CoinTransfer) error {
+			return rw.write(transferRow{
+				ID:        t.ID,
+				CreatedAt: t.CreatedAt,
+				FromUser:  t.FromUsername,
+				ToUser:    t.ToUsername,
+				Amount:    t.Amount,
+				Currency:  t.Currency,
+			})
+		})
+	case model.ReportPurchases:
+		err = s.repo.StreamPurchases(ctx, period, func(p model.Purchase) error {
+			return rw.write(purchaseRow{
+				CreatedAt: p.CreatedAt,
+				Username:  p.Username,
+				Item:      p.Item,
+				Variant:   p.Variant,
+				Price:     p.Price,
+				Currency:  p.Currency,
+				Sale:      p.Sale,
+				PromoCode: p.PromoCode,
+			})
+		})
+	case model.ReportBalances:
+		err = s.repo.StreamBalances(ctx, period, func(b model.BalanceReport) error {
+			return rw.write(balanceRow{
+				Username: b.Username,
+				Opening:  b.Opening,
+				Received: b.Received,
+				Sent:     b.Sent,
+				Spent:    b.Spent,
+				Closing:  b.Closing,
+			})
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export %s report: %w", kind, err)
+	}
+	return rw.flush()
+}
+
+// reportRow is a report line, encoded as is for JSON Lines and through
+// fields for CSV, in header order.
+type reportRow interface {
+	fields() []string
+}
+
+type transferRow struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	FromUser  string    `json:"fromUser"`
+	ToUser    string    `json:"toUser"`
+	Amount    uint32    `json:"amount"`
+	Currency  string    `json:"currency"`
+}
+
+func (r transferRow) fields() []string {
+	return []string{
+		strconv.FormatInt(r.ID, 10),
+		r.CreatedAt.UTC().Format(time.RFC3339),
+		r.FromUser,
+		r.ToUser,
+		strconv.FormatUint(uint64(r.Amount), 10),
+		r.Currency,
+	}
+}
+
+type purchaseRow struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Username  string    `json:"username"`
+	Item      string    `json:"item"`
+	Variant   string    `json:"variant,omitempty"`
+	Price     uint32    `json:"price"`
+	Currency  string    `json:"currency"`
+	Sale      string    `json:"sale,omitempty"`
+	PromoCode string    `json:"promoCode,omitempty"`
+}
+
+func (r purchaseRow) fields() []string {
+	return []string{
+		r.CreatedAt.UTC().Format(time.RFC3339),
+		r.Username,
+		r.Item,
+		r.Variant,
+		strconv.FormatUint(uint64(r.Price), 10),
+		r.Currency,
+		r.Sale,
+		r.PromoCode,
+	}
+}
+
+type balanceRow struct {
+	Username string `json:"username"`
+	Opening  int64  `json:"opening"`
+	Received uint64 `json:"received"`
+	Sent     uint64 `json:"sent"`
+	Spent    uint64 `json:"spent"`
+	Closing  int64  `json:"closing"`
+}
+
+func (r balanceRow) fields() []string {
+	return []string{
+		r.Username,
+		strconv.FormatInt(r.Opening, 10),
+		strconv.FormatUint(r.Received, 10),
+		strconv.FormatUint(r.Sent, 10),
+		strconv.FormatUint(r.Spent, 10),
+		strconv.FormatInt(r.Closing, 10),
+	}
+}
+
+type reportWriter struct {
+	csv *csv.Writer
+	// spreadsheet escapes cells that a spreadsheet would run as formulas.
+	spreadsheet bool
+	json        *json.Encoder
+}
+
+func newReportWriter(w io.Writer, format model.ReportFormat, header []string) (*reportWriter, error) {
+	switch format {
+	case model.ReportFormatJSONL:
+		return &reportWriter{json: json.NewEncoder(w)}, nil
+	case model.ReportFormatXLSX:
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return nil, err
+		}
+	}
+	rw := &reportWriter{
+		csv:         csv.NewWriter(w),
+		spreadsheet: format == model.ReportFormatXLSX,
+	}
+	rw.csv.UseCRLF = rw.spreadsheet
+	if err := rw.csv.Write(header); err != nil {
+		return nil, err
+	}
+	return rw, nil
+}
+
+func (rw *reportWriter) write(row reportRow) error {
+	if rw.json != nil {
+		return rw.json.Encode(row)
+	}
+	fields := row.fields()
+	if rw.spreadsheet {
+		for i, f := range fields {
+			if f != "" && strings.ContainsRune("=+-@\t\r", rune(f[0])) {
+				if _, err := strconv.ParseInt(f, 10, 64); err != nil {
+					fields[i] = "'" + f
+				}
+			}
+		}
+	}
+	return rw.csv.Write(fields)
+}
+
+func (rw *reportWriter) flush() error {
+	if rw.csv == nil {
+		return nil
+	}
+	rw.csv.Flush()
+	return rw.csv.Error()
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+func (s *MerchService) CreateTeam(ctx context.Context, admin, name, description string) (*model.Team, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, model.ErrInvalidTeam
+	}
+	var team *model.Team
+	err := s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		var err error
+		team, err = r.CreateTeam(ctx, model.Team{
+			Name:        name,
+			Description: description,
+			CreatedBy:   admin,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditTeamCreated, name, nil,
+			map[string]any{"description": description})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (s *MerchService) ListTeams(ctx context.Context) ([]model.Team, error) {
+	teams, err := s.repo.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	return teams, nil
+}
+
+// GetTeam returns the team together with its members.
+func (s *MerchService) GetTeam(ctx context.Context, name string) (*model.Team, error) {
+	team, err := s.repo.GetTeam(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	team.Members, err = s.repo.ListTeamMembers(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+	return team, nil
+}
+
+// SetTeamMember adds an active user to the team or changes their role in it.
+func (s *MerchService) SetTeamMember(ctx context.Context, admin, team, username, role string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if role != model.TeamRoleMember && role != model.TeamRoleLead {
+		return model.ErrInvalidTeamRole
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := requireActive(ctx, r, username); err != nil {
+			return err
+		}
+		var before map[string]any
+		member, err := r.GetTeamMember(ctx, team, username)
+		switch {
+		case err == nil:
+			before = map[string]any{"role": member.Role}
+		case !errors.Is(err, model.ErrNotTeamMember):
+			return fmt.Errorf("failed to get team member: %w", err)
+		}
+		if err := r.SetTeamMember(ctx, team, username, role); err != nil {
+			return fmt.Errorf("failed to set team member: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditTeamMemberSet, team, before,
+			map[string]any{"username": username, "role": role})
+	})
+}
+
+func (s *MerchService) RemoveTeamMember(ctx context.Context, admin, team, username string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.RemoveTeamMember(ctx, team, username); err != nil {
+			return fmt.Errorf("failed to remove team member: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditTeamMemberRemoved, team,
+			map[string]any{"username": username}, nil)
+	})
+}
+
+// DepositTeamCoins funds the team wallet with new coins.
+func (s *MerchService) DepositTeamCoins(ctx context.Context, admin, team string, amount int32, reason string) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	if amount <= 0 {
+		return model.ErrInvalidAmount
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.AddTeamCoins(ctx, team, amount); err != nil {
+			return fmt.Errorf("failed to add team coins: %w", err)
+		}
+		err := r.InsertTeamTransaction(ctx, model.TeamTransaction{
+			Team:   team,
+			Kind:   model.TeamTransactionDeposit,
+			Amount: uint32(amount),
+			Actor:  admin,
+			Reason: reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to log team deposit: %w", err)
+		}
+		t, err := r.GetTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditTeamDeposit, team,
+			map[string]any{"coins": t.Coins - uint32(amount)},
+			map[string]any{"coins": t.Coins, "amount": amount, "reason": reason})
+	})
+}
+
+// TeamTransfer sends coins from the team wallet to another member of the
+// team, the way SendCoin does between users. Only active team leads can
+// spend the wallet, and the coins count towards their transfer limits.
+// Deducting the coins locks the team row, so concurrent transfers cannot
+// overdraw it.
+func (s *MerchService) TeamTransfer(ctx context.Context, lead, team, toUsername string, amount int32, reason string) error {
+	if amount <= 0 {
+		return model.ErrInvalidAmount
+	}
+	if lead == toUsername {
+		return model.ErrTeamTransferToSelf
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if _, err := r.GetTeam(ctx, team); err != nil {
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+		member, err := r.GetTeamMember(ctx, team, lead)
+		if errors.Is(err, model.ErrNotTeamMember) {
+			return model.ErrForbidden
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get team member: %w", err)
+		}
+		if member.Role != model.TeamRoleLead {
+			return model.ErrForbidden
+		}
+		if _, err := r.GetTeamMember(ctx, team, toUsername); err != nil {
+			return fmt.Errorf("failed to get team member: %w", err)
+		}
+		// The lead's row is locked for the limits to be summed in turn with
+		// their other transfers.
+		if err := r.LockUsers(ctx, lead, toUsername); err != nil {
+			return fmt.Errorf("failed to lock users: %w", err)
+		}
+		if err := requireActive(ctx, r, lead); err != nil {
+			return err
+		}
+		if err := requireActive(ctx, r, toUsername); err != nil {
+			return err
+		}
+		if err := checkTransferLimits(ctx, r, lead, uint32(amount)); err != nil {
+			return err
+		}
+		if err := r.DeductTeamCoins(ctx, team, amount); err != nil {
+			return fmt.Errorf("failed to deduct team coins: %w", err)
+		}
+		if err := r.AddCoins(ctx, toUsername, amount); err != nil {
+			return fmt.Errorf("failed to add coins to receiver: %w", err)
+		}
+		err = r.InsertTeamTransaction(ctx, model.TeamTransaction{
+			Team:     team,
+			Kind:     model.TeamTransactionTransfer,
+			Username: toUsername,
+			Amount:   uint32(amount),
+			Actor:    lead,
+			Reason:   reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to log team transfer: %w", err)
+		}
+		err = r.CreateNotification(ctx, toUsername, model.NotificationTeamCoinsReceived, map[string]any{
+			"team":     team,
+			"fromUser": lead,
+			"amount":   amount,
+			"reason":   reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+		t, err := r.GetTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+		receiver, err := r.GetUser(ctx, toUsername)
+		if err != nil {
+			return fmt.Errorf("failed to get receiver: %w", err)
+		}
+		return s.audit(ctx, r, lead, model.AuditTeamTransfer, team,
+			map[string]any{"teamCoins": t.Coins + uint32(amount), "toCoins": receiver.Coins - uint32(amount)},
+			map[string]any{"teamCoins": t.Coins, "toCoins": receiver.Coins, "toUser": toUsername, "amount": amount})
+	})
+}
+
+// GetTeamTransactions returns the latest entries of the team wallet ledger
+// to members of the team and to admins.
+func (s *MerchService) GetTeamTransactions(ctx context.Context, username, team string, limit int32) ([]model.TeamTransaction, error) {
+	if _, err := s.repo.GetTeam(ctx, team); err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	_, err := s.repo.GetTeamMember(ctx, team, username)
+	if errors.Is(err, model.ErrNotTeamMember) {
+		if err := s.RequireAdmin(ctx, username); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get team member: %w", err)
+	}
+	txs, err := s.repo.ListTeamTransactions(ctx, team, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team transactions: %w", err)
+	}
+	return txs, nil
+}
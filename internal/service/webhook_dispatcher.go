@@ -0,0 +1,147 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+const (
+	webhookPollInterval   = 2 * time.Second
+	webhookBatchSize      = 50
+	webhookRequestTimeout = 10 * time.Second
+	// webhookLease outlasts a batch in which every request times out, so a
+	// delivery is never handed to a second dispatcher while still in flight.
+	webhookLease       = webhookBatchSize*webhookRequestTimeout + time.Minute
+	webhookMaxAttempts = 10
+	webhookBaseBackoff = 10 * time.Second
+	webhookMaxBackoff  = 6 * time.Hour
+)
+
+// WebhookDispatcher delivers queued shop events to webhook subscribers. Each
+// request carries an X-Merch-Signature header of the form t=<unix>,v1=<hex>,
+// where v1 is the HMAC-SHA256 of "<unix>.<body>" keyed with the subscription
+// secret. Failed deliveries are retried with exponential backoff and marked
+// dead after webhookMaxAttempts.
+type WebhookDispatcher struct {
+	repo   repository.MerchRepository
+	client *http.Client
+}
+
+func NewWebhookDispatcher(repo repository.MerchRepository) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: webhookRequestTimeout},
+	}
+}
+
+// Run polls for due deliveries until ctx is cancelled.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pending, err := d.repo.LeaseWebhookDeliveries(ctx, webhookLease, webhookBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to lease webhook deliveries: %v", err)
+			}
+			continue
+		}
+		for _, p := range pending {
+			attempt := d.deliver(ctx, p)
+			if err := d.repo.UpdateWebhookDelivery(ctx, p.ID, attempt); err != nil {
+				log.Printf("failed to record webhook delivery %d: %v", p.ID, err)
+			}
+		}
+	}
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, p model.PendingWebhookDelivery) model.WebhookAttempt {
+	now := time.Now()
+	attempt := model.WebhookAttempt{
+		Status:        model.WebhookDeliveryDelivered,
+		NextAttemptAt: now,
+	}
+	statusCode, err := d.send(ctx, p, now)
+	if statusCode != 0 {
+		code := int32(statusCode)
+		attempt.StatusCode = &code
+	}
+	if err == nil {
+		return attempt
+	}
+	attempt.Error = err.Error()
+	attempt.Status = model.WebhookDeliveryPending
+	attempt.NextAttemptAt = now.Add(webhookBackoff(p.Attempts + 1))
+	if p.Attempts+1 >= webhookMaxAttempts {
+		attempt.Status = model.WebhookDeliveryDead
+	}
+	return attempt
+}
+
+func (d *WebhookDispatcher) send(ctx context.Context, p model.PendingWebhookDelivery, now time.Time) (int, error) {
+	body, err := encodeEvent(model.OutboxEvent{
+		ID:        p.EventID,
+		Type:      p.EventType,
+		Payload:   p.Payload,
+		CreatedAt: p.EventCreatedAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode webhook body: %w", err)
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Merch-Event", p.EventType)
+	req.Header.Set("X-Merch-Delivery", strconv.FormatInt(p.ID, 10))
+	req.Header.Set("X-Merch-Signature", "t="+timestamp+",v1="+signWebhook(p.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the given attempt: 10s, 20s, 40s
+// and so on, capped at webhookMaxBackoff.
+func webhookBackoff(attempt uint32) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := uint32(1); i < attempt && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"merchshop/internal/model"
+	"merchshop/internal/repository"
+)
+
+var webhookEventTypes = []string{
+	model.ShopEventCoinsTransferred,
+	model.ShopEventItemPurchased,
+	model.ShopEventUserRegistered,
+}
+
+func (s *MerchService) requireRole(ctx context.Context, username, role string) error {
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Role != role {
+		return model.ErrForbidden
+	}
+	return nil
+}
+
+// RequireAdmin returns ErrForbidden unless the user has the admin role.
+func (s *MerchService) RequireAdmin(ctx context.Context, username string) error {
+	return s.requireRole(ctx, username, model.RoleAdmin)
+}
+
+func (s *MerchService) SetUserRole(ctx context.Context, actor, username, role string) error {
+	if role != model.RoleUser && role != model.RoleAdmin && role != model.RoleAuditor {
+		return fmt.Errorf("unknown role %q", role)
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		user, err := r.GetUser(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if err := r.SetUserRole(ctx, username, role); err != nil {
+			return fmt.Errorf("failed to set user role: %w", err)
+		}
+		return s.audit(ctx, r, actor, model.AuditRoleChanged, username,
+			map[string]any{"role": user.Role}, map[string]any{"role": role})
+	})
+}
+
+// CreateWebhook subscribes endpoint to the given shop events, or to all of
+// them when none are given. The returned subscription carries the generated
+// signing secret.
+func (s *MerchService) CreateWebhook(ctx context.Context, admin, endpoint string, eventTypes []string) (*model.WebhookSubscription, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, model.ErrInvalidWebhookURL
+	}
+	for _, t := range eventTypes {
+		if !slices.Contains(webhookEventTypes, t) {
+			return nil, fmt.Errorf("%w: %s", model.ErrUnknownWebhookEvent, t)
+		}
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	var sub *model.WebhookSubscription
+	err = s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		sub, err = r.CreateWebhookSubscription(ctx, model.WebhookSubscription{
+			URL:        endpoint,
+			Secret:     hex.EncodeToString(secret),
+			EventTypes: eventTypes,
+			CreatedBy:  admin,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create webhook subscription: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditWebhookCreated, fmt.Sprint(sub.ID), nil, map[string]any{
+			"url":        sub.URL,
+			"eventTypes": sub.EventTypes,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *MerchService) ListWebhooks(ctx context.Context, admin string) ([]model.WebhookSubscription, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	subs, err := s.repo.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (s *MerchService) DeleteWebhook(ctx context.Context, admin string, id int32) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.DeleteWebhookSubscription(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete webhook subscription: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditWebhookDeleted, fmt.Sprint(id), nil, nil)
+	})
+}
+
+// GetWebhookDeliveries returns the delivery log of a subscription, newest
+// first.
+func (s *MerchService) GetWebhookDeliveries(ctx context.Context, admin string, id int32, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repo.GetWebhookDeliveries(ctx, id, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RetryWebhookDelivery re-queues a dead-lettered delivery with a fresh set of
+// attempts.
+func (s *MerchService) RetryWebhookDelivery(ctx context.Context, admin string, id int64) error {
+	if err := s.RequireAdmin(ctx, admin); err != nil {
+		return err
+	}
+	return s.repo.Atomic(ctx, func(r repository.MerchRepository) error {
+		if err := r.RetryWebhookDelivery(ctx, id); err != nil {
+			return fmt.Errorf("failed to retry webhook delivery: %w", err)
+		}
+		return s.audit(ctx, r, admin, model.AuditWebhookRetried, fmt.Sprint(id), nil, nil)
+	})
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const wishlistPollInterval = time.Minute
+
+// NotifyWishlistPriceDrops notifies users whose wished items got cheaper
+// since they were last told their price, whether through a new product or
+// variant price or a sale starting. It returns how many users it notified.
+func (s *MerchService) NotifyWishlistPriceDrops(ctx context.Context) (int64, error) {
+	notified, err := s.repo.NotifyWishlistPriceDrops(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to notify wishlist price drops: %w", err)
+	}
+	return notified, nil
+}
+
+// WishlistWatcher looks for price drops of wished items in the background.
+type WishlistWatcher struct {
+	service *MerchService
+}
+
+func NewWishlistWatcher(service *MerchService) *WishlistWatcher {
+	return &WishlistWatcher{service: service}
+}
+
+// Run polls the effective prices of wished items until ctx is cancelled.
+func (w *WishlistWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(wishlistPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := w.service.NotifyWishlistPriceDrops(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to check wishlist prices: %v", err)
+		}
+	}
+}